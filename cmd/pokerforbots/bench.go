@@ -0,0 +1,217 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	rand "math/rand/v2"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/lox/pokerforbots/v2/internal/randutil"
+	"github.com/lox/pokerforbots/v2/poker"
+	"github.com/lox/pokerforbots/v2/sdk/analysis"
+)
+
+// BenchCmd benchmarks the pure computational pieces (hand evaluation, equity and
+// range parsing) without a server, so their throughput can be tracked over time.
+type BenchCmd struct {
+	Workload    []string `default:"evaluate,evaluate-batch,equity,equity-adaptive,range-parse" enum:"evaluate,evaluate-batch,equity,equity-adaptive,range-parse" help:"Workloads to run (repeatable or comma-separated)"`
+	Iterations  int      `default:"1000000" help:"Hands to evaluate, or simulations for equity workloads"`
+	Seed        int64    `default:"42" help:"Random seed for generated hands"`
+	Format      string   `default:"text" enum:"text,json" help:"Output format (text|json)"`
+	Simulations int      `default:"1000" help:"Monte Carlo simulations per equity calculation"`
+}
+
+// benchResult is the measured throughput of a single workload.
+type benchResult struct {
+	Name    string  `json:"name"`
+	Ops     int     `json:"ops"`
+	Unit    string  `json:"unit"` // What an op counts, e.g. hands or simulations
+	Elapsed float64 `json:"elapsed_seconds"`
+	NsPerOp float64 `json:"ns_per_op"`
+	PerSec  float64 `json:"per_sec"`
+}
+
+// benchConfig sizes a benchmark run.
+type benchConfig struct {
+	iterations  int
+	simulations int
+	seed        int64
+}
+
+// benchWorkload prepares its inputs untimed and returns the timed body, which
+// reports how many units of work it completed.
+type benchWorkload struct {
+	unit    string
+	prepare func(cfg benchConfig, rng *rand.Rand) func() int
+}
+
+// benchRanges are parsed by the range-parse workload, mixing single, plus and dash notation.
+var benchRanges = []string{
+	"AA,KK,QQ,AKs,AKo",
+	"22+,A2s+,K9s+,QTs+,JTs,ATo+,KJo+",
+	"55-TT,A5s-A2s,KTs-K7s,T9s,98s,87s",
+	"TT+,AJs+,KQs,AQo+,22-55",
+}
+
+var benchWorkloads = map[string]benchWorkload{
+	"evaluate": {unit: "hands", prepare: func(cfg benchConfig, rng *rand.Rand) func() int {
+		hands := benchHands(rng)
+		return func() int {
+			var sink poker.HandRank
+			for i := range cfg.iterations {
+				sink ^= poker.Evaluate7Cards(hands[i%len(hands)])
+			}
+			_ = sink
+			return cfg.iterations
+		}
+	}},
+	"evaluate-batch": {unit: "hands", prepare: func(cfg benchConfig, rng *rand.Rand) func() int {
+		hands := benchHands(rng)
+		out := make([]poker.HandRank, len(hands))
+		return func() int {
+			done := 0
+			for done < cfg.iterations {
+				batch := hands[:min(len(hands), cfg.iterations-done)]
+				out = poker.Evaluate7CardsBatch(batch, out[:0])
+				done += len(batch)
+			}
+			return done
+		}
+	}},
+	"equity": {unit: "simulations", prepare: func(cfg benchConfig, rng *rand.Rand) func() int {
+		return func() int {
+			return benchEquity(cfg, rng, func(hole, board poker.Hand, sims int) uint32 {
+				return analysis.CalculateEquity(hole, board, 1, sims, rng).TotalSimulations
+			})
+		}
+	}},
+	"equity-adaptive": {unit: "simulations", prepare: func(cfg benchConfig, rng *rand.Rand) func() int {
+		return func() int {
+			return benchEquity(cfg, rng, func(hole, board poker.Hand, sims int) uint32 {
+				return analysis.CalculateEquityAdaptive(hole, board, 1, 0.01, sims, rng).TotalSimulations
+			})
+		}
+	}},
+	"range-parse": {unit: "ranges", prepare: func(cfg benchConfig, _ *rand.Rand) func() int {
+		return func() int {
+			for i := range cfg.iterations {
+				if _, err := analysis.ParseRange(benchRanges[i%len(benchRanges)]); err != nil {
+					panic(err)
+				}
+			}
+			return cfg.iterations
+		}
+	}},
+}
+
+func (cmd *BenchCmd) Run() error {
+	workloads := splitWorkloads(cmd.Workload)
+	results, err := runBenchmarks(workloads, benchConfig{
+		iterations:  cmd.Iterations,
+		simulations: cmd.Simulations,
+		seed:        cmd.Seed,
+	})
+	if err != nil {
+		return err
+	}
+	return writeBenchResults(os.Stdout, cmd.Format, results)
+}
+
+// splitWorkloads flattens comma-separated workload flags, preserving order.
+func splitWorkloads(values []string) []string {
+	var workloads []string
+	for _, value := range values {
+		for name := range strings.SplitSeq(value, ",") {
+			if name = strings.TrimSpace(name); name != "" && !slices.Contains(workloads, name) {
+				workloads = append(workloads, name)
+			}
+		}
+	}
+	return workloads
+}
+
+// runBenchmarks runs each named workload with a fresh rng seeded from cfg.seed,
+// so every workload sees the same inputs regardless of which others ran.
+func runBenchmarks(names []string, cfg benchConfig) ([]benchResult, error) {
+	if cfg.iterations <= 0 {
+		return nil, fmt.Errorf("iterations must be positive")
+	}
+	if cfg.simulations <= 0 {
+		return nil, fmt.Errorf("simulations must be positive")
+	}
+
+	results := make([]benchResult, 0, len(names))
+	for _, name := range names {
+		workload, ok := benchWorkloads[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown workload %q", name)
+		}
+
+		run := workload.prepare(cfg, randutil.New(cfg.seed))
+		start := time.Now()
+		ops := run()
+		elapsed := time.Since(start)
+
+		result := benchResult{Name: name, Ops: ops, Unit: workload.unit, Elapsed: elapsed.Seconds()}
+		if ops > 0 {
+			result.NsPerOp = float64(elapsed.Nanoseconds()) / float64(ops)
+		}
+		if elapsed > 0 {
+			result.PerSec = float64(ops) / elapsed.Seconds()
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// writeBenchResults prints results as aligned text lines or a JSON array. The text
+// format is one line per workload so it can be diffed or grepped between runs.
+func writeBenchResults(w io.Writer, format string, results []benchResult) error {
+	if format == "json" {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(results)
+	}
+
+	for _, result := range results {
+		if _, err := fmt.Fprintf(w, "%-16s %12d %-12s %12.1f ns/op %14.0f %s/sec\n",
+			result.Name, result.Ops, result.Unit, result.NsPerOp, result.PerSec, result.Unit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// benchHands deals a fixed pool of random 7-card hands to evaluate.
+func benchHands(rng *rand.Rand) []poker.Hand {
+	const poolSize = 4096
+	deck := poker.NewDeck(rng)
+	hands := make([]poker.Hand, poolSize)
+	for i := range hands {
+		deck.Shuffle()
+		hands[i] = poker.NewHand(deck.Deal(7)...)
+	}
+	return hands
+}
+
+// benchEquity runs equity calculations for random hole cards on random flops
+// until cfg.iterations simulations have been run.
+func benchEquity(cfg benchConfig, rng *rand.Rand, calculate func(hole, board poker.Hand, sims int) uint32) int {
+	deck := poker.NewDeck(rng)
+	done := 0
+	for done < cfg.iterations {
+		deck.Shuffle()
+		hole := poker.NewHand(deck.Deal(2)...)
+		board := poker.NewHand(deck.Deal(3)...)
+		sims := int(calculate(hole, board, min(cfg.simulations, cfg.iterations-done)))
+		if sims == 0 {
+			break // The spot couldn't be simulated; avoid spinning forever
+		}
+		done += sims
+	}
+	return done
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestRunBenchmarksTinyWorkload(t *testing.T) {
+	workloads := splitWorkloads([]string{"evaluate,evaluate-batch", "equity", "equity-adaptive", "range-parse", "equity"})
+	if len(workloads) != 5 {
+		t.Fatalf("expected 5 distinct workloads, got %v", workloads)
+	}
+
+	results, err := runBenchmarks(workloads, benchConfig{iterations: 500, simulations: 100, seed: 2145})
+	if err != nil {
+		t.Fatalf("runBenchmarks: %v", err)
+	}
+	if len(results) != len(workloads) {
+		t.Fatalf("expected %d results, got %d", len(workloads), len(results))
+	}
+	for i, result := range results {
+		if result.Name != workloads[i] {
+			t.Errorf("result %d: expected %s, got %s", i, workloads[i], result.Name)
+		}
+		if result.Ops != 500 {
+			t.Errorf("%s: expected 500 ops, got %d", result.Name, result.Ops)
+		}
+		if result.NsPerOp <= 0 || result.PerSec <= 0 {
+			t.Errorf("%s: expected positive timings, got %.1f ns/op and %.0f/sec", result.Name, result.NsPerOp, result.PerSec)
+		}
+	}
+
+	var text bytes.Buffer
+	if err := writeBenchResults(&text, "text", results); err != nil {
+		t.Fatalf("writeBenchResults text: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(text.String()), "\n")
+	if len(lines) != len(results) {
+		t.Fatalf("expected one line per workload, got:\n%s", text.String())
+	}
+	if !strings.HasPrefix(lines[0], "evaluate ") || !strings.Contains(lines[0], "ns/op") || !strings.Contains(lines[0], "hands/sec") {
+		t.Errorf("unexpected text line: %q", lines[0])
+	}
+
+	var jsonOut bytes.Buffer
+	if err := writeBenchResults(&jsonOut, "json", results); err != nil {
+		t.Fatalf("writeBenchResults json: %v", err)
+	}
+	var decoded []benchResult
+	if err := json.Unmarshal(jsonOut.Bytes(), &decoded); err != nil {
+		t.Fatalf("decoding json output: %v", err)
+	}
+	if len(decoded) != len(results) || decoded[2].Unit != "simulations" {
+		t.Errorf("unexpected json output: %+v", decoded)
+	}
+}
+
+func TestRunBenchmarksRejectsUnknownWorkload(t *testing.T) {
+	if _, err := runBenchmarks([]string{"nope"}, benchConfig{iterations: 1, simulations: 1}); err == nil {
+		t.Fatal("expected error for unknown workload")
+	}
+}
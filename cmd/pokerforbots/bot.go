@@ -6,7 +6,9 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
+	"github.com/lox/pokerforbots/v2/internal/randutil"
 	"github.com/lox/pokerforbots/v2/sdk/bot"
 	"github.com/lox/pokerforbots/v2/sdk/client"
 	"github.com/rs/zerolog"
@@ -16,10 +18,11 @@ import (
 	"github.com/lox/pokerforbots/v2/sdk/bots/callingstation"
 	"github.com/lox/pokerforbots/v2/sdk/bots/complex"
 	"github.com/lox/pokerforbots/v2/sdk/bots/random"
+	"github.com/lox/pokerforbots/v2/sdk/bots/styled"
 )
 
 type BotCmd struct {
-	Name     string `arg:"" help:"Bot type (calling-station, random, aggressive, complex)"`
+	Name     string `arg:"" help:"Bot type (calling-station, random, aggressive, complex, nit, tag, lag, maniac)"`
 	Server   string `default:"ws://localhost:8080/ws" help:"WebSocket server URL"`
 	Game     string `default:"default" help:"Game to join"`
 	LogLevel string `default:"info" help:"Log level (debug|info|warn|error)"`
@@ -32,6 +35,21 @@ var botHandlers = map[string]func(zerolog.Logger) client.Handler{
 	"random":          func(zerolog.Logger) client.Handler { return random.NewHandler() },
 	"aggressive":      func(zerolog.Logger) client.Handler { return aggressive.NewHandler() },
 	"complex":         func(logger zerolog.Logger) client.Handler { return complex.NewHandlerWithLogger(logger) },
+	"nit":             presetHandler(bot.PresetNit),
+	"tag":             presetHandler(bot.PresetTAG),
+	"lag":             presetHandler(bot.PresetLAG),
+	"maniac":          presetHandler(bot.PresetManiac),
+}
+
+// presetHandler plays the named style preset with the styled bot
+func presetHandler(name string) func(zerolog.Logger) client.Handler {
+	return func(zerolog.Logger) client.Handler {
+		cfg, err := bot.PresetConfig(name)
+		if err != nil {
+			panic(err) // Only called with known presets
+		}
+		return styled.NewHandler(cfg, randutil.New(time.Now().UnixNano()))
+	}
 }
 
 // botPrefixes maps bot names to their ID prefixes
@@ -40,13 +58,17 @@ var botPrefixes = map[string]string{
 	"random":          "random",
 	"aggressive":      "aggressive",
 	"complex":         "complex",
+	"nit":             "nit",
+	"tag":             "tag",
+	"lag":             "lag",
+	"maniac":          "maniac",
 }
 
 func (c *BotCmd) Run() error {
 	// Look up the bot handler constructor
 	handlerFn, ok := botHandlers[c.Name]
 	if !ok {
-		return fmt.Errorf("unknown bot: %s (available: calling-station, random, aggressive, complex)", c.Name)
+		return fmt.Errorf("unknown bot: %s (available: calling-station, random, aggressive, complex, nit, tag, lag, maniac)", c.Name)
 	}
 
 	// Setup logger
@@ -15,6 +15,7 @@ type CLI struct {
 	Spawn       SpawnCmd         `cmd:"" help:"Spawn server with bots for testing/demos"`
 	Regression  RegressionCmd    `cmd:"" help:"Run regression tests between bot versions"`
 	HandHistory HandHistoryCmd   `cmd:"hand-history" help:"Work with PHH hand history files"`
+	Bench       BenchCmd         `cmd:"" help:"Benchmark hand evaluation, equity and range parsing throughput"`
 }
 
 func main() {
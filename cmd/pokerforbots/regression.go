@@ -24,6 +24,8 @@ type RegressionCmd struct {
 	Hands         int    `kong:"default='10000',help='Total hands to play'"`
 	BatchSize     int    `kong:"default='10000',help='Hands per batch'"`
 	Seeds         string `kong:"default='42',help='Comma-separated list of seeds'"`
+	SeedSweep     int    `kong:"name='seed-sweep',help='Run the comparison across N seeds derived from the first seed and report robustness'"`
+	Scenarios     string `kong:"help='JSON file of curated spots (stacks and forced cards by position) to run the comparison on, one run per scenario'"`
 	StartingChips int    `kong:"default='1000',help='Starting chips in big blinds'"`
 
 	// Table configuration
@@ -139,6 +141,8 @@ func (c *RegressionCmd) Run() error {
 		HandsTotal:    c.Hands,
 		BatchSize:     c.BatchSize,
 		Seeds:         seeds,
+		SeedSweep:     c.SeedSweep,
+		ScenarioFile:  c.Scenarios,
 		StartingChips: c.StartingChips,
 
 		// Table configuration
@@ -12,6 +12,7 @@ import (
 
 	"github.com/lox/pokerforbots/v2/cmd/pokerforbots/shared"
 	"github.com/lox/pokerforbots/v2/internal/auth"
+	"github.com/lox/pokerforbots/v2/internal/game"
 	"github.com/lox/pokerforbots/v2/internal/server"
 )
 
@@ -27,18 +28,32 @@ type ServerCmd struct {
 	StartChips            int    `kong:"default='1000',help='Starting chip count'"`
 	TimeoutMs             int    `kong:"default='100',help='Decision timeout in milliseconds'"`
 	MinActionTimeMs       int    `kong:"default='0',help='Minimum action time in milliseconds (prevents timing tells and controls game speed)'"`
+	HandsPerHour          int    `kong:"default='0',help='Pace hands to this many per hour for live-like timing (0 runs as fast as possible)'"`
+	DrainTimeoutMs        int    `kong:"default='5000',help='Maximum time in milliseconds to wait for in-flight hands on shutdown'"`
+	MaxActionsPerStreet   int    `kong:"default='1000',help='Maximum actions per street before the hand runner forces folds'"`
+	MissedBlinds          bool   `kong:"help='Require bots reconnecting after hands were dealt without them to post a dead small blind plus a live big blind'"`
+	RabbitHunt            bool   `kong:"help='Reveal the rest of the board in hand results when a hand ends before the river (for study; leaks deck order)'"`
+	Ante                  int    `kong:"default='0',help='Ante every player posts before the blinds (0 disables)'"`
+	Straddle              string `kong:"default='none',enum='none,utg,button,mississippi',help='Straddle posted every hand: none, utg, button or mississippi'"`
+	StraddleSeat          int    `kong:"default='3',help='Seat left of the button that posts a Mississippi straddle (3 is UTG)'"`
+	StudyRunouts          int    `kong:"default='0',help='Run the remaining board this many times whenever betting closes before the river and log how often each player wins them (0 disables)'"`
 	MinPlayers            int    `kong:"default='2',help='Minimum players per hand'"`
 	MaxPlayers            int    `kong:"default='9',help='Maximum players per hand'"`
 	Seed                  *int64 `kong:"help='Deterministic RNG seed for the server (optional)'"`
 	EnableStats           bool   `kong:"help='Enable statistics collection'"`
-	MaxStatsHands         int    `kong:"default='10000',help='Maximum hands to track in statistics (memory limit)'"`
+	MaxStatsHands         int    `kong:"default='10000',help='Recent hands kept per bot for the median (memory limit; totals cover every hand)'"`
 	LatencyTracking       bool   `kong:"help='Collect per-action latency metrics'"`
+	Metrics               bool   `kong:"help='Expose Prometheus metrics on /metrics'"`
 	InfiniteBankroll      bool   `kong:"help='Players never bust out (always have chips to rebuy)'"`
+	AutoRebuy             bool   `kong:"help='Top players back up to the starting stack between hands so effective stacks stay constant'"`
+	RebuyThreshold        int    `kong:"help='With --auto-rebuy, only top up players below this many chips (default: the starting stack)'"`
+	EffectiveStackBB      int    `kong:"help='Cap every starting stack at this many big blinds each hand, overriding --start-chips; shorter bankrolls play what they have (0 disables)'"`
 	HandHistory           bool   `kong:"help='Enable PHH hand history recording to disk'"`
 	HandHistoryDir        string `kong:"default='hands',help='Directory for PHH files'"`
 	HandHistoryFlushSecs  int    `kong:"default='10',help='Flush interval in seconds'"`
 	HandHistoryFlushHands int    `kong:"default='100',help='Flush after N hands'"`
 	HandHistoryHoleCards  bool   `kong:"help='Include hole cards when writing PHH files (default masks with ???? )'"`
+	HandHistoryAnonymize  bool   `kong:"help='Record players as Player1..PlayerN in PHH files so datasets can be shared'"`
 }
 
 func (c *ServerCmd) Run() error {
@@ -89,6 +104,11 @@ func (c *ServerCmd) Run() error {
 		rng = randutil.New(seed)
 	}
 
+	straddle, err := game.ParseStraddle(c.Straddle)
+	if err != nil {
+		return err
+	}
+
 	// Create server config
 	cfg := server.Config{
 		SmallBlind:            c.SmallBlind,
@@ -96,20 +116,34 @@ func (c *ServerCmd) Run() error {
 		StartChips:            c.StartChips,
 		Timeout:               time.Duration(c.TimeoutMs) * time.Millisecond,
 		MinActionTime:         time.Duration(c.MinActionTimeMs) * time.Millisecond,
+		HandsPerHour:          c.HandsPerHour,
+		DrainTimeout:          time.Duration(c.DrainTimeoutMs) * time.Millisecond,
+		MaxActionsPerStreet:   c.MaxActionsPerStreet,
+		PostMissedBlinds:      c.MissedBlinds,
+		RabbitHunt:            c.RabbitHunt,
+		Ante:                  c.Ante,
+		Straddle:              straddle,
+		StraddleSeat:          c.StraddleSeat,
+		StudyRunouts:          c.StudyRunouts,
 		MinPlayers:            c.MinPlayers,
 		MaxPlayers:            c.MaxPlayers,
 		Seed:                  seed, // Propagate seed to config
 		EnableStats:           c.EnableStats,
 		MaxStatsHands:         c.MaxStatsHands,
 		EnableLatencyTracking: c.LatencyTracking,
+		EnableMetrics:         c.Metrics,
 		AuthRequired:          c.AuthRequired,
 		InfiniteBankroll:      c.InfiniteBankroll,
+		AutoRebuy:             c.AutoRebuy,
+		RebuyThreshold:        c.RebuyThreshold,
+		EffectiveStackBB:      c.EffectiveStackBB,
 	}
 	cfg.EnableHandHistory = c.HandHistory
 	cfg.HandHistoryDir = c.HandHistoryDir
 	cfg.HandHistoryFlushSecs = c.HandHistoryFlushSecs
 	cfg.HandHistoryFlushHands = c.HandHistoryFlushHands
 	cfg.HandHistoryIncludeHoleCards = c.HandHistoryHoleCards
+	cfg.HandHistoryAnonymize = c.HandHistoryAnonymize
 
 	// Create and start server
 	s := server.NewServer(logger, rng, server.WithConfig(cfg), server.WithAuthValidator(validator))
@@ -124,6 +158,7 @@ func (c *ServerCmd) Run() error {
 		Int("max_players", cfg.MaxPlayers).
 		Bool("enable_stats", cfg.EnableStats).
 		Bool("latency_tracking", cfg.EnableLatencyTracking).
+		Bool("metrics", cfg.EnableMetrics).
 		Msg("Starting PokerForBots server")
 
 	// Setup graceful shutdown
@@ -141,7 +176,7 @@ func (c *ServerCmd) Run() error {
 	select {
 	case <-ctx.Done():
 		logger.Info().Msg("Shutting down server...")
-		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.DrainTimeout+5*time.Second)
 		defer cancel()
 		return s.Shutdown(shutdownCtx)
 	case err := <-serverErr:
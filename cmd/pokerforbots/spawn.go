@@ -3,6 +3,7 @@ package main
 import (
 	"github.com/lox/pokerforbots/v2/internal/randutil"
 
+	"bufio"
 	"context"
 	"encoding/json"
 	"errors"
@@ -23,6 +24,9 @@ import (
 	"github.com/rs/zerolog"
 )
 
+// prettyEquitySimulations is the number of Monte Carlo runouts per street for --show-equity.
+const prettyEquitySimulations = 2000
+
 type SpawnCmd struct {
 	// Server configuration
 	Addr                  string `kong:"default='localhost:0',help='Server address, defaults to random port on localhost'"`
@@ -39,6 +43,7 @@ type SpawnCmd struct {
 	HandHistoryFlushSecs  int    `kong:"default='10',help='Flush interval in seconds'"`
 	HandHistoryFlushHands int    `kong:"default='100',help='Flush after N hands'"`
 	HandHistoryHoleCards  bool   `kong:"help='Include hole cards when writing PHH files (default masks with ???? )'"`
+	HandHistoryAnonymize  bool   `kong:"help='Record players as Player1..PlayerN in PHH files so datasets can be shared'"`
 
 	// Bot specification
 	Spec   string   `kong:"default='calling-station:6',help='Bot specification (e.g. calling-station:2,random:1,aggressive:3)'"`
@@ -48,13 +53,19 @@ type SpawnCmd struct {
 	// Game control
 	HandLimit        int  `kong:"help='Stop after N hands (0 for unlimited)'"`
 	InfiniteBankroll bool `kong:"help='Players never bust out (always have chips to rebuy)'"`
+	AutoRebuy        bool `kong:"help='Top players back up to the starting stack between hands so effective stacks stay constant'"`
+	RebuyThreshold   int  `kong:"help='With --auto-rebuy, only top up players below this many chips (default: the starting stack)'"`
+	EffectiveStackBB int  `kong:"help='Top up and cap every starting stack at this many big blinds each hand, so effective stacks stay at that depth however bankrolls drift (0 disables)'"`
 
 	// Stats output
 	WriteStats string `kong:"help='Write stats to file on exit'"`
 	PrintStats bool   `kong:"help='Print stats on exit'"`
+	HandLog    string `kong:"help='Write a JSON lines record of every hand to this file'"`
 
 	// Output format
-	Output string `kong:"default='logs',enum='logs,bot-cmd,hand-history,dots,list',help='Output format: logs (all logs), bot-cmd (only custom bot logs), hand-history (pretty hand visualization), dots (progress dots with win/loss colors), list (one line per hand with winner and BB)'"`
+	Output     string `kong:"default='logs',enum='logs,bot-cmd,hand-history,dots,list',help='Output format: logs (all logs), bot-cmd (only custom bot logs), hand-history (pretty hand visualization), dots (progress dots with win/loss colors), list (one line per hand with winner and BB)'"`
+	ShowEquity bool   `kong:"help='Show live player equities in hand-history output (slower)'"`
+	Step       bool   `kong:"help='Pause after each action in hand-history output until Enter is pressed'"`
 
 	// Logging
 	LogLevel string `kong:"help='Log level (debug|info|warn|error)'"`
@@ -154,6 +165,9 @@ func (c *SpawnCmd) Run() error {
 		Seed:                  seed, // Propagate seed to server config
 		HandLimit:             uint64(c.HandLimit),
 		InfiniteBankroll:      c.InfiniteBankroll,
+		AutoRebuy:             c.AutoRebuy,
+		RebuyThreshold:        c.RebuyThreshold,
+		EffectiveStackBB:      c.EffectiveStackBB,
 		EnableStats:           c.WriteStats != "" || c.PrintStats,
 		MaxStatsHands:         10000,
 		EnableLatencyTracking: c.LatencyTracking,
@@ -163,6 +177,7 @@ func (c *SpawnCmd) Run() error {
 	serverCfg.HandHistoryFlushSecs = c.HandHistoryFlushSecs
 	serverCfg.HandHistoryFlushHands = c.HandHistoryFlushHands
 	serverCfg.HandHistoryIncludeHoleCards = c.HandHistoryHoleCards
+	serverCfg.HandHistoryAnonymize = c.HandHistoryAnonymize
 
 	// Create and start server
 	srv := server.NewServer(logger, rng, server.WithConfig(serverCfg))
@@ -182,16 +197,21 @@ func (c *SpawnCmd) Run() error {
 	}
 
 	// Set up output monitor based on mode
+	var monitors []server.HandMonitor
 	switch c.Output {
 	case "hand-history":
-		monitor := server.NewPrettyPrintMonitor(os.Stdout)
-		srv.SetHandMonitor(monitor)
+		var opts []server.PrettyPrintOption
+		if c.ShowEquity {
+			opts = append(opts, server.WithEquityDisplay(prettyEquitySimulations, randutil.New(seed)))
+		}
+		if c.Step {
+			opts = append(opts, server.WithStepper(stdinStepper()))
+		}
+		monitors = append(monitors, server.NewPrettyPrintMonitor(os.Stdout, opts...))
 	case "dots":
-		monitor := server.NewDotsMonitor(os.Stdout)
-		srv.SetHandMonitor(monitor)
+		monitors = append(monitors, server.NewDotsMonitor(os.Stdout))
 	case "list":
-		monitor := server.NewListMonitor(os.Stdout)
-		srv.SetHandMonitor(monitor)
+		monitors = append(monitors, server.NewListMonitor(os.Stdout))
 	default:
 		logger.Info().Str("url", wsURL).Msg("Server started")
 		if c.Seed != 0 {
@@ -199,6 +219,18 @@ func (c *SpawnCmd) Run() error {
 		}
 	}
 
+	if c.HandLog != "" {
+		handLog, err := os.Create(c.HandLog)
+		if err != nil {
+			return fmt.Errorf("failed to create hand log: %w", err)
+		}
+		defer handLog.Close()
+		monitors = append(monitors, server.NewJSONLMonitor(handLog))
+	}
+	if len(monitors) > 0 {
+		srv.SetHandMonitor(server.NewMultiHandMonitor(monitors...))
+	}
+
 	if c.Output != "hand-history" && c.Output != "dots" && c.Output != "list" {
 		logger.Info().Str("spec", c.Spec).Int("additional", len(c.BotCmd)).Int("total_bots", totalBots).Msg("Spawning bots")
 	}
@@ -277,6 +309,10 @@ func (c *SpawnCmd) Run() error {
 		}
 		event.Msg("Run performance")
 	}
+	if c.Output != "hand-history" && c.Output != "dots" && c.Output != "list" {
+		session := spawner.Session{Seed: seed, Hands: c.HandLimit, Spec: c.Spec, BotCmds: c.BotCmd, Count: c.Count}
+		logger.Info().Str("command", session.ReproduceCommand()).Msg("To reproduce this run")
+	}
 
 	// Write stats if requested
 	if c.WriteStats != "" || c.PrintStats {
@@ -535,3 +571,12 @@ func printFormattedStats(data []byte, logger zerolog.Logger) {
 		}
 	}
 }
+
+// stdinStepper returns a step callback that waits for the operator to press Enter.
+func stdinStepper() func() {
+	reader := bufio.NewReader(os.Stdin)
+	return func() {
+		fmt.Fprint(os.Stderr, "-- press Enter to continue --")
+		_, _ = reader.ReadString('\n')
+	}
+}
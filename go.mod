@@ -3,6 +3,7 @@ module github.com/lox/pokerforbots/v2
 go 1.25.1
 
 require (
+	github.com/BurntSushi/toml v1.4.0
 	github.com/alecthomas/kong v1.12.1
 	github.com/google/uuid v1.6.0
 	github.com/gorilla/websocket v1.5.3
@@ -12,7 +13,6 @@ require (
 )
 
 require (
-	github.com/BurntSushi/toml v1.4.0 // indirect
 	github.com/mattn/go-colorable v0.1.13 // indirect
 	github.com/mattn/go-isatty v0.0.19 // indirect
 	github.com/philhofer/fwd v1.2.0 // indirect
@@ -408,7 +408,7 @@ func (c *client) handlePlayerAction(msg *protocol.PlayerAction) error {
 		}
 		state.pot = msg.Pot
 		nameLabel = formatActionName(state, msg.Seat, player.Name, player.Folded, player.AllIn)
-		if !state.printedHoleCards && msg.Street == "preflop" && msg.Action != "post_small_blind" && msg.Action != "post_big_blind" {
+		if !state.printedHoleCards && msg.Street == "preflop" && msg.Action != "post_small_blind" && msg.Action != "post_big_blind" && msg.Action != "post_dead_blind" {
 			state.printedHoleCards = true
 			holeHeaderNeeded = true
 		}
@@ -481,8 +481,9 @@ func (c *client) handleHandResult(msg *protocol.HandResult) error {
 		totalPot += winner.Amount
 	}
 
-	hasShowdown := len(msg.Showdown) > 0
-	if !hasShowdown {
+	hasShowdown := msg.EndReason == protocol.HandEndShowdown || len(msg.Showdown) > 0
+	if !hasShowdown && msg.EndReason == "" {
+		// Older servers don't report how the hand ended, so infer it from the winners
 		for _, winner := range msg.Winners {
 			if len(winner.HoleCards) > 0 || strings.TrimSpace(winner.HandRank) != "" {
 				hasShowdown = true
@@ -942,6 +943,8 @@ func describePlayerAction(action string, amountPaid, playerBet, _ int) string {
 		return fmt.Sprintf("posts small blind %s", formatAmount(amountPaid))
 	case "post_big_blind":
 		return fmt.Sprintf("posts big blind %s", formatAmount(amountPaid))
+	case "post_dead_blind":
+		return fmt.Sprintf("posts dead blind %s", formatAmount(amountPaid))
 	case "timeout_fold":
 		return colorize("times out and folds", colorRed)
 	case "bet":
@@ -0,0 +1,58 @@
+package game
+
+// AnteRule selects how antes are pooled, see WithAnte.
+type AnteRule int
+
+const (
+	// AnteMainPot counts each ante toward the player's contribution, so antes
+	// join the main pot and side pots are layered from them like any other chips.
+	// A player all-in for part of the ante wins at most that much from each
+	// opponent (the default).
+	AnteMainPot AnteRule = iota
+
+	// AnteSeparatePot keeps the antes in a pot of their own, contested by every
+	// player still in however much of the ante they could post. Bets form the
+	// main and side pots on top of it.
+	AnteSeparatePot
+)
+
+// String returns the rule name.
+func (r AnteRule) String() string {
+	switch r {
+	case AnteMainPot:
+		return "main-pot"
+	case AnteSeparatePot:
+		return "separate-pot"
+	default:
+		return "unknown"
+	}
+}
+
+// WithAnte has every player post ante before the blinds, pooled according to
+// rule. Players who can't cover the ante post what they have and are all-in.
+func WithAnte(ante int, rule AnteRule) HandOption {
+	return func(c *handConfig) {
+		c.ante = ante
+		c.anteRule = rule
+	}
+}
+
+// postAntes takes each player's ante straight into the pot.
+func (h *HandState) postAntes(ante int, rule AnteRule) {
+	if ante <= 0 {
+		return
+	}
+	for _, p := range h.Players {
+		p.Ante = min(ante, p.Chips)
+		p.Chips -= p.Ante
+		if p.Chips == 0 {
+			p.AllInFlag = true
+		}
+		if rule == AnteSeparatePot {
+			h.PotManager.AddAntes(p.Ante)
+			continue
+		}
+		p.TotalBet += p.Ante
+		h.PotManager.AddDeadMoney(p.Ante)
+	}
+}
@@ -0,0 +1,92 @@
+package game
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/lox/pokerforbots/v2/internal/randutil"
+	"github.com/lox/pokerforbots/v2/poker"
+)
+
+func TestAntes(t *testing.T) {
+	t.Parallel()
+
+	// Three-handed with the button in seat 0, which can only post 3 of the 5
+	// chip ante. The blinds check it down and the button holds the best hand.
+	tests := []struct {
+		name        string
+		rule        AnteRule
+		wantPots    []Pot
+		wantPayouts map[int]int
+	}{
+		{
+			name: "main pot",
+			rule: AnteMainPot,
+			wantPots: []Pot{
+				{Amount: 9, Eligible: []int{0, 1, 2}, MaxPerPlayer: 3},
+				{Amount: 24, Eligible: []int{1, 2}},
+			},
+			wantPayouts: map[int]int{0: 9, 1: 24},
+		},
+		{
+			name: "separate pot",
+			rule: AnteSeparatePot,
+			wantPots: []Pot{
+				{Amount: 13, Eligible: []int{0, 1, 2}},
+				{Amount: 20, Eligible: []int{1, 2}},
+			},
+			wantPayouts: map[int]int{0: 13, 1: 20},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var cards []poker.Card
+			for _, c := range []string{
+				"Ks", "Qs", "As", // First hole card to seats 1, 2, 0
+				"Kh", "Qh", "Ah", // Second hole card
+				"2c", "7d", "9h", "Jc", "4s", // Board
+			} {
+				card, err := poker.ParseCard(c)
+				if err != nil {
+					t.Fatalf("parse %s: %v", c, err)
+				}
+				cards = append(cards, card)
+			}
+			h := NewHandState(randutil.New(1), []string{"A", "B", "C"}, 0, 5, 10,
+				WithChipsByPlayer([]int{3, 100, 100}), WithAnte(5, tt.rule),
+				WithDeck(poker.NewStackedDeck(cards...)))
+
+			short := h.Players[0]
+			if short.Ante != 3 || short.Chips != 0 || !short.AllInFlag {
+				t.Fatalf("expected the button all-in for a 3 chip ante, got ante %d chips %d all-in %v",
+					short.Ante, short.Chips, short.AllInFlag)
+			}
+			if got := h.PotManager.Total(); got != 13 {
+				t.Fatalf("expected 13 chips of antes in the pot, got %d", got)
+			}
+
+			// The small blind completes and the big blind checks it down
+			if h.ActivePlayer != 1 {
+				t.Fatalf("expected the small blind to act first, got seat %d", h.ActivePlayer)
+			}
+			if err := h.ProcessAction(Call, 0); err != nil {
+				t.Fatalf("small blind call: %v", err)
+			}
+			for h.Street != Showdown {
+				if err := h.ProcessAction(Check, 0); err != nil {
+					t.Fatalf("seat %d check on %s: %v", h.ActivePlayer, h.Street, err)
+				}
+			}
+
+			if got := h.GetPots(); !reflect.DeepEqual(got, tt.wantPots) {
+				t.Errorf("pots = %+v, want %+v", got, tt.wantPots)
+			}
+			if got := h.GetPayouts(); !reflect.DeepEqual(got, tt.wantPayouts) {
+				t.Errorf("payouts = %v, want %v", got, tt.wantPayouts)
+			}
+		})
+	}
+}
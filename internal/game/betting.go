@@ -38,6 +38,8 @@ type BettingRound struct {
 	BBActed        bool
 	ActedThisRound []bool
 	BigBlind       int // Store for resetting min raise on new streets
+	ActionCount    int // Actions processed on the current street
+	Denomination   int // Raise amounts must be multiples of this, 0 or 1 disables
 }
 
 // NewBettingRound creates a new betting round
@@ -62,7 +64,7 @@ func (br *BettingRound) GetValidActions(player *Player) []Action {
 		// No amount to call - return Call (server will normalize to check internally)
 		actions = append(actions, Call)
 		// Can also raise if we have enough chips
-		if player.Chips > br.MinRaise {
+		if player.Chips+player.Bet > br.MinRaiseTo() {
 			actions = append(actions, Raise)
 		} else if player.Chips > 0 {
 			actions = append(actions, AllIn)
@@ -76,7 +78,7 @@ func (br *BettingRound) GetValidActions(player *Player) []Action {
 			// Can call
 			actions = append(actions, Call)
 			// Can raise if we have enough chips
-			if player.Chips > toCall+br.MinRaise {
+			if player.Chips+player.Bet > br.MinRaiseTo() {
 				actions = append(actions, Raise)
 			} else if player.Chips > toCall {
 				// Not enough to min-raise but have chips left after calling
@@ -88,15 +90,45 @@ func (br *BettingRound) GetValidActions(player *Player) []Action {
 	return actions
 }
 
+// MinRaiseTo returns the smallest total bet a raise may go to. A short all-in
+// can leave the current bet off the chip denomination, so the minimum is
+// rounded up to the next whole chip.
+func (br *BettingRound) MinRaiseTo() int {
+	to := br.CurrentBet + br.MinRaise
+	if d := br.Denomination; d > 1 && to%d != 0 {
+		to += d - to%d
+	}
+	return to
+}
+
 // ResetForNewRound resets the betting round for a new street
 func (br *BettingRound) ResetForNewRound(numPlayers int) {
 	br.CurrentBet = 0
 	br.MinRaise = br.BigBlind // Reset to big blind for new street
 	br.LastRaiser = -1
 	br.ActedThisRound = make([]bool, numPlayers)
+	br.ActionCount = 0
 	// Note: BBActed is not reset as it only matters preflop
 }
 
+// RecordRaise records seat raising the bet to amount, so everyone else needs to
+// act again. The minimum raise becomes the raise increment only for a full
+// raise: a short all-in lifts the bet without shrinking the next minimum.
+// Amounts that don't exceed the current bet leave the round unchanged.
+func (br *BettingRound) RecordRaise(seat, amount int) {
+	if amount <= br.CurrentBet {
+		return
+	}
+	if increment := amount - br.CurrentBet; increment >= br.MinRaise {
+		br.MinRaise = increment
+	}
+	br.CurrentBet = amount
+	br.LastRaiser = seat
+
+	clear(br.ActedThisRound)
+	br.MarkPlayerActed(seat)
+}
+
 // MarkPlayerActed marks a player as having acted
 func (br *BettingRound) MarkPlayerActed(seat int) {
 	if seat >= 0 && seat < len(br.ActedThisRound) {
@@ -0,0 +1,76 @@
+package game
+
+import (
+	"github.com/lox/pokerforbots/v2/poker"
+)
+
+// DealtCard records a card leaving the deck.
+type DealtCard struct {
+	Card   poker.Card
+	Street Street // Preflop for hole cards, otherwise the street the board card was dealt on
+	Seat   int    // Seat the hole card was dealt to, -1 for board and burn cards
+	Burn   bool   // Burned before the street's board cards, see WithBurnCards
+}
+
+// DealOrder returns every card dealt so far in the order it left the deck, for
+// writing faithful hand histories.
+func (h *HandState) DealOrder() []DealtCard {
+	if len(h.dealOrder) == 0 {
+		return nil
+	}
+	order := make([]DealtCard, len(h.dealOrder))
+	copy(order, h.dealOrder)
+	return order
+}
+
+// BoardCards returns the community cards in the order they were dealt.
+func (h *HandState) BoardCards() []poker.Card {
+	var cards []poker.Card
+	for _, dealt := range h.dealOrder {
+		if dealt.Seat < 0 && !dealt.Burn {
+			cards = append(cards, dealt.Card)
+		}
+	}
+	return cards
+}
+
+// dealHoleCards deals hole cards in standard order: one card at a time to each
+// player clockwise, starting left of the button, until everyone has a full hand.
+// Heads-up that starts with the big blind.
+func (h *HandState) dealHoleCards() {
+	n := len(h.Players)
+	rounds := h.Variant.holeCards()
+	h.dealOrder = make([]DealtCard, 0, n*rounds+8)
+	for range rounds {
+		for i := range n {
+			p := h.Players[(h.Button+1+i)%n]
+			card := h.Deck.DealOne()
+			p.HoleCards.AddCard(card)
+			h.dealOrder = append(h.dealOrder, DealtCard{Card: card, Street: Preflop, Seat: p.Seat})
+		}
+	}
+	h.verifyCards()
+}
+
+// dealBoard deals n community cards for the current street, burning a card
+// first when burn cards are enabled.
+func (h *HandState) dealBoard(n int) {
+	if h.burnCards {
+		card := h.Deck.DealOne()
+		h.dealOrder = append(h.dealOrder, DealtCard{Card: card, Street: h.Street, Seat: -1, Burn: true})
+	}
+	for _, card := range h.Deck.Deal(n) {
+		h.Board |= poker.Hand(card)
+		h.dealOrder = append(h.dealOrder, DealtCard{Card: card, Street: h.Street, Seat: -1})
+	}
+}
+
+// WithBurnCards burns a card before dealing the flop, turn and river, as a live
+// dealer does. Burned cards never reach the board but consume the deck and are
+// recorded in DealOrder, so hands from external sources can be reproduced card
+// for card. Off by default.
+func WithBurnCards(burn bool) HandOption {
+	return func(c *handConfig) {
+		c.burnCards = burn
+	}
+}
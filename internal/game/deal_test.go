@@ -0,0 +1,102 @@
+package game
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/lox/pokerforbots/v2/internal/randutil"
+	"github.com/lox/pokerforbots/v2/poker"
+)
+
+func TestDealOrderMatchesStandardDealing(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		seats  int
+		button int
+		order  []int // Seats in the order they receive hole cards
+	}{
+		{name: "four-handed", seats: 4, button: 2, order: []int{3, 0, 1, 2}},
+		{name: "heads-up starts with the big blind", seats: 2, button: 0, order: []int{1, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			deck := poker.NewDeck(randutil.New(2191))
+			cards := make([]poker.Card, 52)
+			for i := range cards {
+				cards[i] = deck.DealOne()
+			}
+			names := []string{"A", "B", "C", "D"}[:tt.seats]
+			h := NewHandState(randutil.New(1), names, tt.button, 5, 10,
+				WithDeck(poker.NewStackedDeck(cards...)), WithCardChecks())
+			for range 3 {
+				h.NextStreet()
+			}
+
+			// One card to each player clockwise, then the second, then the board
+			var want []DealtCard
+			for i := range 2 * tt.seats {
+				want = append(want, DealtCard{Card: cards[i], Street: Preflop, Seat: tt.order[i%tt.seats]})
+			}
+			next := 2 * tt.seats
+			for _, street := range []Street{Flop, Flop, Flop, Turn, River} {
+				want = append(want, DealtCard{Card: cards[next], Street: street, Seat: -1})
+				next++
+			}
+
+			if got := h.DealOrder(); !slices.Equal(got, want) {
+				t.Errorf("deal order = %v, want %v", got, want)
+			}
+			for _, dealt := range want {
+				if dealt.Seat >= 0 && !h.Players[dealt.Seat].HoleCards.HasCard(dealt.Card) {
+					t.Errorf("seat %d is missing dealt card %s", dealt.Seat, dealt.Card)
+				}
+			}
+			if got := h.BoardCards(); !slices.Equal(got, cards[2*tt.seats:2*tt.seats+5]) {
+				t.Errorf("board = %v, want %v", got, cards[2*tt.seats:2*tt.seats+5])
+			}
+		})
+	}
+}
+
+func TestBurnCards(t *testing.T) {
+	t.Parallel()
+
+	deck := poker.NewDeck(randutil.New(2192))
+	cards := make([]poker.Card, 52)
+	for i := range cards {
+		cards[i] = deck.DealOne()
+	}
+	h := NewHandState(randutil.New(1), []string{"A", "B", "C"}, 0, 5, 10,
+		WithDeck(poker.NewStackedDeck(cards...)), WithBurnCards(true), WithCardChecks())
+	for range 3 {
+		h.NextStreet()
+	}
+
+	// After six hole cards: burn, flop, burn, turn, burn, river
+	burns := []poker.Card{cards[6], cards[10], cards[12]}
+	board := []poker.Card{cards[7], cards[8], cards[9], cards[11], cards[13]}
+
+	var gotBurns []poker.Card
+	for _, dealt := range h.DealOrder() {
+		if dealt.Burn {
+			gotBurns = append(gotBurns, dealt.Card)
+			if h.Board.HasCard(dealt.Card) {
+				t.Errorf("burn card %s is on the board", dealt.Card)
+			}
+		}
+	}
+	if !slices.Equal(gotBurns, burns) {
+		t.Errorf("burns = %v, want %v", gotBurns, burns)
+	}
+	if got := h.BoardCards(); !slices.Equal(got, board) {
+		t.Errorf("board = %v, want %v", got, board)
+	}
+	if got := h.Deck.CardsRemaining(); got != 52-6-8 {
+		t.Errorf("expected %d cards left in the deck, got %d", 52-6-8, got)
+	}
+}
@@ -1,4 +1,5 @@
-// Package game implements the core poker game logic for Texas Hold'em.
+// Package game implements the core poker game logic for Texas Hold'em, with
+// Omaha Hi-Lo available through WithVariant.
 //
 // The main type is HandState, which manages the state of a single poker hand
 // including players, betting rounds, pot management, and winner determination.
@@ -13,7 +14,8 @@
 //	h.ProcessAction(game.Call, 0)
 //	// Check if hand is complete
 //	if h.IsComplete() {
-//	    winners := h.GetWinners()
+//	    winners := h.GetWinners() // Winning seats per pot
+//	    payouts := h.GetPayouts() // Chips won per seat, odd chips included
 //	}
 //
 // # Deterministic Testing
@@ -40,6 +42,19 @@
 //	h := game.NewHandState(rng, players, button, sb, bb,
 //	    game.WithDeck(deck))
 //
+//	// Omaha Hi-Lo, splitting each pot between high and eight-or-better low
+//	h := game.NewHandState(rng, players, button, sb, bb,
+//	    game.WithVariant(game.OmahaHiLo))
+//
+//	// Burning a card before each street, recorded in DealOrder
+//	h := game.NewHandState(rng, players, button, sb, bb,
+//	    game.WithBurnCards(true))
+//
+//	// Running the remaining board 10 times per street for study from a
+//	// separate stream, see StudyRunouts
+//	h := game.NewHandState(rng, players, button, sb, bb,
+//	    game.WithStudyRunouts(10, studyRNG))
+//
 // # Architecture
 //
 // HandState delegates responsibilities to specialized components:
@@ -47,6 +62,7 @@
 //   - PotManager: Handles pot collection and side pot calculations
 //   - poker.Deck: Provides shuffled cards with optional RNG injection
 //   - poker.Evaluate7Cards: Determines hand rankings and winners
+//   - poker.EvaluateLowA5: Determines the low winners in hi-lo games
 //
 // The design follows a stateless-per-hand approach where each hand is
 // independent, supporting high-performance concurrent execution.
@@ -1,8 +1,11 @@
 package game
 
 import (
+	"cmp"
+	"errors"
 	"fmt"
 	rand "math/rand/v2"
+	"slices"
 
 	"github.com/lox/pokerforbots/v2/poker"
 )
@@ -13,11 +16,43 @@ type HandState struct {
 	Button       int
 	Street       Street
 	Board        poker.Hand
-	boardOrder   []poker.Card
+	dealOrder    []DealtCard
 	PotManager   *PotManager
 	ActivePlayer int
 	Deck         *poker.Deck
 	Betting      *BettingRound // Encapsulates all betting state
+	Variant      Variant       // Game played, HoldEm unless set with WithVariant
+
+	maxActionsPerStreet int           // 0 means no cap
+	checkCards          bool          // Panic on duplicate or colliding cards
+	burnCards           bool          // Burn a card before each street's board cards
+	studyRunouts        int           // Runouts recorded per street, 0 disables
+	studyRNG            *rand.Rand    // Draws the study runouts after the first
+	studies             []StudyRunout // Runouts recorded so far
+	preflopOrder        []int         // Preflop acting order with a straddle, straddler last; nil without one
+}
+
+// ErrActionCapExceeded is returned by ProcessAction when a street exceeds the
+// configured action cap. Use errors.As with *ActionCapError for details.
+var ErrActionCapExceeded = errors.New("action cap exceeded")
+
+// ActionCapError describes the hand state when the per-street action cap was hit.
+type ActionCapError struct {
+	Street     Street
+	Seat       int
+	Actions    int
+	Cap        int
+	CurrentBet int
+	Pot        int
+}
+
+func (e *ActionCapError) Error() string {
+	return fmt.Sprintf("%v: %d actions on %s exceeds cap of %d (seat %d to act, current bet %d, pot %d)",
+		ErrActionCapExceeded, e.Actions, e.Street, e.Cap, e.Seat, e.CurrentBet, e.Pot)
+}
+
+func (e *ActionCapError) Unwrap() error {
+	return ErrActionCapExceeded
 }
 
 // HandOption configures a HandState during creation.
@@ -25,9 +60,21 @@ type HandOption func(*handConfig)
 
 // handConfig holds optional configuration for creating a hand.
 type handConfig struct {
-	chipCounts []int       // If nil, uses uniform starting chips
-	startChips int         // Default: 1000
-	deck       *poker.Deck // If provided, uses this deck (overrides RNG for deck creation)
+	chipCounts   []int       // If nil, uses uniform starting chips
+	startChips   int         // Default: 1000
+	deck         *poker.Deck // If provided, uses this deck (overrides RNG for deck creation)
+	maxActions   int         // Per-street action cap, 0 disables
+	missed       []int       // Seats owing missed blinds on re-entry
+	chipUnit     int         // Bets must be multiples of this, 0 or 1 disables
+	checkCards   bool        // Panic on duplicate or colliding cards
+	variant      Variant     // Game played, HoldEm by default
+	burnCards    bool        // Burn a card before each street's board cards
+	studyRuns    int         // Runouts recorded when betting closes, 0 disables
+	studyRNG     *rand.Rand  // Draws the study runouts, nil uses the hand's RNG
+	straddle     Straddle    // Straddle posted after the blinds
+	straddleSeat int         // Straddling seat for a Mississippi straddle
+	ante         int         // Posted by every player before the blinds, 0 disables
+	anteRule     AnteRule    // How antes are pooled
 }
 
 // NewHandState creates a new hand state with required RNG and optional configuration.
@@ -70,6 +117,9 @@ func NewHandState(rng *rand.Rand, playerNames []string, button int, smallBlind,
 	if cfg.chipCounts != nil && len(cfg.chipCounts) != len(playerNames) {
 		panic("chip counts must match number of players")
 	}
+	if cfg.chipUnit > 1 && (smallBlind%cfg.chipUnit != 0 || bigBlind%cfg.chipUnit != 0 || cfg.ante%cfg.chipUnit != 0) {
+		panic("blinds and ante must be multiples of the chip denomination")
+	}
 
 	// Build players
 	players := make([]*Player, len(playerNames))
@@ -102,10 +152,24 @@ func NewHandState(rng *rand.Rand, playerNames []string, button int, smallBlind,
 		Deck:       deck,
 		PotManager: NewPotManager(players),
 		Betting:    NewBettingRound(len(players), bigBlind),
+		Variant:    cfg.variant,
+
+		maxActionsPerStreet: cfg.maxActions,
+		burnCards:           cfg.burnCards,
+		studyRunouts:        cfg.studyRuns,
+		studyRNG:            cmp.Or(cfg.studyRNG, rng),
+	}
+
+	h.Betting.Denomination = cfg.chipUnit
+	if cfg.checkCards {
+		h.checkCards = true
+		deck.CheckDealt()
 	}
 
 	// Initialize the hand
-	h.postBlinds(smallBlind, bigBlind)
+	h.postAntes(cfg.ante, cfg.anteRule)
+	h.postBlinds(smallBlind, bigBlind, cfg.missed)
+	h.postStraddle(cfg.straddle, cfg.straddleSeat, bigBlind)
 	h.dealHoleCards()
 
 	// Set first active player
@@ -116,6 +180,9 @@ func NewHandState(rng *rand.Rand, playerNames []string, button int, smallBlind,
 		// Regular: UTG (button+3) acts first
 		h.ActivePlayer = h.nextActivePlayer((button + 3) % len(players))
 	}
+	if h.preflopOrder != nil {
+		h.ActivePlayer = h.nextToAct(h.StraddleSeat())
+	}
 
 	return h
 }
@@ -147,7 +214,44 @@ func WithDeck(deck *poker.Deck) HandOption {
 	}
 }
 
-func (h *HandState) postBlinds(smallBlind, bigBlind int) {
+// WithMaxActionsPerStreet caps the number of actions processed on a single street.
+// Once the cap is reached ProcessAction rejects everything except folds with an
+// *ActionCapError, so a misbehaving decision loop fails loudly instead of hanging.
+// Zero (the default) disables the cap.
+func WithMaxActionsPerStreet(n int) HandOption {
+	return func(c *handConfig) {
+		c.maxActions = n
+	}
+}
+
+// WithMissedBlinds marks seats returning after missing their blinds. Unless they
+// are already in the blinds, each posts a dead small blind straight into the pot
+// plus a live big blind that counts toward their preflop bet.
+func WithMissedBlinds(seats ...int) HandOption {
+	return func(c *handConfig) {
+		c.missed = seats
+	}
+}
+
+// WithChipDenomination requires raise amounts to be multiples of d, as when
+// modeling real stakes played with chips that can't be split. Blinds must also be
+// multiples of d. Going all-in for an odd stack is still allowed.
+func WithChipDenomination(d int) HandOption {
+	return func(c *handConfig) {
+		c.chipUnit = d
+	}
+}
+
+// WithCardChecks makes the hand panic if the deck deals a card twice or any
+// player's hole cards collide with another player's or the board. Meant for tests
+// and debugging dealing code.
+func WithCardChecks() HandOption {
+	return func(c *handConfig) {
+		c.checkCards = true
+	}
+}
+
+func (h *HandState) postBlinds(smallBlind, bigBlind int, missed []int) {
 	numPlayers := len(h.Players)
 
 	var sbPos, bbPos int
@@ -164,22 +268,58 @@ func (h *HandState) postBlinds(smallBlind, bigBlind int) {
 
 	// Small blind
 	h.Players[sbPos].Bet = min(smallBlind, h.Players[sbPos].Chips)
-	h.Players[sbPos].TotalBet = h.Players[sbPos].Bet
+	h.Players[sbPos].TotalBet += h.Players[sbPos].Bet
 	h.Players[sbPos].Chips -= h.Players[sbPos].Bet
+	if h.Players[sbPos].Chips == 0 {
+		h.Players[sbPos].AllInFlag = true
+	}
 
 	// Big blind
 	h.Players[bbPos].Bet = min(bigBlind, h.Players[bbPos].Chips)
-	h.Players[bbPos].TotalBet = h.Players[bbPos].Bet
+	h.Players[bbPos].TotalBet += h.Players[bbPos].Bet
 	h.Players[bbPos].Chips -= h.Players[bbPos].Bet
+	if h.Players[bbPos].Chips == 0 {
+		h.Players[bbPos].AllInFlag = true
+	}
+
+	// Returning players owe a dead small blind plus a live big blind
+	for _, seat := range missed {
+		if seat < 0 || seat >= numPlayers || seat == sbPos || seat == bbPos {
+			continue
+		}
+		p := h.Players[seat]
+		p.DeadBlind = min(smallBlind, p.Chips)
+		p.TotalBet += p.DeadBlind
+		p.Chips -= p.DeadBlind
+		h.PotManager.AddDeadMoney(p.DeadBlind)
+
+		p.Bet = min(bigBlind, p.Chips)
+		p.TotalBet += p.Bet
+		p.Chips -= p.Bet
+		if p.Chips == 0 {
+			p.AllInFlag = true
+		}
+	}
 
 	h.Betting.CurrentBet = bigBlind
 	// Don't collect bets yet - they stay in player.Bet until NextStreet
 }
 
-func (h *HandState) dealHoleCards() {
+// verifyCards panics if two players hold the same card or a hole card is also on
+// the board, when card checks are enabled.
+func (h *HandState) verifyCards() {
+	if !h.checkCards {
+		return
+	}
+	if h.Board.CountCards() != len(h.BoardCards()) {
+		panic(fmt.Sprintf("board %s has a duplicate card", h.Board))
+	}
+	seen := h.Board
 	for _, p := range h.Players {
-		cards := h.Deck.Deal(2)
-		p.HoleCards = poker.NewHand(cards...)
+		if p.HoleCards&seen != 0 {
+			panic(fmt.Sprintf("seat %d hole cards %s collide with %s", p.Seat, p.HoleCards, p.HoleCards&seen))
+		}
+		seen |= p.HoleCards
 	}
 }
 
@@ -195,23 +335,26 @@ func (h *HandState) GetValidActions() []Action {
 func (h *HandState) ProcessAction(action Action, amount int) error {
 	p := h.Players[h.ActivePlayer]
 
-	// Mark player as having acted in this round
-	h.Betting.MarkPlayerActed(h.ActivePlayer)
-
-	// Track if BB is acting preflop
-	if h.Street == Preflop {
-		var bbPos int
-		if len(h.Players) == 2 {
-			// Heads-up: button+1 is BB
-			bbPos = (h.Button + 1) % len(h.Players)
-		} else {
-			// Regular: button+2 is BB
-			bbPos = (h.Button + 2) % len(h.Players)
+	// Folds always make progress, so they are allowed past the cap
+	if h.maxActionsPerStreet > 0 && action != Fold && h.Betting.ActionCount >= h.maxActionsPerStreet {
+		pot := 0
+		for _, pt := range h.GetPots() {
+			pot += pt.Amount
 		}
-		if h.ActivePlayer == bbPos {
-			h.Betting.BBActed = true
+		return &ActionCapError{
+			Street:     h.Street,
+			Seat:       h.ActivePlayer,
+			Actions:    h.Betting.ActionCount,
+			Cap:        h.maxActionsPerStreet,
+			CurrentBet: h.Betting.CurrentBet,
+			Pot:        pot,
 		}
 	}
+	// A raise for more than the player has is an all-in for exactly their stack,
+	// whatever amount the client asked for
+	if action == Raise && amount > p.Chips+p.Bet {
+		action = AllIn
+	}
 
 	switch action {
 	case Fold:
@@ -232,30 +375,25 @@ func (h *HandState) ProcessAction(action Action, amount int) error {
 		}
 
 	case Raise:
-		// Check if player has enough chips for this raise
 		playerTotalChips := p.Chips + p.Bet
 
-		// If player is trying to raise more than they have, that's an error
-		if amount > playerTotalChips {
-			return fmt.Errorf("insufficient chips")
+		// Raises must use whole chips unless the player is moving all-in
+		if d := h.Betting.Denomination; d > 1 && amount%d != 0 && amount < playerTotalChips {
+			return fmt.Errorf("raise to %d is not a multiple of the %d chip denomination", amount, d)
 		}
 
 		// If player has enough chips, enforce minimum raise
 		// But if they're going all-in with less than min raise, allow it
-		if amount < h.Betting.CurrentBet+h.Betting.MinRaise {
+		if minRaiseTo := h.Betting.MinRaiseTo(); amount < minRaiseTo {
 			// Check if this is an all-in (player is putting in all their chips)
 			if amount < playerTotalChips {
 				// Player has more chips but trying to raise below minimum
-				return fmt.Errorf("raise too small, minimum %d", h.Betting.CurrentBet+h.Betting.MinRaise)
+				return fmt.Errorf("raise too small, minimum %d", minRaiseTo)
 			}
 			// Player is going all-in with less than min raise - this is allowed
 		}
 
 		raiseAmount := amount - p.Bet
-		h.Betting.MinRaise = amount - h.Betting.CurrentBet
-		h.Betting.CurrentBet = amount
-		h.Betting.LastRaiser = h.ActivePlayer
-
 		p.Chips -= raiseAmount
 		p.Bet = amount
 		p.TotalBet += raiseAmount
@@ -264,12 +402,7 @@ func (h *HandState) ProcessAction(action Action, amount int) error {
 		if p.Chips == 0 {
 			p.AllInFlag = true
 		}
-
-		// Reset acted flags when someone raises (everyone needs to act again)
-		for i := range h.Betting.ActedThisRound {
-			h.Betting.ActedThisRound[i] = false
-		}
-		h.Betting.ActedThisRound[h.ActivePlayer] = true
+		h.Betting.RecordRaise(h.ActivePlayer, amount)
 
 	case AllIn:
 		allInAmount := p.Chips
@@ -278,21 +411,31 @@ func (h *HandState) ProcessAction(action Action, amount int) error {
 		p.Bet += allInAmount
 		p.TotalBet += allInAmount
 
-		if p.Bet > h.Betting.CurrentBet {
-			h.Betting.MinRaise = p.Bet - h.Betting.CurrentBet
-			h.Betting.CurrentBet = p.Bet
-			h.Betting.LastRaiser = h.ActivePlayer
+		// An all-in for more than the bet acts as a raise
+		h.Betting.RecordRaise(h.ActivePlayer, p.Bet)
+	}
 
-			// Reset acted flags when all-in acts as a raise
-			for i := range h.Betting.ActedThisRound {
-				h.Betting.ActedThisRound[i] = false
-			}
-			h.Betting.ActedThisRound[h.ActivePlayer] = true
+	// Only actions that were applied count toward the cap and as having acted
+	h.Betting.ActionCount++
+	h.Betting.MarkPlayerActed(h.ActivePlayer)
+
+	// Track if BB is acting preflop
+	if h.Street == Preflop {
+		var bbPos int
+		if len(h.Players) == 2 {
+			// Heads-up: button+1 is BB
+			bbPos = (h.Button + 1) % len(h.Players)
+		} else {
+			// Regular: button+2 is BB
+			bbPos = (h.Button + 2) % len(h.Players)
+		}
+		if h.ActivePlayer == bbPos {
+			h.Betting.BBActed = true
 		}
 	}
 
 	// Move to next player
-	h.ActivePlayer = h.nextActivePlayer(h.ActivePlayer + 1)
+	h.ActivePlayer = h.nextToAct(h.ActivePlayer)
 
 	// Check if betting round is complete
 	// Note: ActivePlayer will be -1 if no active players left
@@ -337,10 +480,11 @@ func (h *HandState) ForceFold(seat int) {
 
 	// Advance the active player if the disconnected bot was due to act next.
 	if seat == h.ActivePlayer {
-		h.ActivePlayer = h.nextActivePlayer(seat + 1)
+		h.ActivePlayer = h.nextToAct(seat)
 	}
 
-	if h.ActivePlayer == -1 || h.Betting.IsBettingComplete(h.Players, h.Street, h.Button) {
+	// Folding out of turn can leave a single player in the hand, which ends it
+	if h.ActivePlayer == -1 || h.contestingPlayerCount() <= 1 || h.Betting.IsBettingComplete(h.Players, h.Street, h.Button) {
 		h.NextStreet()
 	}
 }
@@ -373,31 +517,25 @@ func (h *HandState) NextStreet() {
 		h.ActivePlayer = -1
 		return
 	}
+	h.recordStudyRunouts()
 
 	// Move to next street and deal community cards
 	switch h.Street {
 	case Preflop:
 		h.Street = Flop
-		cards := h.Deck.Deal(3)
-		for _, c := range cards {
-			h.boardOrder = append(h.boardOrder, c)
-			h.Board |= poker.Hand(c)
-		}
+		h.dealBoard(3)
 	case Flop:
 		h.Street = Turn
-		cards := h.Deck.Deal(1)
-		h.boardOrder = append(h.boardOrder, cards[0])
-		h.Board |= poker.Hand(cards[0])
+		h.dealBoard(1)
 	case Turn:
 		h.Street = River
-		cards := h.Deck.Deal(1)
-		h.boardOrder = append(h.boardOrder, cards[0])
-		h.Board |= poker.Hand(cards[0])
+		h.dealBoard(1)
 	case River:
 		h.Street = Showdown
 	case Showdown:
 		return
 	}
+	h.verifyCards()
 
 	// Set first active player for new street
 	h.ActivePlayer = h.nextActivePlayer((h.Button + 1) % len(h.Players))
@@ -420,16 +558,6 @@ func (h *HandState) contestingPlayerCount() int {
 	return count
 }
 
-// BoardCards returns the community cards in the order they were dealt.
-func (h *HandState) BoardCards() []poker.Card {
-	if len(h.boardOrder) == 0 {
-		return nil
-	}
-	cards := make([]poker.Card, len(h.boardOrder))
-	copy(cards, h.boardOrder)
-	return cards
-}
-
 // GetPots returns the current pots including uncollected bets
 func (h *HandState) GetPots() []Pot {
 	return h.PotManager.GetPotsWithUncollected(h.Players)
@@ -448,7 +576,8 @@ func (h *HandState) IsComplete() bool {
 	return h.Street == Showdown || activePlayers <= 1
 }
 
-// GetWinners determines the winners of each pot
+// GetWinners determines the winners of each pot. In hi-lo games a pot's
+// winners include both the high and the low winners.
 func (h *HandState) GetWinners() map[int][]int {
 	winners := make(map[int][]int) // pot index -> winner seats
 
@@ -457,39 +586,126 @@ func (h *HandState) GetWinners() map[int][]int {
 			continue
 		}
 
-		// If only one player eligible, they win
-		if len(pot.Eligible) == 1 {
-			winners[potIdx] = pot.Eligible
+		high, low := h.potWinners(pot)
+		for _, seat := range low {
+			if !slices.Contains(high, seat) {
+				high = append(high, seat)
+			}
+		}
+		winners[potIdx] = high
+	}
+
+	return winners
+}
+
+// WonHigh reports whether seat wins any pot, or part of one, with its high hand
+// rather than only with a low in hi-lo games.
+func (h *HandState) WonHigh(seat int) bool {
+	for _, pot := range h.GetPots() {
+		if len(pot.Eligible) == 0 {
 			continue
 		}
+		if high, _ := h.potWinners(pot); slices.Contains(high, seat) {
+			return true
+		}
+	}
+	return false
+}
 
-		// Evaluate hands
-		bestRank := poker.HandRank(0)
-		bestPlayers := []int{}
+// potWinners returns the seats with the best high hand and, in hi-lo games, the
+// best qualifying low among the players eligible for pot. low is empty when no
+// low qualifies.
+func (h *HandState) potWinners(pot Pot) (high, low []int) {
+	// If only one player eligible, they win
+	if len(pot.Eligible) == 1 {
+		return pot.Eligible, nil
+	}
 
-		for _, seat := range pot.Eligible {
-			p := h.Players[seat]
-			if p.Folded {
-				continue
-			}
+	// Evaluate hands
+	bestRank := poker.HandRank(0)
+	bestLow := poker.NoLow
+	high = []int{}
 
-			// Combine hole cards and board
-			fullHand := p.HoleCards | h.Board
-			rank := poker.Evaluate7Cards(fullHand)
+	for _, seat := range pot.Eligible {
+		p := h.Players[seat]
+		if p.Folded {
+			continue
+		}
 
-			cmp := poker.CompareHands(rank, bestRank)
-			if cmp > 0 {
-				bestRank = rank
-				bestPlayers = []int{seat}
-			} else if cmp == 0 {
-				bestPlayers = append(bestPlayers, seat)
-			}
+		rank := h.highRank(p)
+		cmp := poker.CompareHands(rank, bestRank)
+		if cmp > 0 {
+			bestRank = rank
+			high = []int{seat}
+		} else if cmp == 0 {
+			high = append(high, seat)
 		}
 
-		winners[potIdx] = bestPlayers
+		lowRank := h.lowRank(p)
+		if lowRank == poker.NoLow {
+			continue
+		}
+		cmp = poker.CompareLowHands(lowRank, bestLow)
+		if cmp > 0 {
+			bestLow = lowRank
+			low = []int{seat}
+		} else if cmp == 0 {
+			low = append(low, seat)
+		}
 	}
 
-	return winners
+	return high, low
+}
+
+// GetPayouts splits each pot between its winners and returns the chips won per
+// seat. Winners are decided pot by pot among the players eligible for it, so a
+// player all-in for less only shares the pots they contributed to and a side pot
+// goes to the best hand among the deeper players. In hi-lo games each pot is
+// halved between the high and low winners, with the odd chip going high, and
+// the high winners scoop it when no low qualifies. Chips that don't divide
+// evenly between tied winners go one at a time to the winners closest to the
+// left of the button.
+func (h *HandState) GetPayouts() map[int]int {
+	payouts := make(map[int]int)
+	for _, pot := range h.GetPots() {
+		if len(pot.Eligible) == 0 {
+			continue
+		}
+		high, low := h.potWinners(pot)
+		if len(high) == 0 {
+			continue
+		}
+		highShare, lowShare := pot.hiLoShares(len(low) > 0)
+		h.award(payouts, highShare, high)
+		h.award(payouts, lowShare, low)
+	}
+	return payouts
+}
+
+// award splits amount between winners, giving chips that don't divide evenly
+// one at a time to the winners closest to the left of the button.
+func (h *HandState) award(payouts map[int]int, amount int, winners []int) {
+	if len(winners) == 0 {
+		return
+	}
+	share, oddChips := amount/len(winners), amount%len(winners)
+	for _, seat := range h.seatsLeftOfButton(winners) {
+		payouts[seat] += share
+		if oddChips > 0 {
+			payouts[seat]++
+			oddChips--
+		}
+	}
+}
+
+// seatsLeftOfButton orders seats clockwise starting from the seat after the button.
+func (h *HandState) seatsLeftOfButton(seats []int) []int {
+	n := len(h.Players)
+	ordered := slices.Clone(seats)
+	slices.SortFunc(ordered, func(a, b int) int {
+		return cmp.Compare((a-h.Button-1+n)%n, (b-h.Button-1+n)%n)
+	})
+	return ordered
 }
 
 func min(a, b int) int {
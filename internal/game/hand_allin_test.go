@@ -45,6 +45,39 @@ func TestAllInRaiseBelowMinimum(t *testing.T) {
 	// Let me reconsider this test...
 }
 
+// TestRaiseAboveStackBecomesAllIn tests that a raise for more than the player
+// has is converted to an all-in for exactly their stack
+func TestRaiseAboveStackBecomesAllIn(t *testing.T) {
+	t.Parallel()
+	players := []string{"Alice", "Bob", "Charlie"}
+	h := NewHandState(randutil.New(42), players, 0, 5, 10, WithChipsByPlayer([]int{100, 1000, 1000}))
+
+	// Alice (UTG) asks to raise to 500 with only 100 chips
+	if err := h.ProcessAction(Raise, 500); err != nil {
+		t.Fatalf("raise above stack should become an all-in: %v", err)
+	}
+
+	alice := h.Players[0]
+	if !alice.AllInFlag || alice.Chips != 0 {
+		t.Errorf("Alice should be all-in with no chips, got all-in=%v chips=%d", alice.AllInFlag, alice.Chips)
+	}
+	if alice.Bet != 100 || alice.TotalBet != 100 {
+		t.Errorf("Alice should have bet exactly her 100 stack, got bet=%d total=%d", alice.Bet, alice.TotalBet)
+	}
+	if h.Betting.CurrentBet != 100 {
+		t.Errorf("current bet should be 100, got %d", h.Betting.CurrentBet)
+	}
+
+	// Blinds of 5 and 10 plus Alice's 100
+	pot := 0
+	for _, p := range h.GetPots() {
+		pot += p.Amount
+	}
+	if pot != 115 {
+		t.Errorf("pot should hold only the 115 chips actually bet, got %d", pot)
+	}
+}
+
 // TestAllInRaiseAboveCurrentBetButBelowMinimum tests the scenario where
 // a player goes all-in with a raise that's above the current bet but below minimum raise
 func TestAllInRaiseAboveCurrentBetButBelowMinimum(t *testing.T) {
@@ -323,3 +356,72 @@ func TestAllInShowdownCompletes(t *testing.T) {
 		t.Error("Hand should be complete at showdown")
 	}
 }
+
+// TestPayoutsTieAcrossMainAndSidePot verifies that when two players tie for the
+// best hand but one is all-in for less, the main pot is split between them and
+// the side pot goes entirely to the deeper player.
+func TestPayoutsTieAcrossMainAndSidePot(t *testing.T) {
+	t.Parallel()
+	players := []string{"Alice", "Bob", "Charlie"}
+	h := NewHandState(randutil.New(42), players, 0, 5, 10, WithChipsByPlayer([]int{100, 300, 300}))
+
+	for _, name := range players {
+		if err := h.ProcessAction(AllIn, 0); err != nil {
+			t.Fatalf("%s all-in failed: %v", name, err)
+		}
+	}
+	if h.Street != Showdown {
+		t.Fatalf("Should be at showdown, got %v", h.Street)
+	}
+
+	// Alice and Bob tie with aces; Charlie's kings lose
+	h.Players[0].HoleCards = parseCards("As", "Ah")
+	h.Players[1].HoleCards = parseCards("Ac", "Ad")
+	h.Players[2].HoleCards = parseCards("Kc", "Kd")
+	h.Board = parseCards("2s", "7d", "9c", "Jh", "3s")
+
+	pots := h.GetPots()
+	if len(pots) != 2 || pots[0].Amount != 300 || pots[1].Amount != 400 {
+		t.Fatalf("Expected a 300 main pot and a 400 side pot, got %+v", pots)
+	}
+
+	payouts := h.GetPayouts()
+	want := map[int]int{0: 150, 1: 550}
+	if len(payouts) != len(want) {
+		t.Fatalf("Expected payouts %v, got %v", want, payouts)
+	}
+	for seat, amount := range want {
+		if payouts[seat] != amount {
+			t.Errorf("Seat %d: expected %d, got %d", seat, amount, payouts[seat])
+		}
+	}
+}
+
+// TestPayoutsOddChipGoesLeftOfButton verifies that a pot that doesn't split evenly
+// awards the odd chip to the tied winner closest to the left of the button.
+func TestPayoutsOddChipGoesLeftOfButton(t *testing.T) {
+	t.Parallel()
+	players := []string{"Alice", "Bob", "Charlie"}
+	h := NewHandState(randutil.New(42), players, 0, 5, 10, WithChips(1000))
+
+	// Alice (button) calls, Bob (SB) completes and Charlie (BB) checks: 30 in the pot
+	for _, action := range []Action{Call, Call, Check} {
+		if err := h.ProcessAction(action, 0); err != nil {
+			t.Fatalf("Preflop %v failed: %v", action, err)
+		}
+	}
+	h.PotManager.AddDeadMoney(1)
+
+	// Alice and Charlie tie; Bob loses
+	h.Players[0].HoleCards = parseCards("As", "Ah")
+	h.Players[1].HoleCards = parseCards("Kc", "Kd")
+	h.Players[2].HoleCards = parseCards("Ac", "Ad")
+	h.Board = parseCards("2s", "7d", "9c", "Jh", "3s")
+	h.Street = Showdown
+
+	payouts := h.GetPayouts()
+	// Clockwise from the button Charlie (seat 2) comes before Alice (seat 0)
+	if payouts[2] != 16 || payouts[0] != 15 || payouts[1] != 0 {
+		t.Errorf("Expected Charlie 16 and Alice 15 of the 31 chip pot, got %v", payouts)
+	}
+}
@@ -1,10 +1,13 @@
 package game
 
 import (
+	"errors"
 	"reflect"
 	"slices"
 	"strings"
 	"testing"
+
+	"github.com/lox/pokerforbots/v2/internal/randutil"
 )
 
 type playerConfig struct {
@@ -212,22 +215,6 @@ func TestProcessActionInvalidInputs(t *testing.T) {
 			amount:  35,
 			wantErr: "minimum",
 		},
-		{
-			name: "raise exceeding stack",
-			state: buildHandState(
-				[]playerConfig{
-					{chips: 100, bet: 20, totalBet: 20, acted: true},
-					{chips: 90, bet: 20, totalBet: 20, acted: true},
-					{chips: 90, bet: 20, totalBet: 20, acted: true},
-				},
-				20,
-				20,
-				0,
-			),
-			action:  Raise,
-			amount:  200,
-			wantErr: "insufficient",
-		},
 	}
 
 	for _, tc := range cases {
@@ -305,6 +292,67 @@ func TestProcessActionAllInUpdatesState(t *testing.T) {
 	}
 }
 
+func TestMinRaiseTracksLastRaiseIncrement(t *testing.T) {
+	t.Parallel()
+
+	// Four-handed 1/2 with the button in seat 0, so UTG in seat 3 acts first
+	h := NewHandState(randutil.New(1), []string{"A", "B", "C", "D"}, 0, 1, 2,
+		WithChipsByPlayer([]int{100, 100, 100, 15}))
+
+	if err := h.ProcessAction(Raise, 6); err != nil {
+		t.Fatalf("raise to 6: %v", err)
+	}
+	if h.Betting.MinRaise != 4 {
+		t.Fatalf("expected the raise increment of 4 to be the minimum raise, got %d", h.Betting.MinRaise)
+	}
+	if err := h.ProcessAction(Raise, 9); err == nil {
+		t.Fatal("expected a re-raise to 9 to be rejected")
+	}
+	if err := h.ProcessAction(Raise, 10); err != nil {
+		t.Fatalf("re-raise to 10: %v", err)
+	}
+
+	// A re-raise to 30 makes the increment 20, not double the bet
+	if err := h.ProcessAction(Raise, 30); err != nil {
+		t.Fatalf("re-raise to 30: %v", err)
+	}
+	if h.Betting.CurrentBet != 30 || h.Betting.MinRaise != 20 {
+		t.Fatalf("expected bet 30 with a minimum raise of 20, got %d and %d", h.Betting.CurrentBet, h.Betting.MinRaise)
+	}
+}
+
+func TestShortAllInKeepsMinRaise(t *testing.T) {
+	t.Parallel()
+
+	state := buildHandState(
+		[]playerConfig{
+			{chips: 100, bet: 100},
+			{chips: 130, bet: 0},
+			{chips: 300, bet: 0},
+		},
+		100,
+		100,
+		1,
+	)
+
+	// All-in to 130 only raises by 30, short of the 100 needed for a full raise
+	if err := state.ProcessAction(AllIn, 0); err != nil {
+		t.Fatalf("all-in: %v", err)
+	}
+	if state.Betting.CurrentBet != 130 {
+		t.Fatalf("expected the bet to rise to 130, got %d", state.Betting.CurrentBet)
+	}
+	if state.Betting.MinRaise != 100 {
+		t.Fatalf("expected the minimum raise to stay 100, got %d", state.Betting.MinRaise)
+	}
+	if err := state.ProcessAction(Raise, 200); err == nil {
+		t.Fatal("expected a raise to 200 to be below the 230 minimum")
+	}
+	if err := state.ProcessAction(Raise, 230); err != nil {
+		t.Fatalf("raise to 230: %v", err)
+	}
+}
+
 func TestIsBettingCompleteHonorsBigBlindOption(t *testing.T) {
 	t.Parallel()
 
@@ -336,6 +384,72 @@ func TestIsBettingCompleteHonorsBigBlindOption(t *testing.T) {
 	}
 }
 
+func TestActionCapStopsRunawayStreet(t *testing.T) {
+	t.Parallel()
+
+	const maxActions = 50
+	h := NewHandState(randutil.New(42), []string{"Alice", "Bob", "Carol"}, 0, 5, 10,
+		WithChips(1_000_000), WithMaxActionsPerStreet(maxActions))
+
+	// A decision function that never lets the street resolve: always min-raise
+	decide := func(h *HandState) (Action, int) {
+		return Raise, h.Betting.CurrentBet + h.Betting.MinRaise
+	}
+
+	var err error
+	for i := 0; i < 10*maxActions && err == nil; i++ {
+		action, amount := decide(h)
+		err = h.ProcessAction(action, amount)
+	}
+
+	if !errors.Is(err, ErrActionCapExceeded) {
+		t.Fatalf("expected ErrActionCapExceeded, got %v", err)
+	}
+
+	var capErr *ActionCapError
+	if !errors.As(err, &capErr) {
+		t.Fatalf("expected *ActionCapError, got %T", err)
+	}
+	if capErr.Street != Preflop || capErr.Actions != maxActions || capErr.Cap != maxActions {
+		t.Fatalf("unexpected cap error details: %+v", capErr)
+	}
+	if capErr.Seat != h.ActivePlayer {
+		t.Fatalf("expected offending seat %d, got %d", h.ActivePlayer, capErr.Seat)
+	}
+	if capErr.CurrentBet != h.Betting.CurrentBet || capErr.Pot <= capErr.CurrentBet {
+		t.Fatalf("expected cap error to capture betting state, got %+v", capErr)
+	}
+	if msg := err.Error(); !strings.Contains(msg, "preflop") || !strings.Contains(msg, "seat") {
+		t.Fatalf("expected descriptive error, got %q", msg)
+	}
+
+	// Folding still makes progress once the cap is hit
+	if err := h.ProcessAction(Fold, 0); err != nil {
+		t.Fatalf("fold should be allowed past the cap: %v", err)
+	}
+}
+
+func TestActionCapIgnoresRejectedActions(t *testing.T) {
+	t.Parallel()
+
+	const maxActions = 2
+	h := NewHandState(randutil.New(42), []string{"Alice", "Bob", "Carol"}, 0, 5, 10,
+		WithChips(1000), WithMaxActionsPerStreet(maxActions))
+
+	// Invalid checks facing the big blind are rejected and don't use up the cap
+	for range 5 {
+		if err := h.ProcessAction(Check, 0); err == nil || errors.Is(err, ErrActionCapExceeded) {
+			t.Fatalf("expected the check to be rejected as invalid, got %v", err)
+		}
+	}
+	if h.Betting.ActionCount != 0 {
+		t.Fatalf("rejected actions counted toward the cap: %d", h.Betting.ActionCount)
+	}
+	if err := h.ProcessAction(Call, 0); err != nil {
+		t.Fatalf("valid call after rejected actions: %v", err)
+	}
+}
+
 func TestForceFoldOutOfTurn(t *testing.T) {
 	t.Parallel()
 
@@ -360,6 +474,30 @@ func TestForceFoldOutOfTurn(t *testing.T) {
 	}
 }
 
+func TestForceFoldLastOpponentEndsHand(t *testing.T) {
+	t.Parallel()
+
+	// Heads-up preflop: the small blind is to act when the big blind disconnects
+	state := buildHandState(
+		[]playerConfig{
+			{chips: 95, bet: 5, acted: false},
+			{chips: 90, bet: 10, acted: false},
+		},
+		10,
+		10,
+		0,
+	)
+
+	state.ForceFold(1)
+
+	if !state.IsComplete() || state.Street != Showdown {
+		t.Fatalf("expected hand to end once one player remains, got street %s", state.Street)
+	}
+	if state.ActivePlayer != -1 {
+		t.Fatalf("expected no active player, got %d", state.ActivePlayer)
+	}
+}
+
 func TestForceFoldActiveSeat(t *testing.T) {
 	t.Parallel()
 
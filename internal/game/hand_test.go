@@ -4,6 +4,7 @@ import (
 	"github.com/lox/pokerforbots/v2/internal/randutil"
 
 	"slices"
+	"strings"
 	"testing"
 
 	"github.com/lox/pokerforbots/v2/poker"
@@ -497,7 +498,7 @@ func TestShortStackCantCoverBlinds(t *testing.T) {
 	h.Players[1].Bet = 0
 
 	// Post blinds again
-	h.postBlinds(5, 10)
+	h.postBlinds(5, 10, nil)
 
 	// Player should be all-in for 3 chips
 	if h.Players[1].Chips != 0 {
@@ -509,6 +510,87 @@ func TestShortStackCantCoverBlinds(t *testing.T) {
 	}
 }
 
+// TestMissedBlindsShortStackIsAllIn tests a returning player whose dead and live
+// blinds take their last chip is all-in and never asked to act
+func TestMissedBlindsShortStackIsAllIn(t *testing.T) {
+	t.Parallel()
+	players := []string{"Alice", "Bob", "Charlie", "Dave", "Eve"}
+
+	// Button 0: SB seat 1, BB seat 2, UTG seat 3; Eve returns with 12 chips
+	h := NewHandState(randutil.New(42), players, 0, 5, 10,
+		WithChipsByPlayer([]int{1000, 1000, 1000, 1000, 12}), WithMissedBlinds(4))
+
+	eve := h.Players[4]
+	if eve.DeadBlind != 5 || eve.Bet != 7 || eve.Chips != 0 || !eve.AllInFlag {
+		t.Fatalf("expected dead 5, live 7 and all-in; got dead %d bet %d chips %d all-in %v",
+			eve.DeadBlind, eve.Bet, eve.Chips, eve.AllInFlag)
+	}
+
+	// UTG calls and action passes over Eve to the button
+	if err := h.ProcessAction(Call, 0); err != nil {
+		t.Fatal(err)
+	}
+	if h.ActivePlayer != 0 {
+		t.Fatalf("expected the button to act after UTG, got seat %d", h.ActivePlayer)
+	}
+}
+
+// TestMissedBlindsPostDeadAndLiveBlind tests a returning player posting a dead small
+// blind plus a live big blind, while a returning player already in the blinds posts normally
+func TestMissedBlindsPostDeadAndLiveBlind(t *testing.T) {
+	t.Parallel()
+	players := []string{"Alice", "Bob", "Charlie", "Dave", "Eve", "Frank"}
+
+	// Button 0: SB seat 1, BB seat 2; seats 1 and 4 are returning
+	h := NewHandState(randutil.New(42), players, 0, 5, 10, WithChips(1000), WithMissedBlinds(1, 4))
+
+	if h.Players[1].DeadBlind != 0 || h.Players[1].Bet != 5 {
+		t.Errorf("returning small blind should post normally, got dead %d bet %d", h.Players[1].DeadBlind, h.Players[1].Bet)
+	}
+
+	eve := h.Players[4]
+	if eve.DeadBlind != 5 || eve.Bet != 10 || eve.TotalBet != 15 || eve.Chips != 985 {
+		t.Fatalf("expected dead 5, live 10, total 15, chips 985; got dead %d bet %d total %d chips %d",
+			eve.DeadBlind, eve.Bet, eve.TotalBet, eve.Chips)
+	}
+
+	pot := 0
+	for _, p := range h.GetPots() {
+		pot += p.Amount
+	}
+	if pot != 30 {
+		t.Errorf("expected pot of 30 with the dead blind, got %d", pot)
+	}
+
+	// UTG folds; the returning player's live blind lets them check
+	if err := h.ProcessAction(Fold, 0); err != nil {
+		t.Fatal(err)
+	}
+	if h.ActivePlayer != 4 {
+		t.Fatalf("expected returning player to act, got seat %d", h.ActivePlayer)
+	}
+	if err := h.ProcessAction(Check, 0); err != nil {
+		t.Fatalf("returning player should be able to check their live blind: %v", err)
+	}
+	for range 3 { // Frank, Alice, Bob fold
+		if err := h.ProcessAction(Fold, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if h.Street != Preflop || h.ActivePlayer != 2 {
+		t.Fatalf("big blind should still have the option, got street %s seat %d", h.Street, h.ActivePlayer)
+	}
+	if err := h.ProcessAction(Check, 0); err != nil {
+		t.Fatal(err)
+	}
+	if h.Street != Flop {
+		t.Fatalf("expected flop, got %s", h.Street)
+	}
+	if total := h.PotManager.Total(); total != 30 {
+		t.Errorf("expected 30 in the pot on the flop, got %d", total)
+	}
+}
+
 // TestAceLowStraight tests the wheel straight (A-2-3-4-5)
 func TestAceLowStraight(t *testing.T) {
 	t.Parallel()
@@ -931,3 +1013,136 @@ func TestNewHandStateDeterministic(t *testing.T) {
 		}
 	}
 }
+
+// TestChipDenominationRejectsOddRaises tests that raises must be whole multiples of
+// the chip denomination, except when a player moves all-in for an odd stack
+func TestChipDenominationRejectsOddRaises(t *testing.T) {
+	t.Parallel()
+	players := []string{"Alice", "Bob", "Charlie"}
+
+	// Button 0 acts first three-handed; Charlie in the big blind has an odd stack
+	h := NewHandState(randutil.New(42), players, 0, 5, 10,
+		WithChipsByPlayer([]int{1000, 1000, 997}), WithChipDenomination(5))
+
+	err := h.ProcessAction(Raise, 27)
+	if err == nil || !strings.Contains(err.Error(), "denomination") {
+		t.Fatalf("expected raise to 27 to be rejected, got %v", err)
+	}
+	if h.ActivePlayer != 0 || h.Players[0].Bet != 0 || h.Betting.CurrentBet != 10 {
+		t.Fatalf("rejected raise should not change the hand, got seat %d bet %d current bet %d",
+			h.ActivePlayer, h.Players[0].Bet, h.Betting.CurrentBet)
+	}
+
+	if err := h.ProcessAction(Raise, 30); err != nil {
+		t.Fatalf("raise to 30 should be accepted: %v", err)
+	}
+	if err := h.ProcessAction(Fold, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.ProcessAction(Raise, 997); err != nil {
+		t.Fatalf("all-in raise for an odd stack should be accepted: %v", err)
+	}
+	if !h.Players[2].AllInFlag || h.Betting.CurrentBet != 997 {
+		t.Errorf("expected Charlie all-in for 997, got all-in %v current bet %d", h.Players[2].AllInFlag, h.Betting.CurrentBet)
+	}
+}
+
+// TestChipDenominationRoundsMinRaiseAfterShortAllIn tests that a short all-in
+// leaving the bet off the denomination rounds the next minimum raise up to a
+// whole chip, both where it is reported and where it is enforced
+func TestChipDenominationRoundsMinRaiseAfterShortAllIn(t *testing.T) {
+	t.Parallel()
+	players := []string{"Alice", "Bob", "Charlie"}
+
+	// Alice on the button acts first and moves all-in for an odd 27
+	h := NewHandState(randutil.New(42), players, 0, 5, 10,
+		WithChipsByPlayer([]int{27, 1000, 1000}), WithChipDenomination(5))
+	if err := h.ProcessAction(Raise, 27); err != nil {
+		t.Fatalf("all-in to 27 should be accepted: %v", err)
+	}
+
+	// 27 plus the 17 raise increment is 44, which isn't a whole chip
+	if got := h.Betting.MinRaiseTo(); got != 45 {
+		t.Fatalf("expected minimum raise to 45, got %d", got)
+	}
+	err := h.ProcessAction(Raise, 40)
+	if err == nil || !strings.Contains(err.Error(), "minimum 45") {
+		t.Fatalf("expected raise to 40 to be rejected with minimum 45, got %v", err)
+	}
+	if err := h.ProcessAction(Raise, 45); err != nil {
+		t.Fatalf("raise to 45 should be accepted: %v", err)
+	}
+	if h.Betting.CurrentBet != 45 {
+		t.Errorf("expected current bet 45, got %d", h.Betting.CurrentBet)
+	}
+}
+
+// TestChipDenominationRequiresWholeBlinds tests that blinds must be multiples of the denomination
+func TestChipDenominationRequiresWholeBlinds(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected blinds of 5/12 with a denomination of 5 to panic")
+		}
+	}()
+	NewHandState(randutil.New(42), []string{"Alice", "Bob"}, 0, 5, 12, WithChipDenomination(5))
+}
+
+// TestChipDenominationRequiresWholeAnte tests that the ante must be a multiple of the denomination
+func TestChipDenominationRequiresWholeAnte(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected an ante of 3 with a denomination of 5 to panic")
+		}
+	}()
+	NewHandState(randutil.New(42), []string{"Alice", "Bob"}, 0, 5, 10,
+		WithChipDenomination(5), WithAnte(3, AnteMainPot))
+}
+
+// TestCardChecks verifies checked hands never deal a card twice and that a deck
+// dealing a duplicate is caught.
+func TestCardChecks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("full hands never duplicate", func(t *testing.T) {
+		for seed := range int64(50) {
+			rng := randutil.New(seed)
+			h := NewHandState(rng, []string{"A", "B", "C", "D", "E", "F"}, 0, 5, 10, WithCardChecks())
+			for h.Street != Showdown {
+				h.NextStreet()
+			}
+
+			seen := h.Board
+			for _, p := range h.Players {
+				if p.HoleCards.CountCards() != 2 || p.HoleCards&seen != 0 {
+					t.Fatalf("seed %d: seat %d hole cards %s collide with %s", seed, p.Seat, p.HoleCards, seen)
+				}
+				seen |= p.HoleCards
+			}
+			if got := seen.CountCards(); got != 6*2+5 {
+				t.Fatalf("seed %d: expected 17 distinct cards dealt, got %d", seed, got)
+			}
+		}
+	})
+
+	t.Run("duplicate card is caught", func(t *testing.T) {
+		cards := []poker.Card{
+			poker.NewCard(poker.Ace, poker.Spades), poker.NewCard(poker.King, poker.Spades), // Alice
+			poker.NewCard(poker.Two, poker.Clubs), poker.NewCard(poker.Three, poker.Clubs), // Bob
+			poker.NewCard(poker.Ace, poker.Spades), // Flop repeats Alice's ace
+			poker.NewCard(poker.Four, poker.Clubs), poker.NewCard(poker.Five, poker.Clubs),
+		}
+		h := NewHandState(randutil.New(42), []string{"Alice", "Bob"}, 0, 5, 10,
+			WithDeck(poker.NewStackedDeck(cards...)), WithCardChecks())
+
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic when the flop repeats a hole card")
+			}
+		}()
+		h.NextStreet()
+	})
+}
@@ -14,6 +14,8 @@ type Player struct {
 	AllInFlag bool
 	Bet       int // Current bet in this round
 	TotalBet  int // Total bet in the hand
+	DeadBlind int // Dead small blind posted on re-entry, already in the pot
+	Ante      int // Ante posted this hand, already in the pot
 }
 
 // IsActive returns true if the player can still act
@@ -7,9 +7,20 @@ type Pot struct {
 	MaxPerPlayer int   // Maximum contribution per player
 }
 
+// hiLoShares splits the pot between its high and low halves. The odd chip goes
+// to the high half, and the high half is the whole pot when no low qualifies.
+func (p Pot) hiLoShares(hasLow bool) (high, low int) {
+	if !hasLow {
+		return p.Amount, 0
+	}
+	low = p.Amount / 2
+	return p.Amount - low, low
+}
+
 // PotManager manages main and side pots
 type PotManager struct {
-	pots []Pot
+	pots  []Pot
+	antes int // Antes kept in a pot of their own, see AnteSeparatePot
 }
 
 // NewPotManager creates a new pot manager
@@ -42,6 +53,18 @@ func (pm *PotManager) Total() int {
 	return total
 }
 
+// AddDeadMoney adds chips to the main pot that don't count toward any player's bet
+func (pm *PotManager) AddDeadMoney(amount int) {
+	pm.pots[0].Amount += amount
+}
+
+// AddAntes adds antes that form a separate pot, contested by every player
+// still in, ahead of the main and side pots.
+func (pm *PotManager) AddAntes(amount int) {
+	pm.pots[0].Amount += amount
+	pm.antes += amount
+}
+
 // CollectBets collects bets from players and adds to the main pot
 func (pm *PotManager) CollectBets(players []*Player) {
 	for _, player := range players {
@@ -62,7 +85,7 @@ func (pm *PotManager) CalculateSidePots(players []*Player) {
 		}
 	}
 
-	if len(allInAmounts) == 0 {
+	if len(allInAmounts) == 0 && pm.antes == 0 {
 		return // No all-ins, just main pot
 	}
 
@@ -81,8 +104,11 @@ func (pm *PotManager) CalculateSidePots(players []*Player) {
 		}
 	}
 
-	// Reset pots
+	// Reset pots, starting with the separate ante pot if there is one
 	pm.pots = []Pot{}
+	if pm.antes > 0 {
+		pm.pots = append(pm.pots, Pot{Amount: pm.antes, Eligible: makeEligible(players)})
+	}
 
 	// Create side pots for each all-in level
 	previousMax := 0
@@ -115,17 +141,25 @@ func (pm *PotManager) CalculateSidePots(players []*Player) {
 		previousMax = maxBet
 	}
 
-	// Create main pot for any remaining chips
+	// Create main pot for any remaining chips, including those of players who
+	// bet past the last all-in and then folded
 	mainPot := Pot{}
 	for _, p := range players {
-		if !p.Folded && p.TotalBet > previousMax {
-			mainPot.Eligible = append(mainPot.Eligible, p.Seat)
+		if p.TotalBet > previousMax {
 			mainPot.Amount += p.TotalBet - previousMax
+			if !p.Folded {
+				mainPot.Eligible = append(mainPot.Eligible, p.Seat)
+			}
 		}
 	}
 
-	if mainPot.Amount > 0 && len(mainPot.Eligible) > 0 {
+	switch {
+	case mainPot.Amount > 0 && len(mainPot.Eligible) > 0:
 		pm.pots = append(pm.pots, mainPot)
+	case mainPot.Amount > 0 && len(pm.pots) > 0:
+		// Everyone who could contest the remainder folded, so it's dead money in
+		// the deepest pot still being contested
+		pm.pots[len(pm.pots)-1].Amount += mainPot.Amount
 	}
 }
 
@@ -0,0 +1,207 @@
+package game
+
+import (
+	"maps"
+	"strings"
+	"testing"
+)
+
+// showdownSeat is one player's contribution and cards at showdown.
+type showdownSeat struct {
+	hole     string // Space separated, e.g. "As Ah"
+	totalBet int
+	allIn    bool
+	folded   bool
+}
+
+// showdownHand builds a hand at showdown with pots formed from each seat's total
+// contribution, the same way the engine collects them over the streets.
+func showdownHand(button int, board string, seats []showdownSeat) *HandState {
+	players := make([]*Player, len(seats))
+	total := 0
+	for i, seat := range seats {
+		players[i] = &Player{
+			Seat:      i,
+			HoleCards: parseCards(strings.Fields(seat.hole)...),
+			TotalBet:  seat.totalBet,
+			AllInFlag: seat.allIn,
+		}
+		total += seat.totalBet
+	}
+
+	pm := NewPotManager(players)
+	pm.pots[0].Amount = total
+	for i, seat := range seats {
+		players[i].Folded = seat.folded
+	}
+	pm.CalculateSidePots(players)
+
+	return &HandState{
+		Players:    players,
+		Button:     button,
+		Street:     Showdown,
+		Board:      parseCards(strings.Fields(board)...),
+		PotManager: pm,
+	}
+}
+
+// TestPotDistribution is the canonical suite for how pots are split at showdown,
+// asserting exactly what each seat is awarded.
+func TestPotDistribution(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		button int
+		board  string
+		seats  []showdownSeat
+		want   map[int]int // Chips awarded per seat; seats not listed win nothing
+	}{
+		{
+			name:  "single pot, best hand wins",
+			board: "2s 7d 9c Jh 3s",
+			seats: []showdownSeat{
+				{hole: "As Ah", totalBet: 100},
+				{hole: "Kc Kd", totalBet: 100},
+				{hole: "Qc Qd", totalBet: 100},
+			},
+			want: map[int]int{0: 300},
+		},
+		{
+			name:  "single pot, two-way tie",
+			board: "2s 7d 9c Jh 3s",
+			seats: []showdownSeat{
+				{hole: "As Ah", totalBet: 100},
+				{hole: "Ac Ad", totalBet: 100},
+				{hole: "Kc Kd", totalBet: 100},
+			},
+			want: map[int]int{0: 150, 1: 150},
+		},
+		{
+			name:  "one side pot, short stack wins the main pot",
+			board: "2s 7d 9c Jh 3s",
+			seats: []showdownSeat{
+				{hole: "As Ah", totalBet: 50, allIn: true},
+				{hole: "Kc Kd", totalBet: 150},
+				{hole: "Qc Qd", totalBet: 150},
+			},
+			want: map[int]int{0: 150, 1: 200},
+		},
+		{
+			name:  "one side pot, short stack loses",
+			board: "2s 7d 9c Jh 3s",
+			seats: []showdownSeat{
+				{hole: "Qc Qd", totalBet: 50, allIn: true},
+				{hole: "As Ah", totalBet: 150},
+				{hole: "Kc Kd", totalBet: 150},
+			},
+			want: map[int]int{1: 350},
+		},
+		{
+			name:  "two side pots, each won by a different player",
+			board: "2s 7d 9c Jh 3s",
+			seats: []showdownSeat{
+				{hole: "As Ah", totalBet: 50, allIn: true},
+				{hole: "Kc Kd", totalBet: 100, allIn: true},
+				{hole: "Qc Qd", totalBet: 200},
+				{hole: "8c 6d", totalBet: 200},
+			},
+			// Main 4x50, first side 3x50, second side 2x100
+			want: map[int]int{0: 200, 1: 150, 2: 200},
+		},
+		{
+			name:  "two side pots, deepest player wins everything",
+			board: "2s 7d 9c Jh 3s",
+			seats: []showdownSeat{
+				{hole: "Qc Qd", totalBet: 50, allIn: true},
+				{hole: "Kc Kd", totalBet: 100, allIn: true},
+				{hole: "As Ah", totalBet: 200},
+				{hole: "8c 6d", totalBet: 200},
+			},
+			want: map[int]int{2: 550},
+		},
+		{
+			name:  "tie within a side pot",
+			board: "2s 7d 9c Jh 3s",
+			seats: []showdownSeat{
+				{hole: "As Ah", totalBet: 50, allIn: true},
+				{hole: "Kc Kd", totalBet: 150},
+				{hole: "Ks Kh", totalBet: 150},
+			},
+			want: map[int]int{0: 150, 1: 100, 2: 100},
+		},
+		{
+			name:   "odd chip in a side pot goes left of the button",
+			button: 0,
+			board:  "2s 7d 9c Jh 3s",
+			seats: []showdownSeat{
+				{hole: "As Ah", totalBet: 50, allIn: true},
+				{hole: "Kc Kd", totalBet: 100},
+				{hole: "Ks Kh", totalBet: 101},
+				{hole: "Qc Qd", totalBet: 0, folded: true},
+			},
+			// Side pot of 50+51 splits 50/51; seat 1 is first left of the button
+			want: map[int]int{0: 150, 1: 51, 2: 50},
+		},
+		{
+			name:  "folded player's chips stay in the pot they can't win",
+			board: "2s 7d 9c Jh 3s",
+			seats: []showdownSeat{
+				{hole: "Kc Kd", totalBet: 50, allIn: true},
+				{hole: "As Ah", totalBet: 30, folded: true},
+				{hole: "Qc Qd", totalBet: 50},
+			},
+			want: map[int]int{0: 130},
+		},
+		{
+			name:  "folded player's chips above an all-in go to the side pot",
+			board: "2s 7d 9c Jh 3s",
+			seats: []showdownSeat{
+				{hole: "Kc Kd", totalBet: 50, allIn: true},
+				{hole: "As Ah", totalBet: 150, folded: true},
+				{hole: "Qc Qd", totalBet: 250},
+			},
+			// Main 3x50 to the kings, the other 300 to the only player left in it
+			want: map[int]int{0: 150, 2: 300},
+		},
+		{
+			name:  "folded player's chips when every deeper player folded",
+			board: "2s 7d 9c Jh 3s",
+			seats: []showdownSeat{
+				{hole: "Kc Kd", totalBet: 50, allIn: true},
+				{hole: "As Ah", totalBet: 100, folded: true},
+				{hole: "Qc Qd", totalBet: 100, folded: true},
+			},
+			want: map[int]int{0: 250},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := showdownHand(tt.button, tt.board, tt.seats)
+
+			payouts := h.GetPayouts()
+			for seat, amount := range payouts {
+				if amount == 0 {
+					delete(payouts, seat)
+				}
+			}
+			if !maps.Equal(payouts, tt.want) {
+				t.Errorf("expected payouts %v, got %v (pots %+v)", tt.want, payouts, h.GetPots())
+			}
+
+			// Every chip put in is awarded to someone
+			invested, awarded := 0, 0
+			for _, seat := range tt.seats {
+				invested += seat.totalBet
+			}
+			for _, amount := range payouts {
+				awarded += amount
+			}
+			if awarded != invested {
+				t.Errorf("expected all %d chips awarded, got %d", invested, awarded)
+			}
+		})
+	}
+}
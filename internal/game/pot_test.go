@@ -4,6 +4,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/lox/pokerforbots/v2/internal/randutil"
 	"github.com/lox/pokerforbots/v2/poker"
 )
 
@@ -333,3 +334,63 @@ func TestMakeEligible(t *testing.T) {
 		t.Errorf("Expected eligible %v, got %v", expected, eligible)
 	}
 }
+
+func TestFourAllInsNestSidePots(t *testing.T) {
+	t.Parallel()
+
+	// Five-handed with the button in seat 0, which covers four all-ins for
+	// different amounts. Shorter stacks hold better hands, so each pot goes to a
+	// different player.
+	cards := make([]poker.Card, 0, 15)
+	for _, c := range []string{
+		"As", "Ks", "Qs", "Ts", "3s", // First hole card to seats 1, 2, 3, 4, 0
+		"Ah", "Kh", "Qh", "Th", "3h", // Second hole card
+		"2c", "7d", "9h", "Jc", "4s", // Board
+	} {
+		card, err := poker.ParseCard(c)
+		if err != nil {
+			t.Fatalf("parse %s: %v", c, err)
+		}
+		cards = append(cards, card)
+	}
+	h := NewHandState(randutil.New(1), []string{"A", "B", "C", "D", "E"}, 0, 5, 10,
+		WithChipsByPlayer([]int{1000, 100, 200, 300, 400}),
+		WithDeck(poker.NewStackedDeck(cards...)), WithCardChecks())
+
+	// UTG shoves first, the button calls the largest shove and the blinds shove
+	for _, step := range []struct {
+		seat   int
+		action Action
+	}{{3, AllIn}, {4, AllIn}, {0, Call}, {1, AllIn}, {2, AllIn}} {
+		if h.ActivePlayer != step.seat {
+			t.Fatalf("expected seat %d to act, got %d", step.seat, h.ActivePlayer)
+		}
+		if err := h.ProcessAction(step.action, 0); err != nil {
+			t.Fatalf("seat %d %s: %v", step.seat, step.action, err)
+		}
+	}
+	// The button is the only player left with chips, so it checks down
+	for h.Street != Showdown {
+		if h.ActivePlayer != 0 {
+			t.Fatalf("expected only the button to act on %s, got seat %d", h.Street, h.ActivePlayer)
+		}
+		if err := h.ProcessAction(Check, 0); err != nil {
+			t.Fatalf("button check on %s: %v", h.Street, err)
+		}
+	}
+
+	want := []Pot{
+		{Amount: 500, Eligible: []int{0, 1, 2, 3, 4}, MaxPerPlayer: 100},
+		{Amount: 400, Eligible: []int{0, 2, 3, 4}, MaxPerPlayer: 200},
+		{Amount: 300, Eligible: []int{0, 3, 4}, MaxPerPlayer: 300},
+		{Amount: 200, Eligible: []int{0, 4}, MaxPerPlayer: 400},
+	}
+	if got := h.GetPots(); !reflect.DeepEqual(got, want) {
+		t.Errorf("pots = %+v, want %+v", got, want)
+	}
+
+	wantPayouts := map[int]int{1: 500, 2: 400, 3: 300, 4: 200}
+	if got := h.GetPayouts(); !reflect.DeepEqual(got, wantPayouts) {
+		t.Errorf("payouts = %v, want %v", got, wantPayouts)
+	}
+}
@@ -0,0 +1,163 @@
+package game
+
+import (
+	rand "math/rand/v2"
+	"slices"
+
+	"github.com/lox/pokerforbots/v2/poker"
+)
+
+// Runout is one run of the remaining board.
+type Runout struct {
+	Board      poker.Hand // Complete five-card board
+	Winners    []int      // Seats with the best high hand among the players still in
+	LowWinners []int      // Seats with the best qualifying low in hi-lo games, empty when no low qualifies
+}
+
+// Shares returns each winning seat's share of the pot on this runout. In hi-lo
+// games the pot splits between the high and low winners, and the high hand
+// scoops when no low qualifies.
+func (r Runout) Shares() map[int]float64 {
+	shares := make(map[int]float64)
+	high := 1.0
+	if len(r.LowWinners) > 0 {
+		high = 0.5
+		for _, seat := range r.LowWinners {
+			shares[seat] += 0.5 / float64(len(r.LowWinners))
+		}
+	}
+	for _, seat := range r.Winners {
+		shares[seat] += high / float64(len(r.Winners))
+	}
+	return shares
+}
+
+// StudyRunout records the remaining board run several times when betting
+// closed on a street, see WithStudyRunouts.
+type StudyRunout struct {
+	Street  Street   // Street whose betting had just closed
+	Runouts []Runout // The first is the board the hand actually deals
+}
+
+// WithStudyRunouts runs the remaining board n times whenever betting closes
+// before the river with two or more players still in, whether or not anyone is
+// all-in, so equity realization can be studied. The first runout is the board
+// the deck will actually deal and the rest are drawn independently from the
+// unseen cards using rng. Give rng a stream of its own so studying never
+// changes the hand's other draws; nil draws from the hand's RNG. Runouts are
+// only reported by StudyRunouts and never change the pot award. Zero (the
+// default) disables them.
+func WithStudyRunouts(n int, rng *rand.Rand) HandOption {
+	return func(c *handConfig) {
+		c.studyRuns = n
+		c.studyRNG = rng
+	}
+}
+
+// StudyRunouts returns the runouts recorded so far, one entry per street.
+func (h *HandState) StudyRunouts() []StudyRunout {
+	return slices.Clone(h.studies)
+}
+
+// recordStudyRunouts runs the remaining board for the street whose betting
+// just closed.
+func (h *HandState) recordStudyRunouts() {
+	missing := 5 - h.Board.CountCards()
+	if h.studyRunouts <= 0 || missing == 0 {
+		return
+	}
+
+	// Every undealt card is unseen, including any that will be burned
+	undealt := h.Deck.Undealt()
+	burns := 0
+	if h.burnCards {
+		burns = int(River - h.Street)
+	}
+	if len(undealt) < missing+burns {
+		return
+	}
+
+	study := StudyRunout{Street: h.Street, Runouts: make([]Runout, 0, h.studyRunouts)}
+	study.Runouts = append(study.Runouts, h.runout(h.canonicalRunout(undealt)))
+	for range h.studyRunouts - 1 {
+		board := h.Board
+		for _, i := range h.studyRNG.Perm(len(undealt))[:missing] {
+			board.AddCard(undealt[i])
+		}
+		study.Runouts = append(study.Runouts, h.runout(board))
+	}
+	h.studies = append(h.studies, study)
+}
+
+// RabbitHunt returns the board cards the deck would have dealt, in order, had
+// the hand continued to the river, skipping burn cards when they are enabled. It
+// returns nil once the board is complete.
+func (h *HandState) RabbitHunt() []poker.Card {
+	return h.remainingBoard(h.Deck.Undealt())
+}
+
+// canonicalRunout returns the board the deck will deal.
+func (h *HandState) canonicalRunout(undealt []poker.Card) poker.Hand {
+	board := h.Board
+	for _, card := range h.remainingBoard(undealt) {
+		board.AddCard(card)
+	}
+	return board
+}
+
+// remainingBoard picks the cards that complete the board from the undealt
+// cards in deal order, skipping burn cards when they are enabled.
+func (h *HandState) remainingBoard(undealt []poker.Card) []poker.Card {
+	var cards []poker.Card
+	next := 0
+	for dealt := h.Board.CountCards(); dealt < 5; {
+		if h.burnCards {
+			next++
+		}
+		count := 1
+		if dealt == 0 {
+			count = 3
+		}
+		if next+count > len(undealt) {
+			break
+		}
+		cards = append(cards, undealt[next:next+count]...)
+		next += count
+		dealt += count
+	}
+	return cards
+}
+
+// runout finds the high and low winners among the players still in on board,
+// evaluated as at showdown.
+func (h *HandState) runout(board poker.Hand) Runout {
+	var best poker.HandRank
+	bestLow := poker.NoLow
+	var winners, lowWinners []int
+	for _, p := range h.Players {
+		if p.Folded {
+			continue
+		}
+		rank := h.highRankOn(p.HoleCards, board)
+		switch poker.CompareHands(rank, best) {
+		case 1:
+			best = rank
+			winners = []int{p.Seat}
+		case 0:
+			winners = append(winners, p.Seat)
+		}
+
+		low := h.lowRankOn(p.HoleCards, board)
+		if low == poker.NoLow {
+			continue
+		}
+		switch poker.CompareLowHands(low, bestLow) {
+		case 1:
+			bestLow = low
+			lowWinners = []int{p.Seat}
+		case 0:
+			lowWinners = append(lowWinners, p.Seat)
+		}
+	}
+	return Runout{Board: board, Winners: winners, LowWinners: lowWinners}
+}
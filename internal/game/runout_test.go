@@ -0,0 +1,127 @@
+package game
+
+import (
+	"maps"
+	"strings"
+	"testing"
+
+	"github.com/lox/pokerforbots/v2/internal/randutil"
+	"github.com/lox/pokerforbots/v2/poker"
+)
+
+func TestStudyRunouts(t *testing.T) {
+	t.Parallel()
+
+	for _, burn := range []bool{false, true} {
+		h := NewHandState(randutil.New(2194), []string{"A", "B", "C"}, 0, 5, 10,
+			WithStudyRunouts(4, nil), WithBurnCards(burn), WithCardChecks())
+
+		// Everyone calls to the flop, then checks it down
+		for !h.IsComplete() {
+			if h.Betting.CurrentBet > h.Players[h.ActivePlayer].Bet {
+				_ = h.ProcessAction(Call, 0)
+			} else {
+				_ = h.ProcessAction(Check, 0)
+			}
+		}
+
+		studies := h.StudyRunouts()
+		if len(studies) != 3 {
+			t.Fatalf("burn %v: expected runouts after preflop, flop and turn, got %d", burn, len(studies))
+		}
+		for i, study := range studies {
+			if study.Street != Street(i) {
+				t.Errorf("burn %v: study %d is for %s, want %s", burn, i, study.Street, Street(i))
+			}
+			if len(study.Runouts) != 4 {
+				t.Fatalf("burn %v: expected 4 runouts on %s, got %d", burn, study.Street, len(study.Runouts))
+			}
+			// Each runout completes the board as it stood when betting closed
+			known := h.BoardCards()[:[]int{0, 3, 4}[i]]
+			for j, runout := range study.Runouts {
+				if runout.Board.CountCards() != 5 {
+					t.Errorf("burn %v: %s runout %d board %s is incomplete", burn, study.Street, j, runout.Board)
+				}
+				for _, card := range known {
+					if !runout.Board.HasCard(card) {
+						t.Errorf("burn %v: %s runout %d board %s is missing %s", burn, study.Street, j, runout.Board, card)
+					}
+				}
+				if len(runout.Winners) == 0 {
+					t.Errorf("burn %v: %s runout %d has no winner", burn, study.Street, j)
+				}
+			}
+
+			// The first runout is the board that was actually dealt
+			if first := study.Runouts[0]; first.Board != h.Board {
+				t.Errorf("burn %v: %s first runout %s, want the dealt board %s", burn, study.Street, first.Board, h.Board)
+			}
+		}
+
+		// Pots are still awarded on the dealt board
+		winners := h.GetWinners()
+		if got, want := studies[0].Runouts[0].Winners, winners[0]; !maps.Equal(seatSet(got), seatSet(want)) {
+			t.Errorf("burn %v: first runout winners %v, want the pot winners %v", burn, got, want)
+		}
+	}
+}
+
+func TestStudyRunoutsUseTheirOwnRNG(t *testing.T) {
+	t.Parallel()
+
+	play := func(opts ...HandOption) (poker.Hand, uint64) {
+		rng := randutil.New(2194)
+		h := NewHandState(rng, []string{"A", "B", "C"}, 0, 5, 10, opts...)
+		for !h.IsComplete() {
+			if h.Betting.CurrentBet > h.Players[h.ActivePlayer].Bet {
+				_ = h.ProcessAction(Call, 0)
+			} else {
+				_ = h.ProcessAction(Check, 0)
+			}
+		}
+		return h.Board, rng.Uint64()
+	}
+
+	board, next := play()
+	studiedBoard, studiedNext := play(WithStudyRunouts(10, randutil.New(1)))
+	if studiedBoard != board {
+		t.Errorf("board with studies %s, want %s", studiedBoard, board)
+	}
+	if studiedNext != next {
+		t.Error("studying drew from the hand's RNG")
+	}
+}
+
+func seatSet(seats []int) map[int]bool {
+	set := make(map[int]bool, len(seats))
+	for _, seat := range seats {
+		set[seat] = true
+	}
+	return set
+}
+
+// TestStudyRunoutSplitsHiLo verifies hi-lo runouts find the low alongside the
+// high and split the pot between them as the showdown does.
+func TestStudyRunoutSplitsHiLo(t *testing.T) {
+	t.Parallel()
+
+	h := NewHandState(randutil.New(42), []string{"A", "B"}, 0, 5, 10, WithVariant(OmahaHiLo))
+	h.Players[0].HoleCards = parseCards(strings.Fields("Kd Kh Js Tc")...) // Trip kings, no low
+	h.Players[1].HoleCards = parseCards(strings.Fields("Ac 3s 9d 9h")...) // 8-5-3-2-A low
+
+	lowBoard := h.runout(parseCards(strings.Fields("2c 5d 8h Ks Qd")...))
+	if !maps.Equal(seatSet(lowBoard.Winners), seatSet([]int{0})) || !maps.Equal(seatSet(lowBoard.LowWinners), seatSet([]int{1})) {
+		t.Fatalf("expected seat 0 high and seat 1 low, got high %v low %v", lowBoard.Winners, lowBoard.LowWinners)
+	}
+	if shares := lowBoard.Shares(); shares[0] != 0.5 || shares[1] != 0.5 {
+		t.Errorf("expected the pot split in half, got %v", shares)
+	}
+
+	noLow := h.runout(parseCards(strings.Fields("2c 7d Ks Qh Jd")...))
+	if len(noLow.LowWinners) != 0 {
+		t.Fatalf("expected no qualifying low, got %v", noLow.LowWinners)
+	}
+	if shares := noLow.Shares(); shares[0] != 1 || shares[1] != 0 {
+		t.Errorf("expected the high hand to scoop, got %v", shares)
+	}
+}
@@ -0,0 +1,150 @@
+package game
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Straddle selects a voluntary blind raise of twice the big blind, posted before
+// the cards are dealt. The straddle is live: the straddler acts last preflop and
+// may raise even if nobody else does, and a raise must go to at least twice the
+// straddle.
+type Straddle int
+
+const (
+	// NoStraddle plays without a straddle (the default).
+	NoStraddle Straddle = iota
+
+	// UTGStraddle has the player left of the big blind straddle. Preflop action
+	// starts left of the straddler and goes round to them.
+	UTGStraddle
+
+	// ButtonStraddle has the button straddle. Preflop action starts left of the
+	// big blind as usual but passes over the button, which acts after the big
+	// blind.
+	ButtonStraddle
+
+	// MississippiStraddle lets any seat outside the blinds straddle, see
+	// WithMississippiStraddle. Preflop action starts left of the straddler and
+	// goes round to them, so from the button it starts with the small blind.
+	MississippiStraddle
+)
+
+// String returns the straddle name.
+func (s Straddle) String() string {
+	switch s {
+	case NoStraddle:
+		return "none"
+	case UTGStraddle:
+		return "utg"
+	case ButtonStraddle:
+		return "button"
+	case MississippiStraddle:
+		return "mississippi"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseStraddle returns the straddle with the given name, see Straddle.String.
+func ParseStraddle(name string) (Straddle, error) {
+	for s := NoStraddle; s <= MississippiStraddle; s++ {
+		if s.String() == name {
+			return s, nil
+		}
+	}
+	return NoStraddle, fmt.Errorf("unknown straddle %q", name)
+}
+
+// WithStraddle posts a UTG or button straddle. Straddles are skipped heads-up
+// and when the straddling seat would be one of the blinds.
+func WithStraddle(s Straddle) HandOption {
+	return func(c *handConfig) {
+		c.straddle = s
+	}
+}
+
+// WithMississippiStraddle posts a Mississippi straddle from seat.
+func WithMississippiStraddle(seat int) HandOption {
+	return func(c *handConfig) {
+		c.straddle = MississippiStraddle
+		c.straddleSeat = seat
+	}
+}
+
+// StraddleSeat returns the seat that straddled, or -1 without a straddle.
+func (h *HandState) StraddleSeat() int {
+	if h.preflopOrder == nil {
+		return -1
+	}
+	return h.preflopOrder[len(h.preflopOrder)-1]
+}
+
+// postStraddle posts the straddle after the blinds and sets the preflop action
+// order to match.
+func (h *HandState) postStraddle(kind Straddle, seat, bigBlind int) {
+	n := len(h.Players)
+	if kind == NoStraddle || n < 3 {
+		return
+	}
+	switch kind {
+	case UTGStraddle:
+		seat = (h.Button + 3) % n
+	case ButtonStraddle:
+		seat = h.Button
+	}
+	if seat < 0 || seat >= n || seat == (h.Button+1)%n || seat == (h.Button+2)%n {
+		return
+	}
+
+	// Returning players may already have a live big blind in, which counts
+	amount := 2 * bigBlind
+	p := h.Players[seat]
+	add := min(max(amount-p.Bet, 0), p.Chips)
+	p.Bet += add
+	p.TotalBet += add
+	p.Chips -= add
+	if p.Chips == 0 {
+		p.AllInFlag = true
+	}
+	if p.Bet >= amount {
+		h.Betting.CurrentBet = amount
+		h.Betting.MinRaise = amount
+	} else {
+		// A short straddle is an all-in for less than a full raise, so it lifts
+		// the bet without changing the minimum raise
+		h.Betting.CurrentBet = max(p.Bet, bigBlind)
+	}
+
+	order := make([]int, 0, n)
+	if kind == ButtonStraddle {
+		for i := 3; i < n+3; i++ {
+			if s := (h.Button + i) % n; s != seat {
+				order = append(order, s)
+			}
+		}
+		order = append(order, seat)
+	} else {
+		for i := 1; i <= n; i++ {
+			order = append(order, (seat+i)%n)
+		}
+	}
+	h.preflopOrder = order
+}
+
+// nextToAct returns the next seat after seat that can act, following the
+// straddle's order preflop, or -1 if nobody can.
+func (h *HandState) nextToAct(seat int) int {
+	if h.Street != Preflop || h.preflopOrder == nil {
+		return h.nextActivePlayer(seat + 1)
+	}
+	n := len(h.preflopOrder)
+	at := slices.Index(h.preflopOrder, seat)
+	for i := 1; i <= n; i++ {
+		p := h.Players[h.preflopOrder[(at+i)%n]]
+		if !p.Folded && !p.AllInFlag {
+			return p.Seat
+		}
+	}
+	return -1
+}
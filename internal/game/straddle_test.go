@@ -0,0 +1,145 @@
+package game
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/lox/pokerforbots/v2/internal/randutil"
+)
+
+func TestStraddleActionOrder(t *testing.T) {
+	t.Parallel()
+
+	// Six-handed with the button in seat 0: blinds in seats 1 and 2, UTG in seat 3
+	tests := []struct {
+		name     string
+		opt      HandOption
+		straddle int
+		order    []int // Preflop action when everyone calls the straddle
+	}{
+		{name: "utg", opt: WithStraddle(UTGStraddle), straddle: 3, order: []int{4, 5, 0, 1, 2, 3}},
+		{name: "button", opt: WithStraddle(ButtonStraddle), straddle: 0, order: []int{3, 4, 5, 1, 2, 0}},
+		{name: "mississippi from the button", opt: WithMississippiStraddle(0), straddle: 0, order: []int{1, 2, 3, 4, 5, 0}},
+		{name: "mississippi from the cutoff", opt: WithMississippiStraddle(5), straddle: 5, order: []int{0, 1, 2, 3, 4, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := NewHandState(randutil.New(1), []string{"A", "B", "C", "D", "E", "F"}, 0, 5, 10, tt.opt)
+			if got := h.StraddleSeat(); got != tt.straddle {
+				t.Fatalf("straddle seat = %d, want %d", got, tt.straddle)
+			}
+			if got := h.Players[tt.straddle].Bet; got != 20 {
+				t.Errorf("expected a 20 chip straddle, got %d", got)
+			}
+			if h.Betting.CurrentBet != 20 || h.Betting.MinRaise != 20 {
+				t.Errorf("expected to call 20 and raise to at least 40, got bet %d min raise %d",
+					h.Betting.CurrentBet, h.Betting.MinRaise)
+			}
+
+			var order []int
+			for h.Street == Preflop {
+				order = append(order, h.ActivePlayer)
+				if err := h.ProcessAction(Call, 0); err != nil {
+					t.Fatalf("seat %d call: %v", h.ActivePlayer, err)
+				}
+			}
+			if !slices.Equal(order, tt.order) {
+				t.Errorf("preflop order = %v, want %v", order, tt.order)
+			}
+			if h.ActivePlayer != 1 {
+				t.Errorf("expected the small blind to act first on the flop, got seat %d", h.ActivePlayer)
+			}
+		})
+	}
+}
+
+func TestStraddlerCanRaiseWhenCalled(t *testing.T) {
+	t.Parallel()
+
+	h := NewHandState(randutil.New(1), []string{"A", "B", "C", "D"}, 0, 5, 10, WithStraddle(ButtonStraddle))
+	for _, seat := range []int{3, 1, 2} {
+		if h.ActivePlayer != seat {
+			t.Fatalf("expected seat %d to act, got %d", seat, h.ActivePlayer)
+		}
+		if err := h.ProcessAction(Call, 0); err != nil {
+			t.Fatalf("seat %d call: %v", seat, err)
+		}
+	}
+
+	// The button acts last with the option to raise
+	if h.ActivePlayer != 0 || h.Street != Preflop {
+		t.Fatalf("expected the button to have the option preflop, got seat %d on %s", h.ActivePlayer, h.Street)
+	}
+	if err := h.ProcessAction(Raise, 30); err == nil {
+		t.Error("expected a raise to 30 to be below the minimum of 40")
+	}
+	if err := h.ProcessAction(Raise, 40); err != nil {
+		t.Fatalf("raise to 40: %v", err)
+	}
+	if h.ActivePlayer != 3 {
+		t.Errorf("expected action back to UTG after the raise, got seat %d", h.ActivePlayer)
+	}
+}
+
+func TestShortStackedStraddleIsAllIn(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		chips       int
+		currentBet  int
+		minRaiseTo  int
+		straddleBet int
+	}{
+		{name: "more than the big blind", chips: 15, currentBet: 15, minRaiseTo: 25, straddleBet: 15},
+		{name: "less than the big blind", chips: 8, currentBet: 10, minRaiseTo: 20, straddleBet: 8},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := NewHandState(randutil.New(1), []string{"A", "B", "C", "D"}, 0, 5, 10,
+				WithChipsByPlayer([]int{tt.chips, 1000, 1000, 1000}), WithStraddle(ButtonStraddle))
+			button := h.Players[0]
+			if !button.AllInFlag || button.Bet != tt.straddleBet {
+				t.Fatalf("expected the button all-in for %d, got bet %d all-in %v", tt.straddleBet, button.Bet, button.AllInFlag)
+			}
+			if h.Betting.CurrentBet != tt.currentBet {
+				t.Errorf("current bet = %d, want %d", h.Betting.CurrentBet, tt.currentBet)
+			}
+			if err := h.ProcessAction(Raise, tt.minRaiseTo-1); err == nil {
+				t.Errorf("expected a raise to %d to be below the minimum of %d", tt.minRaiseTo-1, tt.minRaiseTo)
+			}
+			if err := h.ProcessAction(Raise, tt.minRaiseTo); err != nil {
+				t.Errorf("raise to %d: %v", tt.minRaiseTo, err)
+			}
+		})
+	}
+}
+
+func TestStraddleSkippedHeadsUpAndFromTheBlinds(t *testing.T) {
+	t.Parallel()
+
+	if h := NewHandState(randutil.New(1), []string{"A", "B"}, 0, 5, 10, WithStraddle(ButtonStraddle)); h.StraddleSeat() != -1 {
+		t.Errorf("expected no straddle heads-up, got seat %d", h.StraddleSeat())
+	}
+	if h := NewHandState(randutil.New(1), []string{"A", "B", "C", "D"}, 0, 5, 10, WithMississippiStraddle(2)); h.StraddleSeat() != -1 {
+		t.Errorf("expected no straddle from the big blind, got seat %d", h.StraddleSeat())
+	}
+}
+
+func TestParseStraddle(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []Straddle{NoStraddle, UTGStraddle, ButtonStraddle, MississippiStraddle} {
+		if got, err := ParseStraddle(s.String()); err != nil || got != s {
+			t.Errorf("ParseStraddle(%q) = %v, %v", s, got, err)
+		}
+	}
+	if _, err := ParseStraddle("sleeper"); err == nil {
+		t.Error("expected an error for an unknown straddle")
+	}
+}
@@ -0,0 +1,113 @@
+package game
+
+import (
+	"github.com/lox/pokerforbots/v2/poker"
+)
+
+// Variant selects the poker game a hand is played as.
+type Variant int
+
+const (
+	// HoldEm deals two hole cards and plays the best five of seven (the default).
+	HoldEm Variant = iota
+
+	// OmahaHiLo deals four hole cards and plays exactly two of them with three
+	// board cards. Each pot splits between the best high hand and the best
+	// eight-or-better ace-to-five low, and the high hand scoops if no low qualifies.
+	// Showdown is cards speak: each player's best high and best low are chosen
+	// independently, so they may use different hole and board cards.
+	OmahaHiLo
+)
+
+// String returns the variant name.
+func (v Variant) String() string {
+	switch v {
+	case HoldEm:
+		return "holdem"
+	case OmahaHiLo:
+		return "omaha-hilo"
+	default:
+		return "unknown"
+	}
+}
+
+// holeCards returns how many hole cards each player is dealt.
+func (v Variant) holeCards() int {
+	if v == OmahaHiLo {
+		return 4
+	}
+	return 2
+}
+
+// WithVariant plays the hand as the given variant instead of Hold'em.
+func WithVariant(v Variant) HandOption {
+	return func(c *handConfig) {
+		c.variant = v
+	}
+}
+
+// HandRank returns the best high hand for the player in seat, using the cards
+// the variant allows.
+func (h *HandState) HandRank(seat int) poker.HandRank {
+	return h.highRank(h.Players[seat])
+}
+
+// highRank returns the player's best high hand.
+func (h *HandState) highRank(p *Player) poker.HandRank {
+	return h.highRankOn(p.HoleCards, h.Board)
+}
+
+// highRankOn returns the best high hand hole makes with board.
+func (h *HandState) highRankOn(hole, board poker.Hand) poker.HandRank {
+	if h.Variant != OmahaHiLo {
+		return poker.Evaluate7Cards(hole | board)
+	}
+	best := poker.HandRank(0)
+	omahaHands(hole, board, func(five poker.Hand) {
+		if rank := poker.EvaluateCards(five); rank > best {
+			best = rank
+		}
+	})
+	return best
+}
+
+// lowRank returns the player's best qualifying low, or poker.NoLow if the
+// variant has no low or the player can't make one.
+func (h *HandState) lowRank(p *Player) poker.LowRank {
+	return h.lowRankOn(p.HoleCards, h.Board)
+}
+
+// lowRankOn returns the best qualifying low hole makes with board.
+func (h *HandState) lowRankOn(hole, board poker.Hand) poker.LowRank {
+	if h.Variant != OmahaHiLo {
+		return poker.NoLow
+	}
+	best := poker.NoLow
+	omahaHands(hole, board, func(five poker.Hand) {
+		if low := poker.EvaluateLowA5(five); low < best {
+			best = low
+		}
+	})
+	if !best.EightOrBetter() {
+		return poker.NoLow
+	}
+	return best
+}
+
+// omahaHands calls fn with every five-card hand made of exactly two hole cards
+// and three board cards.
+func omahaHands(hole, board poker.Hand, fn func(poker.Hand)) {
+	holeCount, boardCount := hole.CountCards(), board.CountCards()
+	for a := range holeCount {
+		for b := a + 1; b < holeCount; b++ {
+			two := poker.NewHand(hole.GetCard(a), hole.GetCard(b))
+			for i := range boardCount {
+				for j := i + 1; j < boardCount; j++ {
+					for k := j + 1; k < boardCount; k++ {
+						fn(two | poker.NewHand(board.GetCard(i), board.GetCard(j), board.GetCard(k)))
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package game
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/lox/pokerforbots/v2/internal/randutil"
+)
+
+func TestOmahaHiLoDealsFourHoleCards(t *testing.T) {
+	t.Parallel()
+	h := NewHandState(randutil.New(42), []string{"A", "B", "C"}, 0, 5, 10, WithVariant(OmahaHiLo), WithCardChecks())
+	for _, p := range h.Players {
+		if got := p.HoleCards.CountCards(); got != 4 {
+			t.Errorf("seat %d: expected 4 hole cards, got %d", p.Seat, got)
+		}
+	}
+}
+
+func TestOmahaHiLoPotDistribution(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		board string
+		seats []showdownSeat
+		want  map[int]int // Chips awarded per seat; seats not listed win nothing
+	}{
+		{
+			name:  "high and low split the pot",
+			board: "2c 5d 8h Ks Qd",
+			seats: []showdownSeat{
+				{hole: "Kd Kh Js Tc", totalBet: 100}, // Trip kings, no low
+				{hole: "Ac 3s 9d 9h", totalBet: 100}, // 8-5-3-2-A low
+			},
+			want: map[int]int{0: 100, 1: 100},
+		},
+		{
+			name:  "high scoops when no low qualifies",
+			board: "2c 7d Ks Qh Jd",
+			seats: []showdownSeat{
+				{hole: "Kd Kh 4s 5c", totalBet: 100}, // Trip kings
+				{hole: "Ac 3s 4d 5h", totalBet: 100}, // Only two low board cards, no low
+			},
+			want: map[int]int{0: 200},
+		},
+		{
+			name:  "tied low quarters the pot",
+			board: "2c 5d 8h Ks Qd",
+			seats: []showdownSeat{
+				{hole: "Kd Kh Ac 3s", totalBet: 200}, // Trip kings and 8-5-3-2-A
+				{hole: "Ah 3c 9s 9d", totalBet: 200}, // The same low
+			},
+			want: map[int]int{0: 300, 1: 100},
+		},
+		{
+			name:  "hands must use exactly two hole cards",
+			board: "As Ks Qs Js 2d",
+			seats: []showdownSeat{
+				{hole: "Ts 3c 4c 5c", totalBet: 100}, // One spade, so no royal flush
+				{hole: "Ah Ad 7c 8c", totalBet: 100}, // Trip aces
+			},
+			want: map[int]int{1: 200},
+		},
+		{
+			name:  "odd chip goes to the high half",
+			board: "2c 5d 8h Ks Qd",
+			seats: []showdownSeat{
+				{hole: "Kd Kh Js Tc", totalBet: 100},
+				{hole: "Ac 3s 9d 9h", totalBet: 100},
+				{hole: "7c 7s 6d 6s", totalBet: 1, folded: true}, // Folded small blind
+			},
+			want: map[int]int{0: 101, 1: 100},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := showdownHand(0, tt.board, tt.seats)
+			h.Variant = OmahaHiLo
+
+			got := h.GetPayouts()
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("payouts = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOmahaHiLoCardsSpeakHighAndLowIndependently(t *testing.T) {
+	t.Parallel()
+
+	// Seat 0 makes trip kings with Kh Qc and a 7-4-3-2-A low with Ac 3s. Held to a
+	// single two-card combo it would lose one half to seat 1's kings and queens or
+	// its 7-6-5-4-2 low.
+	h := showdownHand(0, "2c 4d 7h Ks Kd", []showdownSeat{
+		{hole: "Kh Qc Ac 3s", totalBet: 100},
+		{hole: "Qh Qs 5c 6c", totalBet: 100},
+	})
+	h.Variant = OmahaHiLo
+
+	high, low := h.potWinners(h.GetPots()[0])
+	if len(high) != 1 || high[0] != 0 {
+		t.Errorf("high winners = %v, want [0]", high)
+	}
+	if len(low) != 1 || low[0] != 0 {
+		t.Errorf("low winners = %v, want [0]", low)
+	}
+	if got, want := h.GetPayouts(), map[int]int{0: 200}; !maps.Equal(got, want) {
+		t.Errorf("payouts = %v, want %v", got, want)
+	}
+}
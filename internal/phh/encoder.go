@@ -44,7 +44,7 @@ func FormatAction(seat int, action string, totalBet int) (string, bool) {
 			return "", false
 		}
 		return fmt.Sprintf("%s cbr %d", player, totalBet), true
-	case "post_small_blind", "post_big_blind":
+	case "post_small_blind", "post_big_blind", "post_dead_blind":
 		return "", false
 	default:
 		return fmt.Sprintf("# %s %s %d", player, action, totalBet), true
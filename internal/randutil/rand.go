@@ -22,3 +22,9 @@ func mix(x uint64) uint64 {
 	x ^= x >> 31
 	return x
 }
+
+// Derive returns the seed for the n-th independent stream of seed, so a stream
+// can be recreated from (seed, n) alone without replaying the streams before it.
+func Derive(seed int64, n uint64) int64 {
+	return int64(mix(uint64(seed) ^ mix(n+goldenRatio64)))
+}
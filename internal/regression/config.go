@@ -1,6 +1,7 @@
 package regression
 
 import (
+	"sync"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -24,6 +25,11 @@ type Config struct {
 	HandsTotal int
 	BatchSize  int
 	Seeds      []int64
+	SeedSweep  int // Run the comparison once per seed across this many generated seeds
+
+	// Scenarios
+	ScenarioFile string    // Run the comparison once per scenario in this file
+	Scenario     *Scenario // Scenario dealt on every hand of the current run
 
 	// Bot binaries - unified across all modes
 	Challenger string // Primary bot being tested (all modes)
@@ -89,11 +95,12 @@ type BotStatus struct {
 	Cmd          any // Will be *exec.Cmd when running
 }
 
-// HealthMonitor manages bot health and restarts
+// HealthMonitor manages bot health and restarts. Safe for concurrent use.
 type HealthMonitor struct {
 	MaxCrashes   int
 	MaxTimeouts  int
 	RestartDelay time.Duration
+	mu           sync.Mutex
 	bots         map[string]*BotStatus
 	logger       zerolog.Logger
 }
@@ -111,6 +118,9 @@ func NewHealthMonitor(maxCrashes, maxTimeouts int, restartDelay time.Duration, l
 
 // RegisterBot registers a bot with the health monitor
 func (h *HealthMonitor) RegisterBot(id, binary, displayName string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
 	h.bots[id] = &BotStatus{
 		ID:          id,
 		Binary:      binary,
@@ -121,6 +131,9 @@ func (h *HealthMonitor) RegisterBot(id, binary, displayName string) {
 
 // RecordCrash records a bot crash
 func (h *HealthMonitor) RecordCrash(id string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
 	bot, exists := h.bots[id]
 	if !exists {
 		return false
@@ -147,8 +160,21 @@ func (h *HealthMonitor) RecordCrash(id string) bool {
 	return true
 }
 
+// RecordRestart records that a crashed bot was restarted and rejoined
+func (h *HealthMonitor) RecordRestart(id string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if bot, exists := h.bots[id]; exists {
+		bot.RestartCount++
+	}
+}
+
 // RecordTimeout records a bot timeout
 func (h *HealthMonitor) RecordTimeout(id string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
 	bot, exists := h.bots[id]
 	if !exists {
 		return false
@@ -172,6 +198,9 @@ func (h *HealthMonitor) RecordTimeout(id string) bool {
 
 // IsHealthy returns true if the bot is healthy
 func (h *HealthMonitor) IsHealthy(id string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
 	bot, exists := h.bots[id]
 	if !exists {
 		return false
@@ -179,18 +208,38 @@ func (h *HealthMonitor) IsHealthy(id string) bool {
 	return bot.IsHealthy
 }
 
-// GetStatus returns the status of a bot
+// GetStatus returns a copy of the status of a bot, or nil if it isn't tracked
 func (h *HealthMonitor) GetStatus(id string) *BotStatus {
-	return h.bots[id]
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	bot, exists := h.bots[id]
+	if !exists {
+		return nil
+	}
+	status := *bot
+	return &status
 }
 
-// GetAllStatuses returns all bot statuses
+// GetAllStatuses returns copies of all bot statuses, safe to read while the
+// monitor keeps recording
 func (h *HealthMonitor) GetAllStatuses() map[string]*BotStatus {
-	return h.bots
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	statuses := make(map[string]*BotStatus, len(h.bots))
+	for id, bot := range h.bots {
+		status := *bot
+		statuses[id] = &status
+	}
+	return statuses
 }
 
 // GetErrorSummary returns crash and timeout counts
 func (h *HealthMonitor) GetErrorSummary() (crashes, timeouts, recovered int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
 	for _, bot := range h.bots {
 		crashes += bot.Crashes
 		timeouts += bot.Timeouts
@@ -0,0 +1,40 @@
+package regression
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+func TestHealthMonitorStatusesAreCopies(t *testing.T) {
+	monitor := NewHealthMonitor(100, 100, time.Millisecond, zerolog.Nop())
+	monitor.RegisterBot("bot-a", "./bot", "Bot A")
+
+	statuses := monitor.GetAllStatuses()
+	status := monitor.GetStatus("bot-a")
+
+	// Recording keeps going while the snapshots are read
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		for range 50 {
+			monitor.RecordCrash("bot-a")
+			monitor.RecordTimeout("bot-a")
+		}
+	})
+	for range 50 {
+		_ = statuses["bot-a"].Crashes + status.Timeouts
+	}
+	wg.Wait()
+
+	if statuses["bot-a"].Crashes != 0 || status.Timeouts != 0 {
+		t.Errorf("snapshots changed after recording: crashes %d, timeouts %d", statuses["bot-a"].Crashes, status.Timeouts)
+	}
+	if got := monitor.GetStatus("bot-a"); got.Crashes != 50 || got.Timeouts != 50 {
+		t.Errorf("expected 50 crashes and timeouts recorded, got %d and %d", got.Crashes, got.Timeouts)
+	}
+	if monitor.GetStatus("missing") != nil {
+		t.Error("expected nil status for an unknown bot")
+	}
+}
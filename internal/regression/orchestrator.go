@@ -12,6 +12,7 @@ import (
 	"os"
 	"os/exec"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/lox/pokerforbots/v2/internal/fileutil"
@@ -40,8 +41,14 @@ type Orchestrator struct {
 	currentStatsFile string              // Current batch's stats file path
 	progressReporter ProgressReporter    // Optional progress reporting
 	handMonitor      server.HandMonitor  // Optional server hand monitor
+	stopSupervisor   context.CancelFunc  // Stops restarting crashed bots
+	supervisorWG     sync.WaitGroup
 }
 
+// botReconnectGrace is how long the server holds a crashed bot's seat and
+// bankroll, on top of the restart delay, while it restarts and reconnects.
+const botReconnectGrace = 5 * time.Second
+
 // OrchestratorOption configures an Orchestrator
 type OrchestratorOption func(*Orchestrator)
 
@@ -292,9 +299,14 @@ func (o *Orchestrator) aggregateBatchResults(batches []BatchResult, _ BatchStrat
 // StartServer starts the server with the given configuration
 func (o *Orchestrator) StartServer(ctx context.Context, serverConfig *ServerConfig) error {
 	// Try embedded server mode first (fastest)
-	if err := o.startEmbeddedServer(ctx, serverConfig); err == nil {
+	err := o.startEmbeddedServer(ctx, serverConfig)
+	if err == nil {
 		return nil
 	}
+	if o.config.Scenario != nil {
+		// The server subprocess has no way to force a scenario's deal
+		return fmt.Errorf("scenario %q requires the embedded server: %w", o.config.Scenario.Name, err)
+	}
 
 	// Fall back to legacy subprocess mode
 	o.logger.Info().Msg("Using legacy server subprocess mode")
@@ -322,6 +334,17 @@ func (o *Orchestrator) startEmbeddedServer(ctx context.Context, serverConfig *Se
 		EnableStats:           true,
 		EnableLatencyTracking: o.config.EnableLatencyTracking,
 	}
+	if o.config.Scenario != nil {
+		setup, err := o.config.Scenario.HandSetup()
+		if err != nil {
+			return fmt.Errorf("invalid scenario %q: %w", o.config.Scenario.Name, err)
+		}
+		srvConfig.HandSetup = setup
+	}
+	if o.healthMonitor.MaxCrashes > 1 {
+		// Crashed bots get restarted, so give them time to rejoin
+		srvConfig.ReconnectGrace = o.healthMonitor.RestartDelay + botReconnectGrace
+	}
 
 	// Create embedded server
 	o.embeddedServer = server.NewServer(o.logger, rng, server.WithConfig(srvConfig))
@@ -389,12 +412,62 @@ func (o *Orchestrator) startEmbeddedServer(ctx context.Context, serverConfig *Se
 		return fmt.Errorf("failed to spawn bots: %w", err)
 	}
 
+	o.superviseBots(ctx)
+
 	// Wait for bots to connect
 	time.Sleep(2 * time.Second)
 
 	return nil
 }
 
+// superviseBots watches the spawned bots and restarts any that crash mid-batch,
+// within the health monitor's crash limit. A restarted bot reconnects with the
+// same bot ID, so the server credits its hands and bankroll to the same player
+// and the batch's stats carry on across the crash.
+func (o *Orchestrator) superviseBots(ctx context.Context) {
+	supervisorCtx, cancel := context.WithCancel(ctx)
+	o.stopSupervisor = cancel
+
+	for _, proc := range o.botSpawner.GetAllProcesses() {
+		o.healthMonitor.RegisterBot(proc.ID, proc.Command, proc.Env["POKERFORBOTS_BOT_ID"])
+		o.supervisorWG.Add(1)
+		go func() {
+			defer o.supervisorWG.Done()
+			o.superviseBot(supervisorCtx, o.botSpawner, proc)
+		}()
+	}
+}
+
+// superviseBot restarts proc each time it exits with an error until the batch
+// ends or the bot exceeds its crash limit.
+func (o *Orchestrator) superviseBot(ctx context.Context, botSpawner *spawner.BotSpawner, proc *spawner.Process) {
+	for {
+		err := proc.Wait()
+		if ctx.Err() != nil || err == nil {
+			return
+		}
+
+		o.logger.Warn().Err(err).Str("bot_id", proc.ID).Msg("Bot process crashed")
+		if !o.healthMonitor.RecordCrash(proc.ID) {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(o.healthMonitor.RestartDelay):
+		}
+
+		restarted, err := botSpawner.Restart(proc.ID)
+		if err != nil {
+			o.logger.Error().Err(err).Str("bot_id", proc.ID).Msg("Failed to restart bot")
+			return
+		}
+		o.healthMonitor.RecordRestart(proc.ID)
+		proc = restarted
+	}
+}
+
 // parseNPCConfig converts NPC configuration string to bot specs
 func (o *Orchestrator) parseNPCConfig(npcConfig string, seed int64) ([]spawner.BotSpec, error) {
 	var specs []spawner.BotSpec
@@ -528,6 +601,12 @@ func (o *Orchestrator) WaitForCompletion(ctx context.Context) error {
 
 // StopServer stops the server
 func (o *Orchestrator) StopServer() error {
+	// Stop restarting bots before they are shut down deliberately
+	if o.stopSupervisor != nil {
+		o.stopSupervisor()
+		o.stopSupervisor = nil
+	}
+
 	// Stop spawned bots first
 	if o.botSpawner != nil {
 		o.logger.Info().Msg("Stopping spawned bots")
@@ -535,6 +614,7 @@ func (o *Orchestrator) StopServer() error {
 			o.logger.Warn().Err(err).Msg("Failed to stop some bots")
 		}
 	}
+	o.supervisorWG.Wait()
 
 	// Stop embedded server
 	if o.embeddedServer != nil {
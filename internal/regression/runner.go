@@ -163,6 +163,17 @@ func (r *Runner) Run(ctx context.Context) error {
 		return fmt.Errorf("binary validation failed: %w", err)
 	}
 
+	if r.config.ScenarioFile != "" {
+		if r.config.SeedSweep > 0 {
+			return fmt.Errorf("scenario files and seed sweeps can't be combined")
+		}
+		return r.runScenarios(ctx)
+	}
+
+	if r.config.SeedSweep > 0 {
+		return r.runSeedSweep(ctx)
+	}
+
 	var results []*TestResult
 
 	// Apply multiple test correction if running all modes
@@ -328,6 +339,85 @@ func (r *Runner) Run(ctx context.Context) error {
 	return r.outputResults(results)
 }
 
+// singleModeTest returns the test function for the configured mode, for runs
+// that repeat one mode under different settings
+func (r *Runner) singleModeTest() (func(context.Context) (*TestResult, error), bool) {
+	switch r.config.Mode {
+	case ModeHeadsUp:
+		return r.runHeadsUpTest, true
+	case ModePopulation:
+		return r.runPopulationTest, true
+	case ModeNPCBenchmark:
+		return r.runNPCBenchmarkTest, true
+	case ModeSelfPlay:
+		return r.runSelfPlayTest, true
+	default:
+		return nil, false
+	}
+}
+
+// runScenarios repeats the configured test mode once per scenario in the
+// scenario file and reports the comparison on each curated spot
+func (r *Runner) runScenarios(ctx context.Context) error {
+	run, ok := r.singleModeTest()
+	if !ok {
+		return fmt.Errorf("scenario runs require a single test mode, got %s", r.config.Mode)
+	}
+
+	scenarios, err := LoadScenarios(r.config.ScenarioFile)
+	if err != nil {
+		return err
+	}
+
+	results, err := RunScenarios(ctx, r.config, scenarios, run)
+	if err != nil {
+		return fmt.Errorf("scenario run failed: %w", err)
+	}
+
+	if r.config.OutputFormat == "json" || r.config.OutputFormat == "both" {
+		if err := r.reporter.WriteScenarioJSON(results); err != nil {
+			return fmt.Errorf("failed to write JSON report: %w", err)
+		}
+	}
+	if r.config.OutputFormat == "summary" || r.config.OutputFormat == "both" {
+		if err := r.reporter.WriteScenarioSummary(results); err != nil {
+			return fmt.Errorf("failed to write summary: %w", err)
+		}
+	}
+	return nil
+}
+
+// runSeedSweep repeats the configured test mode across generated seeds and
+// reports whether the effect holds up across all of them
+func (r *Runner) runSeedSweep(ctx context.Context) error {
+	run, ok := r.singleModeTest()
+	if !ok {
+		return fmt.Errorf("seed sweep requires a single test mode, got %s", r.config.Mode)
+	}
+
+	base := int64(42)
+	if len(r.config.Seeds) > 0 {
+		base = r.config.Seeds[0]
+	}
+
+	sweep, err := RunSeedSweep(ctx, r.config, SweepSeeds(base, r.config.SeedSweep), run)
+	if err != nil {
+		return fmt.Errorf("seed sweep failed: %w", err)
+	}
+
+	if r.config.OutputFormat == "json" || r.config.OutputFormat == "both" {
+		if err := r.reporter.WriteSeedSweepJSON(sweep); err != nil {
+			return fmt.Errorf("failed to write JSON report: %w", err)
+		}
+	}
+	if r.config.OutputFormat == "summary" || r.config.OutputFormat == "both" {
+		if err := r.reporter.WriteSeedSweepSummary(sweep); err != nil {
+			return fmt.Errorf("failed to write summary: %w", err)
+		}
+	}
+	return nil
+}
+
 // addSampleSizeGuidance adds a warning when sample size might be too small
 func addSampleSizeGuidance(results []*TestResult) {
 	for _, result := range results {
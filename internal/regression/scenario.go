@@ -0,0 +1,197 @@
+package regression
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/lox/pokerforbots/v2/internal/server"
+	"github.com/lox/pokerforbots/v2/poker"
+)
+
+// ScenarioFile is the JSON format of a file of curated test spots
+type ScenarioFile struct {
+	Scenarios []Scenario `json:"scenarios"`
+}
+
+// Scenario is a curated spot dealt on every hand of its run. Stacks and hole
+// cards are listed by position clockwise from the button, which is position 0,
+// so bots rotate through the forced seats as the seating changes.
+type Scenario struct {
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	Hands       int        `json:"hands,omitempty"`      // Hands to play, 0 uses --hands
+	Stacks      []int      `json:"stacks,omitempty"`     // Starting stack per position, 0 keeps the buy-in
+	HoleCards   [][]string `json:"hole_cards,omitempty"` // Hole cards per position, empty deals at random
+	Board       []string   `json:"board,omitempty"`      // Leading board cards, the rest are dealt at random
+}
+
+// HandSetup converts the scenario into the server's forced deal
+func (s Scenario) HandSetup() (*server.HandSetup, error) {
+	setup := &server.HandSetup{Stacks: s.Stacks}
+	for _, cards := range s.HoleCards {
+		parsed, err := parseCards(cards)
+		if err != nil {
+			return nil, err
+		}
+		setup.HoleCards = append(setup.HoleCards, parsed)
+	}
+	board, err := parseCards(s.Board)
+	if err != nil {
+		return nil, err
+	}
+	setup.Board = board
+	if err := setup.Validate(); err != nil {
+		return nil, err
+	}
+	return setup, nil
+}
+
+func parseCards(cards []string) ([]poker.Card, error) {
+	var parsed []poker.Card
+	for _, c := range cards {
+		card, err := poker.ParseCard(c)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, card)
+	}
+	return parsed, nil
+}
+
+// LoadScenarios reads and validates a scenario file
+func LoadScenarios(path string) ([]Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scenario file: %w", err)
+	}
+
+	var file ScenarioFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse scenario file %s: %w", path, err)
+	}
+	if len(file.Scenarios) == 0 {
+		return nil, fmt.Errorf("scenario file %s has no scenarios", path)
+	}
+
+	names := make(map[string]bool)
+	for i, scenario := range file.Scenarios {
+		if scenario.Name == "" {
+			return nil, fmt.Errorf("scenario %d has no name", i+1)
+		}
+		if names[scenario.Name] {
+			return nil, fmt.Errorf("duplicate scenario name %q", scenario.Name)
+		}
+		names[scenario.Name] = true
+		if scenario.Hands < 0 {
+			return nil, fmt.Errorf("scenario %q: hands must not be negative", scenario.Name)
+		}
+		if _, err := scenario.HandSetup(); err != nil {
+			return nil, fmt.Errorf("scenario %q: %w", scenario.Name, err)
+		}
+	}
+	return file.Scenarios, nil
+}
+
+// ScenarioRunResult holds the per-scenario results of a scenario file run
+type ScenarioRunResult struct {
+	Mode      TestMode        `json:"mode"`
+	Scenarios []ScenarioEntry `json:"scenarios"`
+}
+
+// ScenarioEntry summarizes the comparison run on a single scenario
+type ScenarioEntry struct {
+	Name           string  `json:"name"`
+	Description    string  `json:"description,omitempty"`
+	Hands          int     `json:"hands"`
+	ChallengerBB   float64 `json:"challenger_bb_per_100"`
+	BaselineBB     float64 `json:"baseline_bb_per_100"`
+	Difference     float64 `json:"difference"`
+	PValue         float64 `json:"p_value"`
+	Significant    bool    `json:"significant"`
+	Recommendation string  `json:"recommendation"`
+}
+
+// RunScenarios runs the test once per scenario, pinning config.Scenario (and
+// config.HandsTotal when the scenario sets its own hand count) for the duration
+// of the run, and collects the per-scenario results.
+func RunScenarios(ctx context.Context, config *Config, scenarios []Scenario, run func(context.Context) (*TestResult, error)) (*ScenarioRunResult, error) {
+	if len(scenarios) == 0 {
+		return nil, fmt.Errorf("scenario run requires at least one scenario")
+	}
+
+	originalScenario, originalHands := config.Scenario, config.HandsTotal
+	defer func() {
+		config.Scenario, config.HandsTotal = originalScenario, originalHands
+	}()
+
+	results := &ScenarioRunResult{Mode: config.Mode}
+	for i := range scenarios {
+		scenario := scenarios[i]
+		config.Logger.Info().
+			Int("scenario_index", i+1).
+			Int("scenarios", len(scenarios)).
+			Str("scenario", scenario.Name).
+			Msg("Running scenario comparison")
+
+		config.Scenario = &scenario
+		config.HandsTotal = originalHands
+		if scenario.Hands > 0 {
+			config.HandsTotal = scenario.Hands
+		}
+		result, err := run(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("scenario %q failed: %w", scenario.Name, err)
+		}
+		if results.Mode == "" {
+			results.Mode = result.Mode
+		}
+
+		// Scenario entries carry the same comparison as a seed sweep entry
+		entry := seedSweepEntry(0, result)
+		results.Scenarios = append(results.Scenarios, ScenarioEntry{
+			Name:           scenario.Name,
+			Description:    scenario.Description,
+			Hands:          entry.Hands,
+			ChallengerBB:   entry.ChallengerBB,
+			BaselineBB:     entry.BaselineBB,
+			Difference:     entry.Difference,
+			PValue:         entry.PValue,
+			Significant:    entry.Significant,
+			Recommendation: entry.Recommendation,
+		})
+	}
+	return results, nil
+}
+
+// WriteScenarioJSON outputs scenario results as indented JSON
+func (r *Reporter) WriteScenarioJSON(results *ScenarioRunResult) error {
+	encoder := json.NewEncoder(r.writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(results)
+}
+
+// WriteScenarioSummary outputs a human-readable table of scenario results
+func (r *Reporter) WriteScenarioSummary(results *ScenarioRunResult) error {
+	var sb strings.Builder
+
+	sb.WriteString("\nScenario Report\n")
+	sb.WriteString("===============\n")
+	sb.WriteString(fmt.Sprintf("Mode: %s\n", results.Mode))
+	sb.WriteString(fmt.Sprintf("Scenarios: %d\n\n", len(results.Scenarios)))
+
+	sb.WriteString(fmt.Sprintf("%-24s %8s %12s %12s %10s %8s  %s\n", "Scenario", "Hands", "Challenger", "Baseline", "Diff", "P-Value", "Verdict"))
+	for _, entry := range results.Scenarios {
+		marker := ""
+		if entry.Significant {
+			marker = " *"
+		}
+		sb.WriteString(fmt.Sprintf("%-24s %8d %12.2f %12.2f %+10.2f %8.3f  %s%s\n",
+			entry.Name, entry.Hands, entry.ChallengerBB, entry.BaselineBB, entry.Difference, entry.PValue, entry.Recommendation, marker))
+	}
+
+	_, err := fmt.Fprint(r.writer, sb.String())
+	return err
+}
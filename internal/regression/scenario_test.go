@@ -0,0 +1,135 @@
+package regression
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+const twoScenarios = `{
+  "scenarios": [
+    {
+      "name": "river-bluff-catch",
+      "hands": 500,
+      "hole_cards": [["Kh", "Td"], []],
+      "board": ["Ks", "9s", "4d", "2c", "As"]
+    },
+    {
+      "name": "short-stack-3bet",
+      "stacks": [1000, 250],
+      "hole_cards": [[], ["Ac", "Qd"]]
+    }
+  ]
+}`
+
+func writeScenarioFile(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "scenarios.json")
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatalf("failed to write scenario file: %v", err)
+	}
+	return path
+}
+
+func TestRunScenarios(t *testing.T) {
+	scenarios, err := LoadScenarios(writeScenarioFile(t, twoScenarios))
+	if err != nil {
+		t.Fatalf("LoadScenarios failed: %v", err)
+	}
+
+	config := &Config{
+		Mode:              ModeHeadsUp,
+		HandsTotal:        1000,
+		SignificanceLevel: 0.05,
+		Logger:            zerolog.Nop(),
+	}
+
+	// The challenger wins the bluff catch and loses the short-stack spot
+	var ran []string
+	run := func(context.Context) (*TestResult, error) {
+		if config.Scenario == nil {
+			t.Fatal("expected a scenario to be set during the run")
+		}
+		if _, err := config.Scenario.HandSetup(); err != nil {
+			t.Fatalf("scenario %q has invalid setup: %v", config.Scenario.Name, err)
+		}
+		ran = append(ran, config.Scenario.Name)
+		challenger := 25.0
+		if len(ran) == 2 {
+			challenger = -40
+		}
+		return &TestResult{
+			Mode:   ModeHeadsUp,
+			Config: TestConfigSummary{HandsTotal: config.HandsTotal},
+			Aggregate: AggregateResults{
+				Challenger: &BotResults{BBPer100: challenger},
+				Baseline:   &BotResults{BBPer100: 5},
+			},
+			Verdict: TestVerdict{PValue: 0.01, SignificantDifference: true, Recommendation: "accept"},
+		}, nil
+	}
+
+	results, err := RunScenarios(context.Background(), config, scenarios, run)
+	if err != nil {
+		t.Fatalf("RunScenarios failed: %v", err)
+	}
+
+	if len(results.Scenarios) != 2 {
+		t.Fatalf("expected 2 per-scenario results, got %d", len(results.Scenarios))
+	}
+	want := []struct {
+		name  string
+		hands int
+		diff  float64
+	}{
+		{"river-bluff-catch", 500, 20},
+		{"short-stack-3bet", 1000, -45},
+	}
+	for i, w := range want {
+		entry := results.Scenarios[i]
+		if entry.Name != w.name || ran[i] != w.name {
+			t.Errorf("result %d: expected scenario %s, got entry %s run %s", i, w.name, entry.Name, ran[i])
+		}
+		if entry.Hands != w.hands {
+			t.Errorf("%s: expected %d hands, got %d", w.name, w.hands, entry.Hands)
+		}
+		if entry.Difference != w.diff {
+			t.Errorf("%s: expected difference %.1f, got %.1f", w.name, w.diff, entry.Difference)
+		}
+	}
+	if config.Scenario != nil || config.HandsTotal != 1000 {
+		t.Errorf("expected config restored, got scenario %v and %d hands", config.Scenario, config.HandsTotal)
+	}
+
+	var buf bytes.Buffer
+	if err := NewReporter(&buf, zerolog.Nop(), config).WriteScenarioSummary(results); err != nil {
+		t.Fatalf("WriteScenarioSummary failed: %v", err)
+	}
+	for _, name := range []string{"river-bluff-catch", "short-stack-3bet"} {
+		if !strings.Contains(buf.String(), name) {
+			t.Errorf("expected %s in summary, got:\n%s", name, buf.String())
+		}
+	}
+}
+
+func TestLoadScenariosRejectsInvalid(t *testing.T) {
+	tests := map[string]string{
+		"empty":          `{"scenarios": []}`,
+		"missing name":   `{"scenarios": [{"board": ["As", "Kd", "2c"]}]}`,
+		"duplicate card": `{"scenarios": [{"name": "dup", "hole_cards": [["As", "Kd"]], "board": ["As", "7c", "2h"]}]}`,
+		"bad card":       `{"scenarios": [{"name": "bad", "board": ["Xx"]}]}`,
+		"one hole card":  `{"scenarios": [{"name": "short", "hole_cards": [["As"]]}]}`,
+	}
+	for name, contents := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := LoadScenarios(writeScenarioFile(t, contents)); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
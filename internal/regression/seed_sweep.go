@@ -0,0 +1,233 @@
+package regression
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/lox/pokerforbots/v2/internal/randutil"
+)
+
+// Seed sweep verdicts
+const (
+	SweepRobustImprovement = "robust_improvement"
+	SweepRobustRegression  = "robust_regression"
+	SweepInconsistent      = "inconsistent"
+	SweepNoEffect          = "no_effect"
+)
+
+// sweepAgreementThreshold is the fraction of seeds that must agree on the direction
+// of the effect before it is considered robust.
+const sweepAgreementThreshold = 0.8
+
+// SeedSweepResult holds the per-seed results of a seed sweep and their aggregate
+type SeedSweepResult struct {
+	Mode      TestMode         `json:"mode"`
+	Seeds     []SeedSweepEntry `json:"seeds"`
+	Aggregate SeedSweepSummary `json:"aggregate"`
+}
+
+// SeedSweepEntry summarizes the comparison run on a single seed
+type SeedSweepEntry struct {
+	Seed           int64   `json:"seed"`
+	Hands          int     `json:"hands"`
+	ChallengerBB   float64 `json:"challenger_bb_per_100"`
+	BaselineBB     float64 `json:"baseline_bb_per_100"`
+	Difference     float64 `json:"difference"`
+	PValue         float64 `json:"p_value"`
+	Significant    bool    `json:"significant"`
+	Recommendation string  `json:"recommendation"`
+}
+
+// SeedSweepSummary describes the distribution of effects across seeds
+type SeedSweepSummary struct {
+	MeanDifference   float64 `json:"mean_difference"`
+	StdDevDifference float64 `json:"std_dev_difference"`
+	MinDifference    float64 `json:"min_difference"`
+	MaxDifference    float64 `json:"max_difference"`
+	PositiveSeeds    int     `json:"positive_seeds"`
+	NegativeSeeds    int     `json:"negative_seeds"`
+	SignificantSeeds int     `json:"significant_seeds"`
+	PValue           float64 `json:"p_value"` // One-sample t-test of the per-seed differences
+	Robust           bool    `json:"robust"`
+	Verdict          string  `json:"verdict"`
+}
+
+// SweepSeeds deterministically derives n seeds from base. The first seed is
+// always base so a sweep includes the single-seed run it extends.
+func SweepSeeds(base int64, n int) []int64 {
+	if n <= 0 {
+		return nil
+	}
+	rng := randutil.New(base)
+	seeds := make([]int64, n)
+	seeds[0] = base
+	for i := 1; i < n; i++ {
+		seeds[i] = rng.Int64N(math.MaxInt32)
+	}
+	return seeds
+}
+
+// RunSeedSweep runs the test once per seed in seeds, pinning config.Seeds to
+// that seed for the duration of the run, and aggregates the per-seed results.
+func RunSeedSweep(ctx context.Context, config *Config, seeds []int64, run func(context.Context) (*TestResult, error)) (*SeedSweepResult, error) {
+	if len(seeds) == 0 {
+		return nil, fmt.Errorf("seed sweep requires at least one seed")
+	}
+
+	originalSeeds := config.Seeds
+	defer func() {
+		config.Seeds = originalSeeds
+	}()
+
+	sweep := &SeedSweepResult{Mode: config.Mode}
+	for i, seed := range seeds {
+		config.Logger.Info().
+			Int("seed_index", i+1).
+			Int("seeds", len(seeds)).
+			Int64("seed", seed).
+			Msg("Running seed sweep comparison")
+
+		config.Seeds = []int64{seed}
+		result, err := run(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("seed %d failed: %w", seed, err)
+		}
+		if sweep.Mode == "" {
+			sweep.Mode = result.Mode
+		}
+		sweep.Seeds = append(sweep.Seeds, seedSweepEntry(seed, result))
+	}
+
+	sweep.Aggregate = SummarizeSeedSweep(sweep.Seeds, config.SignificanceLevel)
+	return sweep, nil
+}
+
+// seedSweepEntry extracts the per-seed comparison from a test result
+func seedSweepEntry(seed int64, result *TestResult) SeedSweepEntry {
+	entry := SeedSweepEntry{
+		Seed:           seed,
+		Hands:          result.Config.HandsTotal,
+		PValue:         result.Verdict.PValue,
+		Significant:    result.Verdict.SignificantDifference,
+		Recommendation: result.Verdict.Recommendation,
+	}
+	if hands := CalculateTotalHands(result.Batches, "actual_hands"); hands > 0 {
+		entry.Hands = hands
+	}
+	if result.Aggregate.Challenger != nil {
+		entry.ChallengerBB = result.Aggregate.Challenger.BBPer100
+	}
+	if result.Aggregate.Baseline != nil {
+		entry.BaselineBB = result.Aggregate.Baseline.BBPer100
+	}
+	entry.Difference = entry.ChallengerBB - entry.BaselineBB
+	return entry
+}
+
+// SummarizeSeedSweep aggregates per-seed differences into a robustness verdict.
+// An effect is robust when the mean difference across seeds is significant and
+// at least 80% of seeds agree on its direction. Effects that are significant on
+// some seeds but not robust across them are reported as inconsistent.
+func SummarizeSeedSweep(entries []SeedSweepEntry, alpha float64) SeedSweepSummary {
+	summary := SeedSweepSummary{PValue: 1, Verdict: SweepNoEffect}
+	if len(entries) == 0 {
+		return summary
+	}
+
+	summary.MinDifference = math.Inf(1)
+	summary.MaxDifference = math.Inf(-1)
+	var sum float64
+	for _, entry := range entries {
+		sum += entry.Difference
+		summary.MinDifference = math.Min(summary.MinDifference, entry.Difference)
+		summary.MaxDifference = math.Max(summary.MaxDifference, entry.Difference)
+		switch {
+		case entry.Difference > 0:
+			summary.PositiveSeeds++
+		case entry.Difference < 0:
+			summary.NegativeSeeds++
+		}
+		if entry.Significant {
+			summary.SignificantSeeds++
+		}
+	}
+
+	n := len(entries)
+	summary.MeanDifference = sum / float64(n)
+	if n > 1 {
+		var sumSq float64
+		for _, entry := range entries {
+			diff := entry.Difference - summary.MeanDifference
+			sumSq += diff * diff
+		}
+		summary.StdDevDifference = math.Sqrt(sumSq / float64(n-1))
+
+		stdErr := summary.StdDevDifference / math.Sqrt(float64(n))
+		switch {
+		case stdErr > 0:
+			summary.PValue = calculatePValue(summary.MeanDifference/stdErr, n-1)
+		case summary.MeanDifference != 0:
+			// Every seed produced the same non-zero effect
+			summary.PValue = 0
+		}
+	}
+
+	agreeing := summary.NegativeSeeds
+	verdict := SweepRobustRegression
+	if summary.MeanDifference > 0 {
+		agreeing = summary.PositiveSeeds
+		verdict = SweepRobustImprovement
+	}
+
+	switch {
+	case summary.MeanDifference != 0 && summary.PValue < alpha &&
+		float64(agreeing) >= sweepAgreementThreshold*float64(n):
+		summary.Robust = true
+		summary.Verdict = verdict
+	case summary.SignificantSeeds > 0:
+		summary.Verdict = SweepInconsistent
+	}
+	return summary
+}
+
+// WriteSeedSweepJSON outputs a seed sweep as indented JSON
+func (r *Reporter) WriteSeedSweepJSON(sweep *SeedSweepResult) error {
+	encoder := json.NewEncoder(r.writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(sweep)
+}
+
+// WriteSeedSweepSummary outputs a human-readable summary of a seed sweep
+func (r *Reporter) WriteSeedSweepSummary(sweep *SeedSweepResult) error {
+	var sb strings.Builder
+
+	sb.WriteString("\nSeed Sweep Report\n")
+	sb.WriteString("=================\n")
+	sb.WriteString(fmt.Sprintf("Mode: %s\n", sweep.Mode))
+	sb.WriteString(fmt.Sprintf("Seeds: %d\n\n", len(sweep.Seeds)))
+
+	sb.WriteString(fmt.Sprintf("%-12s %8s %12s %12s %10s %8s\n", "Seed", "Hands", "Challenger", "Baseline", "Diff", "P-Value"))
+	for _, entry := range sweep.Seeds {
+		marker := ""
+		if entry.Significant {
+			marker = " *"
+		}
+		sb.WriteString(fmt.Sprintf("%-12d %8d %12.2f %12.2f %+10.2f %8.3f%s\n",
+			entry.Seed, entry.Hands, entry.ChallengerBB, entry.BaselineBB, entry.Difference, entry.PValue, marker))
+	}
+
+	agg := sweep.Aggregate
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf("Mean difference: %+.2f BB/100 (std dev %.2f, range %+.2f to %+.2f)\n",
+		agg.MeanDifference, agg.StdDevDifference, agg.MinDifference, agg.MaxDifference))
+	sb.WriteString(fmt.Sprintf("Seeds positive/negative: %d/%d, individually significant: %d\n",
+		agg.PositiveSeeds, agg.NegativeSeeds, agg.SignificantSeeds))
+	sb.WriteString(fmt.Sprintf("Across-seed P-Value: %.3f\n", agg.PValue))
+	sb.WriteString(fmt.Sprintf("\nSweep verdict: %s\n", strings.ToUpper(strings.ReplaceAll(agg.Verdict, "_", " "))))
+
+	_, err := fmt.Fprint(r.writer, sb.String())
+	return err
+}
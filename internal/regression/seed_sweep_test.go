@@ -0,0 +1,156 @@
+package regression
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+func TestRunSeedSweep(t *testing.T) {
+	config := &Config{
+		Mode:              ModeHeadsUp,
+		HandsTotal:        1000,
+		Seeds:             []int64{7},
+		SignificanceLevel: 0.05,
+		Logger:            zerolog.Nop(),
+	}
+	seeds := SweepSeeds(7, 5)
+
+	// Each seed yields a slightly different, consistently positive effect
+	var ran []int64
+	run := func(context.Context) (*TestResult, error) {
+		seed := config.Seeds[0]
+		ran = append(ran, seed)
+		return &TestResult{
+			Mode:   ModeHeadsUp,
+			Config: TestConfigSummary{HandsTotal: config.HandsTotal},
+			Aggregate: AggregateResults{
+				Challenger: &BotResults{BBPer100: 10 + float64(len(ran))},
+				Baseline:   &BotResults{BBPer100: -2},
+			},
+			Verdict: TestVerdict{PValue: 0.2, Recommendation: "inconclusive"},
+		}, nil
+	}
+
+	sweep, err := RunSeedSweep(context.Background(), config, seeds, run)
+	if err != nil {
+		t.Fatalf("RunSeedSweep failed: %v", err)
+	}
+
+	if len(sweep.Seeds) != len(seeds) {
+		t.Fatalf("expected %d per-seed results, got %d", len(seeds), len(sweep.Seeds))
+	}
+	for i, entry := range sweep.Seeds {
+		if entry.Seed != seeds[i] || ran[i] != seeds[i] {
+			t.Errorf("result %d: expected seed %d, got entry %d run %d", i, seeds[i], entry.Seed, ran[i])
+		}
+		if want := 12 + float64(i+1); entry.Difference != want {
+			t.Errorf("seed %d: expected difference %.1f, got %.1f", entry.Seed, want, entry.Difference)
+		}
+		if entry.Hands != 1000 {
+			t.Errorf("seed %d: expected 1000 hands, got %d", entry.Seed, entry.Hands)
+		}
+	}
+	if len(config.Seeds) != 1 || config.Seeds[0] != 7 {
+		t.Errorf("expected config seeds restored to [7], got %v", config.Seeds)
+	}
+
+	agg := sweep.Aggregate
+	if agg.MeanDifference != 15 || agg.MinDifference != 13 || agg.MaxDifference != 17 {
+		t.Errorf("unexpected distribution: mean %.2f min %.2f max %.2f", agg.MeanDifference, agg.MinDifference, agg.MaxDifference)
+	}
+	if agg.PositiveSeeds != 5 || agg.SignificantSeeds != 0 {
+		t.Errorf("expected 5 positive and 0 significant seeds, got %d and %d", agg.PositiveSeeds, agg.SignificantSeeds)
+	}
+	// No single seed was significant, but the effect is consistent across seeds
+	if !agg.Robust || agg.Verdict != SweepRobustImprovement {
+		t.Errorf("expected robust improvement, got robust=%v verdict=%s (p=%.4f)", agg.Robust, agg.Verdict, agg.PValue)
+	}
+
+	var buf bytes.Buffer
+	if err := NewReporter(&buf, zerolog.Nop(), config).WriteSeedSweepSummary(sweep); err != nil {
+		t.Fatalf("WriteSeedSweepSummary failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), "Sweep verdict: ROBUST IMPROVEMENT") {
+		t.Errorf("expected verdict in summary, got:\n%s", buf.String())
+	}
+}
+
+func TestSummarizeSeedSweep(t *testing.T) {
+	tests := []struct {
+		name       string
+		entries    []SeedSweepEntry
+		wantRobust bool
+		want       string
+	}{
+		{
+			name: "lucky seed",
+			entries: []SeedSweepEntry{
+				{Difference: 40, Significant: true},
+				{Difference: -5},
+				{Difference: 3},
+				{Difference: -8},
+				{Difference: 1},
+			},
+			want: SweepInconsistent,
+		},
+		{
+			name: "consistent regression",
+			entries: []SeedSweepEntry{
+				{Difference: -10, Significant: true},
+				{Difference: -12, Significant: true},
+				{Difference: -9},
+				{Difference: -11},
+			},
+			wantRobust: true,
+			want:       SweepRobustRegression,
+		},
+		{
+			name: "noise",
+			entries: []SeedSweepEntry{
+				{Difference: 2},
+				{Difference: -3},
+				{Difference: 1},
+			},
+			want: SweepNoEffect,
+		},
+		{
+			name: "single seed",
+			entries: []SeedSweepEntry{
+				{Difference: 20, Significant: true},
+			},
+			want: SweepInconsistent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary := SummarizeSeedSweep(tt.entries, 0.05)
+			if summary.Robust != tt.wantRobust || summary.Verdict != tt.want {
+				t.Errorf("expected robust=%v verdict=%s, got robust=%v verdict=%s (p=%.4f)",
+					tt.wantRobust, tt.want, summary.Robust, summary.Verdict, summary.PValue)
+			}
+		})
+	}
+}
+
+func TestSweepSeedsDeterministic(t *testing.T) {
+	first := SweepSeeds(42, 4)
+	second := SweepSeeds(42, 4)
+	if len(first) != 4 || first[0] != 42 {
+		t.Fatalf("expected 4 seeds starting with 42, got %v", first)
+	}
+	seen := make(map[int64]bool)
+	for i := range first {
+		if first[i] != second[i] {
+			t.Errorf("seed %d differs between runs: %d vs %d", i, first[i], second[i])
+		}
+		if seen[first[i]] {
+			t.Errorf("duplicate seed %d", first[i])
+		}
+		seen[first[i]] = true
+	}
+}
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"github.com/lox/pokerforbots/v2/internal/game"
+	"github.com/lox/pokerforbots/v2/protocol"
+)
+
+// actionContext follows the betting within a hand so each voluntary action can
+// be classified for the player_action context field.
+type actionContext struct {
+	street        game.Street
+	bets          int          // Bets and raises this street, counting the big blind preflop
+	aggressor     int          // Seat that made the street's last bet or raise, -1 for none
+	prevAggressor int          // Aggressor of the previous street, -1 for none
+	checked       map[int]bool // Seats that checked this street
+}
+
+func newActionContext() actionContext {
+	return actionContext{
+		street:        game.Preflop,
+		bets:          1,
+		aggressor:     -1,
+		prevAggressor: -1,
+		checked:       make(map[int]bool),
+	}
+}
+
+// classify records an action taken on street and returns its context:
+// protocol.ContextCheckRaise, protocol.ContextThreeBet, protocol.ContextCBet or "".
+// raised reports whether the action increased the bet to match.
+func (c *actionContext) classify(street game.Street, seat int, action game.Action, raised bool) string {
+	if street != c.street {
+		c.street = street
+		c.bets = 0
+		c.prevAggressor = c.aggressor
+		c.aggressor = -1
+		clear(c.checked)
+	}
+
+	if action == game.Check {
+		c.checked[seat] = true
+		return ""
+	}
+	if !raised {
+		return ""
+	}
+
+	opening := c.bets == 0
+	c.bets++
+	c.aggressor = seat
+
+	switch {
+	case c.checked[seat]:
+		return protocol.ContextCheckRaise
+	case c.bets == 3:
+		return protocol.ContextThreeBet
+	case opening && seat == c.prevAggressor:
+		return protocol.ContextCBet
+	}
+	return ""
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/lox/pokerforbots/v2/internal/randutil"
 
 	"slices"
+	"sync/atomic"
 	"testing"
 
 	"github.com/lox/pokerforbots/v2/internal/game"
@@ -81,3 +82,22 @@ func BenchmarkGameEngine(b *testing.B) {
 func getSeed(i int64) int64 {
 	return 12345 + i // Deterministic seeds for reproducibility
 }
+
+// BenchmarkStatsMonitorConcurrentHands measures contention on the stats monitor
+// when many tables record hands at once.
+func BenchmarkStatsMonitorConcurrentHands(b *testing.B) {
+	monitor := NewStatsMonitor(10, true, 0)
+	var tables atomic.Int64
+
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		table := tables.Add(1)
+		raiser := &Bot{ID: fmt.Sprintf("raiser-%d", table), done: make(chan struct{})}
+		folder := &Bot{ID: fmt.Sprintf("folder-%d", table), done: make(chan struct{})}
+		for i := 0; pb.Next(); i++ {
+			for _, event := range statsHandEvents(monitor, fmt.Sprintf("table-%d-hand-%d", table, i), raiser, folder, i%2) {
+				event()
+			}
+		}
+	})
+}
@@ -22,6 +22,8 @@ type Bot struct {
 	lastPing        time.Time
 	closed          bool                // Track if bot is closed
 	done            chan struct{}       // Signal channel closure
+	closing         chan struct{}       // Signal to flush queued messages and close
+	closingOnce     sync.Once           // Ensures closing is only signalled once
 	actionChan      chan ActionEnvelope // Channel to send actions to hand runner with bot ID
 	handRunnerMu    sync.RWMutex
 	bankroll        int // Total chips the bot has
@@ -29,6 +31,8 @@ type Bot struct {
 	displayName     string
 	gameID          string
 	botCommand      string // Original bot command for tracking
+	missedBlinds    bool   // Sat out while a hand was dealt and owes blinds on re-entry
+	pinnedSeat      int    // Seat requested on connect, -1 to be seated randomly
 	ProtocolVersion string // "1" or "2" - which protocol version this bot speaks
 }
 
@@ -46,6 +50,17 @@ func (b *Bot) Done() <-chan struct{} {
 	return b.done
 }
 
+// CloseAfterFlush closes the connection once all queued messages have been written.
+func (b *Bot) CloseAfterFlush() {
+	if b.closing == nil {
+		b.close()
+		return
+	}
+	b.closingOnce.Do(func() {
+		close(b.closing)
+	})
+}
+
 // IsClosed reports whether the bot connection has been closed.
 func (b *Bot) IsClosed() bool {
 	b.mu.RLock()
@@ -68,14 +83,16 @@ func NewBot(logger zerolog.Logger, id string, conn *websocket.Conn, pool *BotPoo
 	bankroll := maxBuyIn * defaultBankrollBB
 
 	return &Bot{
-		ID:       id,
-		conn:     conn,
-		send:     make(chan []byte, 256),
-		pool:     pool,
-		lastPing: time.Now(),
-		done:     make(chan struct{}),
-		bankroll: bankroll,
-		logger:   logger.With().Str("component", "bot").Str("bot_id", id).Logger(),
+		ID:         id,
+		conn:       conn,
+		send:       make(chan []byte, 256),
+		pool:       pool,
+		lastPing:   time.Now(),
+		done:       make(chan struct{}),
+		closing:    make(chan struct{}),
+		bankroll:   bankroll,
+		pinnedSeat: -1,
+		logger:     logger.With().Str("component", "bot").Str("bot_id", id).Logger(),
 	}
 }
 
@@ -93,6 +110,20 @@ func (b *Bot) DisplayName() string {
 	return b.displayName
 }
 
+// SetPinnedSeat pins the bot to a seat for every hand it is dealt into; -1 unpins it.
+func (b *Bot) SetPinnedSeat(seat int) {
+	b.mu.Lock()
+	b.pinnedSeat = seat
+	b.mu.Unlock()
+}
+
+// PinnedSeat returns the seat the bot is pinned to, or -1 if it is seated randomly.
+func (b *Bot) PinnedSeat() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.pinnedSeat
+}
+
 // SetGameID records the game identifier the bot is currently assigned to.
 func (b *Bot) SetGameID(gameID string) {
 	b.mu.Lock()
@@ -139,6 +170,27 @@ func (b *Bot) SendMessage(msg any) error {
 	}
 }
 
+// MarkMissedBlinds records that a hand was dealt while the bot sat out.
+func (b *Bot) MarkMissedBlinds() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.missedBlinds = true
+}
+
+// OwesMissedBlinds reports whether the bot must post missed blinds on its next hand.
+func (b *Bot) OwesMissedBlinds() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.missedBlinds
+}
+
+// ClearMissedBlinds resets the missed blind obligation once it has been settled.
+func (b *Bot) ClearMissedBlinds() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.missedBlinds = false
+}
+
 // SetInHand marks the bot as being in a hand or not
 func (b *Bot) SetInHand(inHand bool) {
 	b.mu.Lock()
@@ -160,21 +212,38 @@ func (b *Bot) SetActionChannel(ch chan ActionEnvelope) {
 	b.actionChan = ch
 }
 
-// GetBuyIn returns the buy-in amount for this bot (capped at the table's starting stack)
+// GetBuyIn returns the buy-in amount for this bot (capped at the table's starting stack).
+// With auto-rebuy, a bankroll below the rebuy threshold is first topped back up to
+// the starting stack. An effective stack depth replaces the starting stack as the cap,
+// so chips above it sit out the hand while short bankrolls play what they have.
 func (b *Bot) GetBuyIn() int {
-	b.mu.RLock()
-	defer b.mu.RUnlock()
+	b.mu.Lock()
+	defer b.mu.Unlock()
 
 	maxBuyIn := defaultMaxBuyIn
 	if b.pool != nil && b.pool.config.StartChips > 0 {
 		maxBuyIn = b.pool.config.StartChips
 	}
+	if b.pool != nil && b.pool.config.EffectiveStackBB > 0 {
+		maxBuyIn = b.pool.config.EffectiveStackBB * b.pool.config.BigBlind
+	}
 
 	// With infinite bankroll, always return the max buy-in
 	if b.pool != nil && b.pool.config.InfiniteBankroll {
 		return maxBuyIn
 	}
 
+	if b.pool != nil && b.pool.config.AutoRebuy {
+		threshold := b.pool.config.RebuyThreshold
+		if threshold <= 0 || threshold > maxBuyIn {
+			threshold = maxBuyIn
+		}
+		if b.bankroll < threshold {
+			b.logger.Debug().Int("bankroll", b.bankroll).Int("rebuy", maxBuyIn-b.bankroll).Msg("Auto-rebuy")
+			b.bankroll = maxBuyIn
+		}
+	}
+
 	if b.bankroll >= maxBuyIn {
 		return maxBuyIn
 	}
@@ -200,13 +269,24 @@ func (b *Bot) ApplyResult(delta int) {
 	}
 }
 
+// inheritBankroll carries over the bankroll of a previous connection with the same ID.
+func (b *Bot) inheritBankroll(previous *Bot) {
+	previous.mu.RLock()
+	bankroll := previous.bankroll
+	previous.mu.RUnlock()
+
+	b.mu.Lock()
+	b.bankroll = bankroll
+	b.mu.Unlock()
+}
+
 // HasChips returns true if the bot has chips to play
 func (b *Bot) HasChips() bool {
 	b.mu.RLock()
 	defer b.mu.RUnlock()
 
-	// If infinite bankroll is enabled, bots always have chips
-	if b.pool != nil && b.pool.config.InfiniteBankroll {
+	// With infinite bankroll or auto-rebuy, bots always have chips
+	if b.pool != nil && (b.pool.config.InfiniteBankroll || b.pool.config.AutoRebuy) {
 		return true
 	}
 
@@ -303,6 +383,14 @@ func (b *Bot) WritePump() {
 			_ = b.conn.WriteMessage(websocket.CloseMessage, []byte{})
 			return
 
+		case <-b.closing:
+			if !b.flushQueued() {
+				return
+			}
+			b.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			_ = b.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, "server shutdown"))
+			return
+
 		case <-ticker.C:
 			b.conn.SetWriteDeadline(time.Now().Add(writeWait))
 			if err := b.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
@@ -311,3 +399,19 @@ func (b *Bot) WritePump() {
 		}
 	}
 }
+
+// flushQueued writes any messages still waiting in the send buffer.
+// Returns false if a write fails.
+func (b *Bot) flushQueued() bool {
+	for {
+		select {
+		case message := <-b.send:
+			b.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := b.conn.WriteMessage(websocket.BinaryMessage, message); err != nil {
+				return false
+			}
+		default:
+			return true
+		}
+	}
+}
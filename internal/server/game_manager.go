@@ -1,6 +1,8 @@
 package server
 
 import (
+	"context"
+	"sort"
 	"sync"
 	"time"
 
@@ -135,6 +137,19 @@ func (gm *GameManager) ListGames() []GameSummary {
 	return summaries
 }
 
+// Games returns a snapshot of registered game instances ordered by ID.
+func (gm *GameManager) Games() []*GameInstance {
+	gm.mu.RLock()
+	defer gm.mu.RUnlock()
+
+	games := make([]*GameInstance, 0, len(gm.games))
+	for _, game := range gm.games {
+		games = append(games, game)
+	}
+	sort.Slice(games, func(i, j int) bool { return games[i].ID < games[j].ID })
+	return games
+}
+
 // GameStats retrieves statistics for a game by ID.
 func (gm *GameManager) GameStats(id string) (GameStats, bool) {
 	gm.mu.RLock()
@@ -149,6 +164,19 @@ func (gm *GameManager) GameStats(id string) (GameStats, bool) {
 	return stats, true
 }
 
+// TableState retrieves the public state of a game's hands in progress by ID.
+func (gm *GameManager) TableState(id string) (TableState, bool) {
+	gm.mu.RLock()
+	defer gm.mu.RUnlock()
+
+	instance, ok := gm.games[id]
+	if !ok {
+		return TableState{}, false
+	}
+
+	return TableState{GameID: instance.ID, Hands: instance.Pool.TableHands()}, true
+}
+
 // Stats returns aggregate statistics for the game instance.
 func (gi *GameInstance) Stats() GameStats {
 	timeoutMs := int(gi.Config.Timeout / time.Millisecond)
@@ -174,6 +202,7 @@ func (gi *GameInstance) Stats() GameStats {
 		TimeoutMs:        timeoutMs,
 		MinPlayers:       gi.Config.MinPlayers,
 		MaxPlayers:       gi.Config.MaxPlayers,
+		OpenSeats:        gi.Pool.OpenSeats(),
 		InfiniteBankroll: gi.Config.InfiniteBankroll,
 		HandsCompleted:   handsCompleted,
 		HandLimit:        handLimit,
@@ -217,3 +246,29 @@ func (gm *GameManager) StopAll() {
 		game.Pool.Stop()
 	}
 }
+
+// DrainAll stops all game pools and waits for their in-flight hands to finish.
+// Returns the context error if any hand is still running when ctx is done.
+func (gm *GameManager) DrainAll(ctx context.Context) error {
+	games := gm.Games()
+
+	// Stop every pool first so no table starts a new hand while another drains
+	for _, game := range games {
+		game.Pool.Stop()
+	}
+
+	for _, game := range games {
+		if err := game.Pool.Drain(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DisconnectAll notifies every connected bot across all games that the server is
+// shutting down and closes their connections.
+func (gm *GameManager) DisconnectAll(reason string) {
+	for _, game := range gm.Games() {
+		game.Pool.DisconnectAll(reason)
+	}
+}
@@ -82,6 +82,7 @@ func (m *Manager) CreateMonitor(gameID string) (*Monitor, error) {
 		Filename:         defaultFilename,
 		FlushHands:       m.cfg.FlushHands,
 		IncludeHoleCards: m.cfg.IncludeHoleCards,
+		AnonymizePlayers: m.cfg.AnonymizePlayers,
 		Variant:          m.cfg.Variant,
 		Clock:            m.cfg.Clock,
 	}
@@ -35,6 +35,7 @@ type Monitor struct {
 	consecutiveFailures int
 	disabled            bool
 	sectionCounter      int
+	aliases             map[string]string // Anonymized name for each player seen
 }
 
 type handState struct {
@@ -154,7 +155,7 @@ func (m *Monitor) OnHandStart(handID string, players []Player, button int, blind
 		history.Seats[pos] = player.Seat + 1
 		history.StartingStacks[pos] = player.Chips
 		history.FinishingStacks[pos] = player.Chips
-		history.Players[pos] = player.Name
+		history.Players[pos] = m.recordedName(player.Name)
 		if player.Seat >= 0 && player.Seat < len(seatToPlayerIdx) {
 			seatToPlayerIdx[player.Seat] = pos
 		}
@@ -317,6 +318,23 @@ func (m *Monitor) IsDisabled() bool {
 	return m.disabled
 }
 
+// recordedName returns the name written for a player, which is a stable
+// PlayerN alias when players are anonymized. Callers must hold m.mu.
+func (m *Monitor) recordedName(name string) string {
+	if !m.cfg.AnonymizePlayers {
+		return name
+	}
+	if alias, ok := m.aliases[name]; ok {
+		return alias
+	}
+	if m.aliases == nil {
+		m.aliases = make(map[string]string)
+	}
+	alias := fmt.Sprintf("Player%d", len(m.aliases)+1)
+	m.aliases[name] = alias
+	return alias
+}
+
 func (m *Monitor) ensureSeatContributions(n int) {
 	if cap(m.seatContributions) < n {
 		m.seatContributions = make([]int, n)
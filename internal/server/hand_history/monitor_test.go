@@ -1,6 +1,7 @@
 package handhistory
 
 import (
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
@@ -227,3 +228,68 @@ func TestMonitorFlushWritesSectionHeaders(t *testing.T) {
 		t.Fatalf("expected no metadata table, got %s", contents)
 	}
 }
+
+func TestMonitorAnonymizesPlayersAcrossHands(t *testing.T) {
+	dir := t.TempDir()
+	monitor, err := NewMonitor(MonitorConfig{
+		GameID:           "test",
+		OutputDir:        dir,
+		AnonymizePlayers: true,
+		Clock:            stubClock{current: time.Date(2025, time.January, 2, 3, 4, 5, 0, time.UTC)},
+	}, zerolog.New(io.Discard))
+	if err != nil {
+		t.Fatalf("NewMonitor error: %v", err)
+	}
+
+	hands := []struct {
+		players []Player
+		button  int
+		want    []string // Recorded names from the small blind round to the button
+	}{
+		{
+			players: []Player{{Seat: 0, Name: "alice", Chips: 200}, {Seat: 1, Name: "bob", Chips: 200}, {Seat: 2, Name: "carol", Chips: 200}},
+			button:  0,
+			want:    []string{"Player1", "Player2", "Player3"}, // bob, carol, alice
+		},
+		{
+			players: []Player{{Seat: 0, Name: "alice", Chips: 210}, {Seat: 1, Name: "bob", Chips: 190}, {Seat: 2, Name: "dave", Chips: 200}},
+			button:  1,
+			want:    []string{"Player4", "Player3", "Player1"}, // dave, alice, bob
+		},
+	}
+	for i, hand := range hands {
+		handID := fmt.Sprintf("hand-%d", i+1)
+		monitor.OnHandStart(handID, hand.players, hand.button, Blinds{Small: 1, Big: 2})
+		monitor.OnHandComplete(Outcome{
+			HandID: handID,
+			Detail: &OutcomeDetail{BotOutcomes: []BotOutcome{
+				{Name: hand.players[0].Name, Seat: 0, NetChips: 10},
+				{Name: hand.players[1].Name, Seat: 1, NetChips: -10},
+			}},
+		})
+	}
+
+	for i, hand := range hands {
+		hist := monitor.buffer[i]
+		if !slices.Equal(hist.Players, hand.want) {
+			t.Errorf("hand %d: players = %v, want %v", i+1, hist.Players, hand.want)
+		}
+	}
+	// Seats and results are kept: alice in seat 1 is last to act and won 10
+	if got := monitor.buffer[0]; !slices.Equal(got.Seats, []int{2, 3, 1}) || !slices.Equal(got.Winnings, []int{0, 0, 10}) {
+		t.Errorf("expected seats and winnings to survive anonymizing, got seats %v winnings %v", got.Seats, got.Winnings)
+	}
+
+	if err := monitor.Flush(); err != nil {
+		t.Fatalf("Flush error: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, defaultFilename))
+	if err != nil {
+		t.Fatalf("Read file: %v", err)
+	}
+	for _, name := range []string{"alice", "bob", "carol", "dave"} {
+		if strings.Contains(string(data), name) {
+			t.Errorf("export leaks player name %q:\n%s", name, data)
+		}
+	}
+}
@@ -0,0 +1,269 @@
+package handhistory
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lox/pokerforbots/v2/internal/phh"
+)
+
+// Render returns a readable text replay of a PHH hand: the seats and
+// positions, blinds, hole cards, each street's board and actions, the
+// showdown and the winners. Actions it does not recognise are shown verbatim.
+func Render(h *phh.HandHistory) string {
+	if h == nil {
+		return ""
+	}
+	r := newRenderer(h)
+	r.header()
+	for _, raw := range h.Actions {
+		r.action(strings.TrimSpace(raw))
+	}
+	r.result()
+	return r.out.String()
+}
+
+// renderer tracks the betting while it replays a hand.
+type renderer struct {
+	hand     *phh.HandHistory
+	out      strings.Builder
+	bets     []int // Chips each player has put in this street
+	highBet  int   // Largest bet this street
+	board    []string
+	street   string // Street whose header has been written, "" before the first action
+	showdown bool
+}
+
+func newRenderer(h *phh.HandHistory) *renderer {
+	return &renderer{hand: h, bets: make([]int, len(h.Players))}
+}
+
+func (r *renderer) header() {
+	h := r.hand
+	fmt.Fprintf(&r.out, "Hand %s", h.HandID)
+	if details := strings.Join(nonEmpty(h.Variant, h.Table), ", "); details != "" {
+		fmt.Fprintf(&r.out, " (%s)", details)
+	}
+	r.out.WriteString("\n")
+
+	for pos, name := range h.Players {
+		seat := pos + 1
+		if pos < len(h.Seats) {
+			seat = h.Seats[pos]
+		}
+		fmt.Fprintf(&r.out, "  Seat %d: %s (%s) %d chips\n", seat, name, renderPosition(pos, len(h.Players)), valueAt(h.StartingStacks, pos))
+	}
+
+	var blinds []string
+	for pos, amount := range h.BlindsOrStraddles {
+		if amount > 0 && pos < len(r.bets) {
+			r.bets[pos] = amount
+			r.highBet = max(r.highBet, amount)
+			blinds = append(blinds, strconv.Itoa(amount))
+		}
+	}
+	if len(blinds) > 0 {
+		fmt.Fprintf(&r.out, "Blinds: %s\n", strings.Join(blinds, "/"))
+	}
+	for pos, ante := range h.Antes {
+		if ante > 0 {
+			fmt.Fprintf(&r.out, "  %s posts ante %d\n", r.name(pos), ante)
+		}
+	}
+}
+
+func (r *renderer) action(raw string) {
+	fields := strings.Fields(raw)
+	switch {
+	case len(fields) == 0:
+		return
+	case len(fields) >= 4 && fields[0] == "d" && fields[1] == "dh":
+		r.streetHeader("preflop")
+		fmt.Fprintf(&r.out, "  %s is dealt %s\n", r.name(playerIndex(fields[2])), renderCards(fields[3]))
+		return
+	case len(fields) >= 3 && fields[0] == "d" && fields[1] == "db":
+		r.board = append(r.board, splitCards(fields[2])...)
+		r.nextStreet()
+		return
+	}
+
+	pos := playerIndex(fields[0])
+	if pos < 0 || len(fields) < 2 {
+		r.streetHeader("preflop")
+		fmt.Fprintf(&r.out, "  %s\n", raw)
+		return
+	}
+	if fields[1] == "sm" {
+		if !r.showdown {
+			r.out.WriteString("*** SHOWDOWN ***\n")
+			r.showdown = true
+		}
+		if len(fields) >= 3 {
+			fmt.Fprintf(&r.out, "  %s shows %s\n", r.name(pos), renderCards(fields[2]))
+		}
+		return
+	}
+
+	r.streetHeader("preflop")
+	name := r.name(pos)
+	switch fields[1] {
+	case "f":
+		fmt.Fprintf(&r.out, "  %s folds\n", name)
+	case "cc":
+		toCall := r.highBet - r.bet(pos)
+		if toCall <= 0 {
+			fmt.Fprintf(&r.out, "  %s checks\n", name)
+			return
+		}
+		r.setBet(pos, r.highBet)
+		fmt.Fprintf(&r.out, "  %s calls %d\n", name, toCall)
+	case "cbr":
+		total := 0
+		if len(fields) >= 3 {
+			total, _ = strconv.Atoi(fields[2])
+		}
+		verb := "raises to"
+		if r.highBet == 0 {
+			verb = "bets"
+		}
+		r.setBet(pos, total)
+		r.highBet = max(r.highBet, total)
+		fmt.Fprintf(&r.out, "  %s %s %d\n", name, verb, total)
+	default:
+		fmt.Fprintf(&r.out, "  %s\n", raw)
+	}
+}
+
+// streetHeader writes the header for street unless one has been written.
+func (r *renderer) streetHeader(street string) {
+	if r.street != "" {
+		return
+	}
+	r.street = street
+	fmt.Fprintf(&r.out, "*** %s ***\n", strings.ToUpper(street))
+}
+
+// nextStreet starts the street the board has reached and resets the betting.
+func (r *renderer) nextStreet() {
+	r.street = boardStreet(len(r.board))
+	clear(r.bets)
+	r.highBet = 0
+	fmt.Fprintf(&r.out, "*** %s *** [%s]\n", strings.ToUpper(r.street), strings.Join(r.board, " "))
+}
+
+func (r *renderer) result() {
+	h := r.hand
+	r.out.WriteString("*** RESULT ***\n")
+	won := false
+	for pos := range h.Players {
+		amount := valueAt(h.Winnings, pos)
+		if amount <= 0 && pos < len(h.FinishingStacks) {
+			amount = valueAt(h.FinishingStacks, pos) - valueAt(h.StartingStacks, pos)
+		}
+		if amount > 0 {
+			fmt.Fprintf(&r.out, "  %s wins %d\n", r.name(pos), amount)
+			won = true
+		}
+	}
+	if !won {
+		r.out.WriteString("  No winners recorded\n")
+	}
+}
+
+func (r *renderer) name(pos int) string {
+	if pos >= 0 && pos < len(r.hand.Players) && r.hand.Players[pos] != "" {
+		return r.hand.Players[pos]
+	}
+	return fmt.Sprintf("p%d", pos+1)
+}
+
+func (r *renderer) bet(pos int) int {
+	return valueAt(r.bets, pos)
+}
+
+func (r *renderer) setBet(pos, total int) {
+	if pos >= 0 && pos < len(r.bets) {
+		r.bets[pos] = total
+	}
+}
+
+// renderPosition names the table position of the player at PHH index pos,
+// where players are listed from the small blind and the button acts last.
+func renderPosition(pos, playerCount int) string {
+	if playerCount == 2 {
+		if pos == 0 {
+			return "BTN/SB"
+		}
+		return "BB"
+	}
+	switch fromButton := (pos + 1) % playerCount; {
+	case fromButton == 0:
+		return "BTN"
+	case fromButton == 1:
+		return "SB"
+	case fromButton == 2:
+		return "BB"
+	case fromButton == playerCount-1 && playerCount >= 5:
+		return "CO"
+	case fromButton == playerCount-2 && playerCount >= 6:
+		return "HJ"
+	case fromButton == 3:
+		return "UTG"
+	default:
+		return fmt.Sprintf("UTG+%d", fromButton-3)
+	}
+}
+
+func boardStreet(cards int) string {
+	switch {
+	case cards <= 3:
+		return "flop"
+	case cards == 4:
+		return "turn"
+	default:
+		return "river"
+	}
+}
+
+// playerIndex parses a PHH player token like "p3" into a zero-based index.
+func playerIndex(token string) int {
+	if !strings.HasPrefix(token, "p") {
+		return -1
+	}
+	n, err := strconv.Atoi(token[1:])
+	if err != nil || n < 1 {
+		return -1
+	}
+	return n - 1
+}
+
+// splitCards splits a PHH card run like "AhKd" into cards.
+func splitCards(run string) []string {
+	var cards []string
+	for i := 0; i+1 < len(run); i += 2 {
+		cards = append(cards, run[i:i+2])
+	}
+	return cards
+}
+
+func renderCards(run string) string {
+	return strings.Join(splitCards(run), " ")
+}
+
+func valueAt(values []int, i int) int {
+	if i < 0 || i >= len(values) {
+		return 0
+	}
+	return values[i]
+}
+
+func nonEmpty(values ...string) []string {
+	var out []string
+	for _, v := range values {
+		if v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,94 @@
+package handhistory
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lox/pokerforbots/v2/internal/phh"
+)
+
+func TestRenderHand(t *testing.T) {
+	t.Parallel()
+
+	hand := &phh.HandHistory{
+		Variant:           "NT",
+		Table:             "test",
+		Seats:             []int{1, 2, 3},
+		Antes:             []int{0, 0, 0},
+		BlindsOrStraddles: []int{1, 2, 0},
+		StartingStacks:    []int{200, 200, 200},
+		FinishingStacks:   []int{199, 182, 219},
+		Winnings:          []int{0, 0, 19},
+		Players:           []string{"alice", "bob", "carol"},
+		HandID:            "hand-1",
+		Actions: []string{
+			"d dh p1 ????",
+			"d dh p2 7c2d",
+			"d dh p3 AhKh",
+			"p3 cbr 6",
+			"p1 f",
+			"p2 cc",
+			"d db Ah7d2c",
+			"p2 cc",
+			"p3 cbr 12",
+			"p2 cc",
+			"d db 9s",
+			"p2 cc",
+			"p3 cc",
+			"d db 3h",
+			"p2 cc",
+			"p3 cc",
+			"p2 sm 7c2d",
+			"p3 sm AhKh",
+		},
+	}
+
+	want := strings.Join([]string{
+		"Hand hand-1 (NT, test)",
+		"  Seat 1: alice (SB) 200 chips",
+		"  Seat 2: bob (BB) 200 chips",
+		"  Seat 3: carol (BTN) 200 chips",
+		"Blinds: 1/2",
+		"*** PREFLOP ***",
+		"  alice is dealt ?? ??",
+		"  bob is dealt 7c 2d",
+		"  carol is dealt Ah Kh",
+		"  carol raises to 6",
+		"  alice folds",
+		"  bob calls 4",
+		"*** FLOP *** [Ah 7d 2c]",
+		"  bob checks",
+		"  carol bets 12",
+		"  bob calls 12",
+		"*** TURN *** [Ah 7d 2c 9s]",
+		"  bob checks",
+		"  carol checks",
+		"*** RIVER *** [Ah 7d 2c 9s 3h]",
+		"  bob checks",
+		"  carol checks",
+		"*** SHOWDOWN ***",
+		"  bob shows 7c 2d",
+		"  carol shows Ah Kh",
+		"*** RESULT ***",
+		"  carol wins 19",
+		"",
+	}, "\n")
+
+	if got := Render(hand); got != want {
+		t.Errorf("Render() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestRenderPosition(t *testing.T) {
+	t.Parallel()
+
+	want := []string{"SB", "BB", "UTG", "UTG+1", "HJ", "CO", "BTN"}
+	for pos, label := range want {
+		if got := renderPosition(pos, len(want)); got != label {
+			t.Errorf("renderPosition(%d, %d) = %q, want %q", pos, len(want), got, label)
+		}
+	}
+	if got := renderPosition(0, 2); got != "BTN/SB" {
+		t.Errorf("expected the heads-up button to be BTN/SB, got %q", got)
+	}
+}
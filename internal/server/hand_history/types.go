@@ -34,6 +34,7 @@ type MonitorConfig struct {
 	Filename         string
 	FlushHands       int
 	IncludeHoleCards bool
+	AnonymizePlayers bool // Record players as Player1..PlayerN in order of first appearance
 	Variant          string
 	Clock            Clock
 }
@@ -69,6 +70,7 @@ type ManagerConfig struct {
 	FlushInterval    time.Duration
 	FlushHands       int
 	IncludeHoleCards bool
+	AnonymizePlayers bool
 	Variant          string
 	Clock            Clock
 }
@@ -0,0 +1,80 @@
+package server
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lox/pokerforbots/v2/internal/randutil"
+)
+
+// handIDPrefix starts every hand ID.
+const handIDPrefix = "hand-"
+
+// HandID returns the ID of hand number num in a game with the given master
+// seed, like "hand-000000000000002a-00000001". The seed is hex encoded so
+// negative seeds round trip, and the number is zero padded so IDs from one game
+// sort in the order the hands were dealt.
+func HandID(seed int64, num uint64) string {
+	return fmt.Sprintf("%s%016x-%08d", handIDPrefix, uint64(seed), num)
+}
+
+// ParseHandID recovers the master seed and hand number from an ID made by HandID.
+// Together with HandSeed they reproduce the hand's deck.
+func ParseHandID(id string) (seed int64, num uint64, err error) {
+	rest, ok := strings.CutPrefix(id, handIDPrefix)
+	if !ok {
+		return 0, 0, fmt.Errorf("hand ID %q: missing %q prefix", id, handIDPrefix)
+	}
+	seedPart, numPart, ok := strings.Cut(rest, "-")
+	if !ok {
+		return 0, 0, fmt.Errorf("hand ID %q: expected seed and hand number", id)
+	}
+	rawSeed, err := strconv.ParseUint(seedPart, 16, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("hand ID %q: invalid seed: %w", id, err)
+	}
+	num, err = strconv.ParseUint(numPart, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("hand ID %q: invalid hand number: %w", id, err)
+	}
+	return int64(rawSeed), num, nil
+}
+
+// Each hand's randomness is split into independent streams derived from its
+// hand seed, so the deck is shuffled identically no matter how much other
+// randomness the server draws while running the hand:
+//
+//	hand seed   = Derive(master seed, hand number)
+//	deck seed   = Derive(hand seed, deckStream)
+//	server seed = Derive(hand seed, serverStream)
+//	study seed  = Derive(hand seed, studyStream)
+const (
+	deckStream uint64 = iota
+	serverStream
+	studyStream
+)
+
+// HandSeed returns the seed that every RNG stream of hand number num of a game
+// with the given master seed derives from.
+func HandSeed(seed int64, num uint64) int64 {
+	return randutil.Derive(seed, num)
+}
+
+// DeckSeed returns the seed of the RNG that shuffles hand number num of a game
+// with the given master seed.
+func DeckSeed(seed int64, num uint64) int64 {
+	return randutil.Derive(HandSeed(seed, num), deckStream)
+}
+
+// serverSeed returns the seed of the RNG for any server-side randomness in
+// hand number num, kept apart from the deck so using it never changes the deal.
+func serverSeed(seed int64, num uint64) int64 {
+	return randutil.Derive(HandSeed(seed, num), serverStream)
+}
+
+// studySeed returns the seed of the RNG that draws study runouts in hand number
+// num, so enabling them never changes the deal or other server draws.
+func studySeed(seed int64, num uint64) int64 {
+	return randutil.Derive(HandSeed(seed, num), studyStream)
+}
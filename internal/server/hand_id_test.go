@@ -0,0 +1,89 @@
+package server
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/lox/pokerforbots/v2/internal/randutil"
+	"github.com/lox/pokerforbots/v2/poker"
+)
+
+func TestHandIDRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, seed := range []int64{42, -7, 0, 1<<63 - 1} {
+		seen := make(map[string]bool)
+		previous := ""
+		for num := uint64(1); num <= 1000; num++ {
+			id := HandID(seed, num)
+			if seen[id] {
+				t.Fatalf("duplicate hand ID %s", id)
+			}
+			seen[id] = true
+			if id <= previous {
+				t.Fatalf("expected %s to sort after %s", id, previous)
+			}
+			previous = id
+
+			gotSeed, gotNum, err := ParseHandID(id)
+			if err != nil {
+				t.Fatalf("ParseHandID(%q): %v", id, err)
+			}
+			if gotSeed != seed || gotNum != num {
+				t.Fatalf("ParseHandID(%q) = (%d, %d), want (%d, %d)", id, gotSeed, gotNum, seed, num)
+			}
+		}
+	}
+}
+
+func TestHandSeedIsDeterministic(t *testing.T) {
+	t.Parallel()
+
+	if HandSeed(42, 7) != HandSeed(42, 7) {
+		t.Error("expected the same hand seed for the same seed and hand number")
+	}
+	seeds := make(map[int64]bool)
+	for num := uint64(1); num <= 1000; num++ {
+		seeds[HandSeed(42, num)] = true
+	}
+	if len(seeds) != 1000 {
+		t.Errorf("expected 1000 distinct hand seeds, got %d", len(seeds))
+	}
+	if HandSeed(42, 1) == HandSeed(43, 1) {
+		t.Error("expected different master seeds to give different hand seeds")
+	}
+}
+
+func TestParseHandIDRejectsMalformed(t *testing.T) {
+	t.Parallel()
+
+	for _, id := range []string{"", "hand-42", "game-000000000000002a-00000001", "hand-zz-00000001", "hand-000000000000002a-x"} {
+		if _, _, err := ParseHandID(id); err == nil {
+			t.Errorf("expected ParseHandID(%q) to fail", id)
+		}
+	}
+}
+
+func TestDeckIndependentOfServerRandomness(t *testing.T) {
+	t.Parallel()
+
+	deal := func(serverDraws int) []poker.Card {
+		rng := randutil.New(serverSeed(42, 1))
+		for range serverDraws {
+			rng.Int64()
+		}
+		runner := NewHandRunner(testLogger(), nil, HandID(42, 1), 0, rng)
+		runner.SetDeckRNG(randutil.New(DeckSeed(42, 1)))
+		return runner.shuffleDeck().Deal(52)
+	}
+
+	want := deal(0)
+	for _, draws := range []int{1, 10, 1000} {
+		if got := deal(draws); !slices.Equal(got, want) {
+			t.Errorf("deck changed after %d server-side draws", draws)
+		}
+	}
+	if DeckSeed(42, 1) == serverSeed(42, 1) {
+		t.Error("expected the deck and server streams to have different seeds")
+	}
+}
@@ -24,24 +24,33 @@ const (
 	defaultSmallBlind = 5
 	defaultBigBlind   = 10
 	defaultStartChips = 1000
+
+	// Default per-street action cap guarding against runaway betting loops
+	defaultMaxActionsPerStreet = 1000
 )
 
 // HandRunner manages the execution of a single poker hand
 type HandRunner struct {
-	bots          []*Bot
-	handState     *game.HandState
-	button        int
-	handID        string
-	actions       chan BotAction
-	botActionChan chan ActionEnvelope // Channel to receive actions from bots with ID verification
-	seatBuyIns    []int               // Track actual buy-in per seat for accurate P&L
-	playerLabels  []string
-	networkNames  []string
-	lastStreet    game.Street
-	logger        zerolog.Logger
-	rng           *rand.Rand
-	pool          *BotPool // Reference to pool for metrics
-	config        Config   // Server configuration
+	bots           []*Bot
+	handState      *game.HandState
+	button         int
+	handID         string
+	actions        chan BotAction
+	botActionChan  chan ActionEnvelope // Channel to receive actions from bots with ID verification
+	seatBuyIns     []int               // Track actual buy-in per seat for accurate P&L
+	playerLabels   []string
+	networkNames   []string
+	lastStreet     game.Street
+	seatStreets    []game.Street // Furthest street each seat was dealt into without folding
+	lastFolder     int           // Seat of the most recent fold, -1 if nobody has folded
+	lastFoldForced bool          // The most recent fold was forced after an invalid action
+	actionCtx      actionContext // Classifies actions as c-bets, 3-bets and check-raises
+	logger         zerolog.Logger
+	rng            *rand.Rand
+	deckRNG        *rand.Rand // Shuffles the deck, kept apart from rng so other draws never change the deal
+	studyRNG       *rand.Rand // Draws study runouts, kept apart from rng so studying never changes other draws
+	pool           *BotPool   // Reference to pool for metrics
+	config         Config     // Server configuration
 
 	// Track actions for statistics (only if enabled)
 	trackActions      bool
@@ -98,6 +107,8 @@ func NewHandRunnerWithConfig(logger zerolog.Logger, bots []*Bot, handID string,
 		actions:        make(chan BotAction, 1),
 		botActionChan:  actionChan,
 		lastStreet:     game.Preflop,
+		lastFolder:     -1,
+		actionCtx:      newActionContext(),
 		logger:         logger.With().Str("component", "hand_runner").Str("hand_id", handID).Logger(),
 		rng:            rng,
 		config:         config,
@@ -109,11 +120,23 @@ func NewHandRunnerWithConfig(logger zerolog.Logger, bots []*Bot, handID string,
 	return hr
 }
 
+// SetDeckRNG sets the RNG that shuffles the deck. Without one the deck is
+// shuffled from a stream drawn off the runner's RNG.
+func (hr *HandRunner) SetDeckRNG(rng *rand.Rand) {
+	hr.deckRNG = rng
+}
+
+// SetStudyRNG sets the RNG that draws study runouts. Without one the runouts
+// are drawn from a stream drawn off the runner's RNG.
+func (hr *HandRunner) SetStudyRNG(rng *rand.Rand) {
+	hr.studyRNG = rng
+}
+
 // SetPool sets the pool reference for metrics tracking
 func (hr *HandRunner) SetPool(pool *BotPool) {
 	hr.pool = pool
 	if pool != nil {
-		hr.latencyEnabled = pool.config.EnableLatencyTracking
+		hr.latencyEnabled = pool.tracksActionLatency()
 	} else {
 		hr.latencyEnabled = false
 	}
@@ -156,6 +179,12 @@ func (hr *HandRunner) recordResponseLatency(botIndex int, outcome ResponseOutcom
 }
 
 func (hr *HandRunner) displayName(observerSeat, targetSeat int) string {
+	if observerSeat == spectatorSeat && targetSeat >= 0 && targetSeat < len(hr.bots) {
+		if name := hr.bots[targetSeat].DisplayName(); name != "" {
+			return name
+		}
+		return hr.bots[targetSeat].ID
+	}
 	if observerSeat == targetSeat {
 		if targetSeat >= 0 && targetSeat < len(hr.playerLabels) && hr.playerLabels[targetSeat] != "" {
 			return hr.playerLabels[targetSeat]
@@ -168,6 +197,19 @@ func (hr *HandRunner) displayName(observerSeat, targetSeat int) string {
 	return fmt.Sprintf("bot-%d", targetSeat+1)
 }
 
+// shuffleDeck shuffles the hand's deck from the deck RNG, with any forced
+// cards from the configured HandSetup in place.
+func (hr *HandRunner) shuffleDeck() *poker.Deck {
+	deckRNG := hr.deckRNG
+	if deckRNG == nil {
+		deckRNG = randutil.New(hr.rng.Int64())
+	}
+	if setup := hr.config.HandSetup; setup != nil {
+		return setup.deck(deckRNG, len(hr.bots), hr.button)
+	}
+	return poker.NewDeck(deckRNG)
+}
+
 // Run executes the hand
 func (hr *HandRunner) Run() {
 	startTime := time.Now()
@@ -192,19 +234,51 @@ func (hr *HandRunner) Run() {
 	}
 
 	// Initialize hand state with individual chip counts and deterministic deck
-	// Clone the RNG to avoid concurrent access issues
-	deckRNG := randutil.New(hr.rng.Int64())
-	deck := poker.NewDeck(deckRNG)
+	if setup := hr.config.HandSetup; setup != nil {
+		setup.applyStacks(chipCounts, hr.button)
+	}
+	deck := hr.shuffleDeck()
+	maxActions := hr.config.MaxActionsPerStreet
+	if maxActions <= 0 {
+		maxActions = defaultMaxActionsPerStreet
+	}
+	opts := []game.HandOption{
+		game.WithChipsByPlayer(chipCounts),
+		game.WithDeck(deck),
+		game.WithMaxActionsPerStreet(maxActions),
+		game.WithVariant(hr.config.Variant),
+	}
+	if hr.config.PostMissedBlinds {
+		opts = append(opts, game.WithMissedBlinds(hr.missedBlindSeats()...))
+	}
+	if hr.config.Ante > 0 {
+		opts = append(opts, game.WithAnte(hr.config.Ante, game.AnteMainPot))
+	}
+	switch hr.config.Straddle {
+	case game.NoStraddle:
+	case game.MississippiStraddle:
+		seat := (hr.button + hr.config.StraddleSeat) % len(hr.bots)
+		opts = append(opts, game.WithMississippiStraddle(seat))
+	default:
+		opts = append(opts, game.WithStraddle(hr.config.Straddle))
+	}
+	if hr.config.StudyRunouts > 0 {
+		studyRNG := hr.studyRNG
+		if studyRNG == nil {
+			studyRNG = randutil.New(hr.rng.Int64())
+		}
+		opts = append(opts, game.WithStudyRunouts(hr.config.StudyRunouts, studyRNG))
+	}
 	hr.handState = game.NewHandState(
-		deckRNG,
+		hr.rng,
 		playerNames,
 		hr.button,
 		hr.config.SmallBlind,
 		hr.config.BigBlind,
-		game.WithChipsByPlayer(chipCounts),
-		game.WithDeck(deck),
+		opts...,
 	)
 	hr.lastStreet = hr.handState.Street
+	hr.seatStreets = make([]game.Street, len(hr.bots))
 
 	// Store the actual buy-ins for P&L calculation later
 	hr.seatBuyIns = chipCounts
@@ -215,6 +289,11 @@ func (hr *HandRunner) Run() {
 	// Broadcast blind posts
 	hr.broadcastBlindPosts()
 
+	// Drop the hand from the admin state endpoint once it's over
+	if hr.pool != nil {
+		defer hr.pool.tables.remove(hr.handID)
+	}
+
 	// Run betting rounds until hand is complete
 	for !hr.handState.IsComplete() {
 		if hr.foldDisconnectedPlayers(-1) {
@@ -260,6 +339,7 @@ func (hr *HandRunner) Run() {
 			// Active player disconnected before acting, loop to pick next player
 			continue
 		}
+		hr.publishTableState()
 		if err := hr.sendActionRequest(bot, activePlayer, validActions); err != nil {
 			if errors.Is(err, ErrBotClosed) {
 				if hr.botDisconnects != nil && activePlayer < len(hr.botDisconnects) {
@@ -283,8 +363,8 @@ func (hr *HandRunner) Run() {
 		// Broadcast game update
 		hr.broadcastGameUpdate()
 
-		// Check for street change
-		if hr.handState.Street != hr.lastStreet {
+		// Check for street change (a hand won uncontested ends on the current street)
+		if hr.handState.Street != hr.lastStreet && !hr.wonUncontested() {
 			previousStreet := hr.lastStreet
 			hr.broadcastStreetChange(previousStreet)
 			hr.lastStreet = hr.handState.Street
@@ -299,6 +379,7 @@ func (hr *HandRunner) Run() {
 
 	// Log aggregated hand summary and update bankrolls
 	hr.logHandSummary(winners)
+	hr.logStudyRunouts()
 
 	// Log hand completion time
 	elapsed := time.Since(startTime)
@@ -313,6 +394,19 @@ func (hr *HandRunner) Run() {
 
 }
 
+// missedBlindSeats returns the seats of bots returning after sitting out. Being
+// dealt in settles the obligation, whether through the regular blinds or a dead blind.
+func (hr *HandRunner) missedBlindSeats() []int {
+	var seats []int
+	for i, bot := range hr.bots {
+		if bot.OwesMissedBlinds() {
+			seats = append(seats, i)
+			bot.ClearMissedBlinds()
+		}
+	}
+	return seats
+}
+
 // broadcastHandStart sends the initial hand information to all bots
 func (hr *HandRunner) broadcastHandStart() {
 	// Notify monitor of hand start
@@ -330,10 +424,7 @@ func (hr *HandRunner) broadcastHandStart() {
 				Name:        bot.ID,      // Stable bot ID for stats tracking
 				DisplayName: displayName, // Human-readable name for display
 				Chips:       player.Chips,
-				HoleCards: []string{
-					player.HoleCards.GetCard(0).String(),
-					player.HoleCards.GetCard(1).String(),
-				},
+				HoleCards:   player.HoleCards.Cards(),
 			}
 		}
 		blinds := Blinds{
@@ -343,31 +434,28 @@ func (hr *HandRunner) broadcastHandStart() {
 		monitor.OnHandStart(hr.handID, players, hr.button, blinds)
 	}
 
+	straddleSeat := hr.handState.StraddleSeat()
+	straddle := 0
+	if straddleSeat >= 0 {
+		straddle = hr.handState.Players[straddleSeat].Bet
+	}
+
 	for i, bot := range hr.bots {
 		player := hr.handState.Players[i]
-
-		// Create player list for this bot's perspective
-		players := make([]protocol.Player, len(hr.bots))
-		for j, p := range hr.handState.Players {
-			players[j] = protocol.Player{
-				Name:  hr.displayName(i, j),
-				Chips: p.Chips,
-				Seat:  p.Seat,
-			}
-		}
-
 		msg := &protocol.HandStart{
-			Type:     "hand_start",
-			HandID:   hr.handID,
-			Players:  players,
-			Button:   hr.button,
-			YourSeat: i,
-			HoleCards: []string{
-				player.HoleCards.GetCard(0).String(),
-				player.HoleCards.GetCard(1).String(),
-			},
-			SmallBlind: hr.config.SmallBlind,
-			BigBlind:   hr.config.BigBlind,
+			Type:         "hand_start",
+			HandID:       hr.handID,
+			Players:      hr.handStartPlayers(i),
+			Button:       hr.button,
+			YourSeat:     i,
+			HoleCards:    player.HoleCards.Cards(),
+			SmallBlind:   hr.config.SmallBlind,
+			BigBlind:     hr.config.BigBlind,
+			Variant:      hr.handState.Variant.String(),
+			BettingLimit: protocol.NoLimit,
+			Ante:         hr.config.Ante,
+			Straddle:     straddle,
+			StraddleSeat: straddleSeat,
 		}
 
 		if bot.IsClosed() {
@@ -379,6 +467,46 @@ func (hr *HandRunner) broadcastHandStart() {
 			}
 		}
 	}
+
+	hr.notifySpectators(func() any {
+		return &protocol.HandStart{
+			Type:         "hand_start",
+			HandID:       hr.handID,
+			Players:      hr.handStartPlayers(spectatorSeat),
+			Button:       hr.button,
+			YourSeat:     spectatorSeat,
+			SmallBlind:   hr.config.SmallBlind,
+			BigBlind:     hr.config.BigBlind,
+			Variant:      hr.handState.Variant.String(),
+			BettingLimit: protocol.NoLimit,
+			Ante:         hr.config.Ante,
+			Straddle:     straddle,
+			StraddleSeat: straddleSeat,
+		}
+	})
+}
+
+// handStartPlayers lists the seated players from observerSeat's point of view.
+func (hr *HandRunner) handStartPlayers(observerSeat int) []protocol.Player {
+	players := make([]protocol.Player, len(hr.bots))
+	for j, p := range hr.handState.Players {
+		players[j] = protocol.Player{
+			Name:      hr.displayName(observerSeat, j),
+			Chips:     p.Chips,
+			Seat:      p.Seat,
+			DeadBlind: p.DeadBlind,
+		}
+	}
+	return players
+}
+
+// notifySpectators sends the public view of an event to the game's observers.
+// build is only called when someone is watching.
+func (hr *HandRunner) notifySpectators(build func() any) {
+	if hr.pool == nil || !hr.pool.observers.active() {
+		return
+	}
+	hr.pool.observers.broadcast(build())
 }
 
 // convertActionsForProtocol converts valid_actions based on protocol version
@@ -438,8 +566,8 @@ func (hr *HandRunner) sendActionRequest(bot *Bot, seat int, validActions []game.
 		HandID:        hr.handID,
 		Pot:           pot,
 		ToCall:        toCall,
-		MinBet:        hr.handState.Betting.CurrentBet + hr.handState.Betting.MinRaise,
-		MinRaise:      hr.handState.Betting.MinRaise,
+		MinBet:        hr.handState.Betting.MinRaiseTo(),
+		MinRaise:      hr.handState.Betting.MinRaiseTo() - hr.handState.Betting.CurrentBet,
 		ValidActions:  actions,
 		TimeRemaining: int(hr.config.Timeout.Milliseconds()),
 	}
@@ -497,6 +625,9 @@ func (hr *HandRunner) waitForAction(botIndex int) (game.Action, int) {
 	case <-hr.bots[botIndex].Done():
 		hr.recordResponseLatency(botIndex, ResponseOutcomeDisconnect)
 		hr.logger.Warn().Str("bot_id", hr.bots[botIndex].ID).Msg("Bot disconnected during action window")
+		if hr.pool != nil {
+			hr.pool.IncrementDisconnectCounter()
+		}
 		if hr.botDisconnects != nil && botIndex < len(hr.botDisconnects) {
 			hr.botDisconnects[botIndex] = true
 		}
@@ -650,31 +781,50 @@ func (hr *HandRunner) convertAction(action protocol.Action) (game.Action, int) {
 
 // processAction processes a bot's action and broadcasts it
 func (hr *HandRunner) processAction(botIndex int, action game.Action, amount int) game.Action {
-	// Track the player's bet before the action
-	playerBetBefore := hr.handState.Players[botIndex].Bet
+	action, amount = hr.clampRaise(botIndex, action, amount)
+
+	// Track the player's committed chips before the action. TotalBet survives
+	// the street advancing, unlike Bet.
+	committedBefore := hr.handState.Players[botIndex].TotalBet
+	streetBefore := hr.handState.Street
+	betBefore := hr.handState.Players[botIndex].Bet
+	currentBetBefore := hr.handState.Betting.CurrentBet
 
 	if err := hr.handState.ProcessAction(action, amount); err != nil {
+		msg := "Invalid action from bot - forcing fold"
+		if errors.Is(err, game.ErrActionCapExceeded) {
+			msg = "Action cap exceeded - forcing fold"
+		}
 		hr.logger.Error().
 			Err(err).
 			Str("bot_id", hr.bots[botIndex].ID).
 			Str("action", action.String()).
 			Int("amount", amount).
 			Int("seat", botIndex).
-			Msg("Invalid action from bot - forcing fold")
+			Msg(msg)
 		if hr.botInvalidActions != nil && botIndex < len(hr.botInvalidActions) {
 			hr.botInvalidActions[botIndex]++
 		}
+		if hr.pool != nil {
+			hr.pool.IncrementInvalidActionCounter()
+		}
 		// Force fold on invalid action
 		_ = hr.handState.ProcessAction(game.Fold, 0)
+		hr.lastFolder = botIndex
+		hr.lastFoldForced = true
 
 		// Broadcast the forced fold
 		hr.broadcastPlayerAction(botIndex, "timeout_fold", 0)
 		return game.Fold
 	}
 
-	// Calculate amount paid (difference in bet)
-	playerBetAfter := hr.handState.Players[botIndex].Bet
-	amountPaid := playerBetAfter - playerBetBefore
+	if action == game.Fold {
+		hr.lastFolder = botIndex
+		hr.lastFoldForced = false
+	}
+
+	// Calculate amount paid (difference in committed chips)
+	amountPaid := hr.handState.Players[botIndex].TotalBet - committedBefore
 
 	// Map action to string for broadcast
 	actionStr := action.String()
@@ -684,12 +834,75 @@ func (hr *HandRunner) processAction(botIndex int, action game.Action, amount int
 		actionStr = strings.ToLower(actionStr)
 	}
 
+	raised := betBefore+amountPaid > currentBetBefore
+	context := hr.actionCtx.classify(streetBefore, botIndex, action, raised)
+
 	// Broadcast the player action
-	hr.broadcastPlayerAction(botIndex, actionStr, amountPaid)
+	hr.broadcastPlayerActionWithContext(botIndex, actionStr, amountPaid, context)
 
 	return action
 }
 
+// clampRaise adjusts a raise the engine would otherwise reject or convert so the
+// broadcast reflects what was actually played: a raise to at least the player's
+// stack becomes an all-in, and a raise below the minimum is lifted to the
+// minimum, or to all-in if the player can't cover it.
+func (hr *HandRunner) clampRaise(botIndex int, action game.Action, amount int) (game.Action, int) {
+	if action != game.Raise {
+		return action, amount
+	}
+
+	p := hr.handState.Players[botIndex]
+	stack := p.Chips + p.Bet
+	minRaiseTo := hr.handState.Betting.MinRaiseTo()
+
+	clamped, clampedAmount := action, amount
+	switch {
+	case amount >= stack:
+		clamped, clampedAmount = game.AllIn, 0
+	case amount < minRaiseTo && minRaiseTo >= stack:
+		clamped, clampedAmount = game.AllIn, 0
+	case amount < minRaiseTo:
+		clampedAmount = minRaiseTo
+	default:
+		return action, amount
+	}
+
+	hr.logger.Debug().
+		Str("bot_id", hr.bots[botIndex].ID).
+		Int("seat", botIndex).
+		Int("requested", amount).
+		Str("action", clamped.String()).
+		Int("amount", clampedAmount).
+		Msg("Clamped raise")
+	return clamped, clampedAmount
+}
+
+// wonUncontested reports whether every player but one has folded.
+func (hr *HandRunner) wonUncontested() bool {
+	remaining := 0
+	for _, p := range hr.handState.Players {
+		if !p.Folded {
+			remaining++
+		}
+	}
+	return remaining <= 1
+}
+
+// endReason reports how the hand ended, as sent in the hand result.
+func (hr *HandRunner) endReason() string {
+	if !hr.wonUncontested() {
+		return protocol.HandEndShowdown
+	}
+	if hr.lastFolder >= 0 && hr.bots[hr.lastFolder].IsClosed() {
+		return protocol.HandEndSinglePlayerRemaining
+	}
+	if hr.lastFoldForced {
+		return protocol.HandEndForcedFold
+	}
+	return protocol.HandEndAllFolded
+}
+
 // foldDisconnectedPlayers scans for closed bot connections (excluding skipSeat) and force-folds them.
 // Returns true if any folds occurred.
 func (hr *HandRunner) foldDisconnectedPlayers(skipSeat int) bool {
@@ -729,9 +942,11 @@ func (hr *HandRunner) forceFoldSeat(seat int) bool {
 		Str("bot", hr.playerLabels[seat]).
 		Msg("Bot disconnected - forcing fold")
 	hr.handState.ForceFold(seat)
+	hr.lastFolder = seat
+	hr.lastFoldForced = false
 	hr.broadcastPlayerAction(seat, "timeout_fold", 0)
 	hr.broadcastGameUpdate()
-	if hr.handState.Street != prevStreet {
+	if hr.handState.Street != prevStreet && !hr.wonUncontested() {
 		hr.broadcastStreetChange(prevStreet)
 		hr.lastStreet = hr.handState.Street
 	}
@@ -760,10 +975,25 @@ func (hr *HandRunner) broadcastBlindPosts() {
 	// Broadcast big blind post
 	bbPlayer := hr.handState.Players[bbPos]
 	hr.broadcastPlayerAction(bbPos, "post_big_blind", bbPlayer.Bet)
+
+	// Returning players post a dead small blind followed by a live big blind
+	for seat, player := range hr.handState.Players {
+		if player.DeadBlind == 0 {
+			continue
+		}
+		hr.broadcastPlayerAction(seat, "post_dead_blind", player.DeadBlind)
+		hr.broadcastPlayerAction(seat, "post_big_blind", player.Bet)
+	}
 }
 
 // broadcastPlayerAction sends detailed action information to all bots
 func (hr *HandRunner) broadcastPlayerAction(seat int, action string, amountPaid int) {
+	hr.broadcastPlayerActionWithContext(seat, action, amountPaid, "")
+}
+
+// broadcastPlayerActionWithContext broadcasts a voluntary action along with its
+// classification, e.g. protocol.ContextCheckRaise.
+func (hr *HandRunner) broadcastPlayerActionWithContext(seat int, action string, amountPaid int, context string) {
 	player := hr.handState.Players[seat]
 	pot := hr.totalPot()
 
@@ -774,18 +1004,7 @@ func (hr *HandRunner) broadcastPlayerAction(seat int, action string, amountPaid
 	}
 
 	for observerSeat, bot := range hr.bots {
-		msg := &protocol.PlayerAction{
-			Type:        "player_action",
-			HandID:      hr.handID,
-			Street:      hr.handState.Street.String(),
-			Seat:        seat,
-			PlayerName:  hr.displayName(observerSeat, seat),
-			Action:      action,
-			AmountPaid:  amountPaid,
-			PlayerBet:   player.Bet,
-			PlayerChips: player.Chips,
-			Pot:         pot,
-		}
+		msg := hr.playerActionMessage(observerSeat, seat, action, amountPaid, pot, context)
 
 		if bot.IsClosed() {
 			continue
@@ -796,29 +1015,35 @@ func (hr *HandRunner) broadcastPlayerAction(seat int, action string, amountPaid
 			}
 		}
 	}
+
+	hr.notifySpectators(func() any {
+		return hr.playerActionMessage(spectatorSeat, seat, action, amountPaid, pot, context)
+	})
+}
+
+// playerActionMessage describes seat's action from observerSeat's point of view.
+func (hr *HandRunner) playerActionMessage(observerSeat, seat int, action string, amountPaid, pot int, context string) *protocol.PlayerAction {
+	player := hr.handState.Players[seat]
+	return &protocol.PlayerAction{
+		Type:        "player_action",
+		HandID:      hr.handID,
+		Street:      hr.handState.Street.String(),
+		Seat:        seat,
+		PlayerName:  hr.displayName(observerSeat, seat),
+		Action:      action,
+		AmountPaid:  amountPaid,
+		PlayerBet:   player.Bet,
+		PlayerChips: player.Chips,
+		Pot:         pot,
+		Context:     context,
+	}
 }
 
 // broadcastGameUpdate sends game state updates to all bots
 func (hr *HandRunner) broadcastGameUpdate() {
 	totalPot := hr.totalPot()
-	players := make([]protocol.Player, len(hr.handState.Players))
 	for observerSeat, bot := range hr.bots {
-		for seat, p := range hr.handState.Players {
-			players[seat] = protocol.Player{
-				Name:   hr.displayName(observerSeat, seat),
-				Chips:  p.Chips,
-				Bet:    p.Bet,
-				Folded: p.Folded,
-				AllIn:  p.AllInFlag,
-			}
-		}
-
-		msg := &protocol.GameUpdate{
-			Type:    "game_update",
-			HandID:  hr.handID,
-			Pot:     totalPot,
-			Players: players,
-		}
+		msg := hr.gameUpdateMessage(observerSeat, totalPot)
 
 		if bot.IsClosed() {
 			continue
@@ -829,6 +1054,58 @@ func (hr *HandRunner) broadcastGameUpdate() {
 			}
 		}
 	}
+
+	hr.notifySpectators(func() any {
+		return hr.gameUpdateMessage(spectatorSeat, totalPot)
+	})
+}
+
+// gameUpdateMessage describes the table from observerSeat's point of view.
+func (hr *HandRunner) gameUpdateMessage(observerSeat, totalPot int) *protocol.GameUpdate {
+	players := make([]protocol.Player, len(hr.handState.Players))
+	for seat, p := range hr.handState.Players {
+		players[seat] = protocol.Player{
+			Name:   hr.displayName(observerSeat, seat),
+			Chips:  p.Chips,
+			Bet:    p.Bet,
+			Folded: p.Folded,
+			AllIn:  p.AllInFlag,
+		}
+	}
+	return &protocol.GameUpdate{
+		Type:    "game_update",
+		HandID:  hr.handID,
+		Pot:     totalPot,
+		Players: players,
+	}
+}
+
+// publishTableState records the public state of the hand for the admin state
+// endpoint, from a spectator's point of view.
+func (hr *HandRunner) publishTableState() {
+	if hr.pool == nil {
+		return
+	}
+	players := make([]TableStatePlayer, len(hr.handState.Players))
+	for seat, p := range hr.handState.Players {
+		players[seat] = TableStatePlayer{
+			Seat:   seat,
+			Name:   hr.displayName(spectatorSeat, seat),
+			Chips:  p.Chips,
+			Bet:    p.Bet,
+			Folded: p.Folded,
+			AllIn:  p.AllInFlag,
+		}
+	}
+	hr.pool.tables.update(TableHand{
+		HandID:  hr.handID,
+		Street:  hr.handState.Street.String(),
+		Board:   hr.boardStrings(),
+		Pot:     hr.totalPot(),
+		Button:  hr.button,
+		ToAct:   hr.handState.ActivePlayer,
+		Players: players,
+	})
 }
 
 func (hr *HandRunner) boardStrings() []string {
@@ -843,6 +1120,44 @@ func (hr *HandRunner) boardStrings() []string {
 	return boardCards
 }
 
+// logStudyRunouts logs how often each seat still in won the runouts studied
+// when betting closed on each street.
+func (hr *HandRunner) logStudyRunouts() {
+	for _, study := range hr.handState.StudyRunouts() {
+		wins := make(map[string]float64)
+		for _, runout := range study.Runouts {
+			for seat, share := range runout.Shares() {
+				wins[hr.playerLabels[seat]] += share / float64(len(study.Runouts))
+			}
+		}
+		event := hr.logger.Info().
+			Str("hand_id", hr.handID).
+			Str("street", study.Street.String()).
+			Int("runouts", len(study.Runouts))
+		for name, share := range wins {
+			event = event.Float64(name, share)
+		}
+		event.Msg("Study runouts")
+	}
+}
+
+// rabbitHuntStrings returns the board cards the hand would have run out to when
+// rabbit hunting is enabled, or nil.
+func (hr *HandRunner) rabbitHuntStrings() []string {
+	if !hr.config.RabbitHunt {
+		return nil
+	}
+	cards := hr.handState.RabbitHunt()
+	if len(cards) == 0 {
+		return nil
+	}
+	rabbitHunt := make([]string, len(cards))
+	for i, card := range cards {
+		rabbitHunt[i] = card.String()
+	}
+	return rabbitHunt
+}
+
 func (hr *HandRunner) totalPot() int {
 	total := 0
 	for _, pot := range hr.handState.GetPots() {
@@ -1042,13 +1357,7 @@ func (hr *HandRunner) buildDetailedOutcome(winners []winnerSummary) *HandOutcome
 		player := hr.handState.Players[i]
 		delta := player.Chips - hr.seatBuyIns[i]
 
-		holeCards := []string{}
-		if player.HoleCards != 0 {
-			holeCards = []string{
-				player.HoleCards.GetCard(0).String(),
-				player.HoleCards.GetCard(1).String(),
-			}
-		}
+		holeCards := player.HoleCards.Cards()
 
 		outcome := BotHandOutcome{
 			Bot:            bot,
@@ -1056,10 +1365,18 @@ func (hr *HandRunner) buildDetailedOutcome(winners []winnerSummary) *HandOutcome
 			ButtonDistance: (i - hr.button + len(hr.bots)) % len(hr.bots),
 			HoleCards:      holeCards,
 			NetChips:       delta,
+			StreetReached:  game.Preflop.String(),
 			WentToShowdown: wentToShowdown[i],
 			WonAtShowdown:  wonAtShowdown[i],
 			WentBroke:      player.Chips == 0,
 		}
+		if wentToShowdown[i] {
+			outcome.StreetReached = game.Showdown.String()
+			outcome.ShowdownHand = hr.handState.HandRank(i).String()
+			outcome.WonLowOnly = wonAtShowdown[i] && !hr.handState.WonHigh(i)
+		} else if i < len(hr.seatStreets) {
+			outcome.StreetReached = hr.seatStreets[i].String()
+		}
 
 		if hr.trackActions && i < len(hr.botActions) {
 			outcome.Actions = hr.botActions[i]
@@ -1115,26 +1432,10 @@ func (hr *HandRunner) resolveHand() []winnerSummary {
 		}
 	}
 
-	// Get winners for each pot and accumulate payouts per seat
-	payouts := make(map[int]int)
-	winners := hr.handState.GetWinners()
-
-	for potIdx, winnerSeats := range winners {
-		if len(winnerSeats) == 0 {
-			continue
-		}
-
-		pots := hr.handState.GetPots()
-		if potIdx >= len(pots) {
-			continue
-		}
-		pot := pots[potIdx]
-		share := pot.Amount / len(winnerSeats)
-
-		for _, seat := range winnerSeats {
-			hr.handState.Players[seat].Chips += share
-			payouts[seat] += share
-		}
+	// Split each pot between its winners, odd chips included
+	payouts := hr.handState.GetPayouts()
+	for seat, amount := range payouts {
+		hr.handState.Players[seat].Chips += amount
 	}
 
 	summaries := make([]winnerSummary, 0, len(payouts))
@@ -1161,20 +1462,25 @@ func (hr *HandRunner) broadcastSpecificStreet(previous, current game.Street, boa
 		Strs("board", board).
 		Msg("Street advanced")
 
+	for i, player := range hr.handState.Players {
+		if !player.Folded && i < len(hr.seatStreets) {
+			hr.seatStreets[i] = current
+		}
+	}
+
 	// Notify monitor of street change
 	if hr.pool != nil {
 		monitor := hr.pool.GetHandMonitor()
 		monitor.OnStreetChange(hr.handID, current.String(), board)
 	}
 
+	msg := &protocol.StreetChange{
+		Type:   "street_change",
+		HandID: hr.handID,
+		Street: current.String(),
+		Board:  board,
+	}
 	for _, bot := range hr.bots {
-		msg := &protocol.StreetChange{
-			Type:   "street_change",
-			HandID: hr.handID,
-			Street: current.String(),
-			Board:  board,
-		}
-
 		if bot.IsClosed() {
 			continue
 		}
@@ -1184,6 +1490,8 @@ func (hr *HandRunner) broadcastSpecificStreet(previous, current game.Street, boa
 			}
 		}
 	}
+
+	hr.notifySpectators(func() any { return msg })
 }
 
 func (hr *HandRunner) broadcastRemainingStreets(from game.Street) {
@@ -1235,66 +1543,68 @@ func (hr *HandRunner) broadcastHandResult(winners []winnerSummary) {
 	boardCards := hr.boardStrings()
 
 	for observerSeat, bot := range hr.bots {
-		winnerInfo := make([]protocol.Winner, len(winners))
-		winnerSeats := make(map[int]bool)
-		for i, winner := range winners {
-			player := hr.handState.Players[winner.seat]
-			holeCards := []string{
-				player.HoleCards.GetCard(0).String(),
-				player.HoleCards.GetCard(1).String(),
-			}
+		msg := hr.handResultMessage(observerSeat, winners, boardCards)
 
-			fullHand := player.HoleCards | hr.handState.Board
-			handRank := poker.Evaluate7Cards(fullHand)
-
-			winnerInfo[i] = protocol.Winner{
-				Name:      hr.displayName(observerSeat, winner.seat),
-				Amount:    winner.amount,
-				HoleCards: holeCards,
-				HandRank:  handRank.String(),
+		if bot.IsClosed() {
+			continue
+		}
+		if err := bot.SendMessage(msg); err != nil {
+			if !errors.Is(err, ErrBotClosed) {
+				hr.logger.Error().Err(err).Str("bot_id", bot.ID).Msg("Failed to send hand result")
 			}
-			winnerSeats[winner.seat] = true
 		}
+	}
 
-		var showdownHands []protocol.ShowdownHand
-		if hr.handState.Street == game.Showdown {
-			for _, player := range hr.handState.Players {
-				if player.Folded || winnerSeats[player.Seat] || player.HoleCards == 0 {
-					continue
-				}
+	hr.notifySpectators(func() any {
+		return hr.handResultMessage(spectatorSeat, winners, boardCards)
+	})
+}
 
-				holeCards := []string{
-					player.HoleCards.GetCard(0).String(),
-					player.HoleCards.GetCard(1).String(),
-				}
-				fullHand := player.HoleCards | hr.handState.Board
-				handRank := poker.Evaluate7Cards(fullHand)
-
-				showdownHands = append(showdownHands, protocol.ShowdownHand{
-					Name:      hr.displayName(observerSeat, player.Seat),
-					HoleCards: holeCards,
-					HandRank:  handRank.String(),
-				})
-			}
-		}
+// handResultMessage describes the hand's winners and showdown from observerSeat's point of view.
+func (hr *HandRunner) handResultMessage(observerSeat int, winners []winnerSummary, boardCards []string) *protocol.HandResult {
+	winnerInfo := make([]protocol.Winner, len(winners))
+	winnerSeats := make(map[int]bool)
+	for i, winner := range winners {
+		player := hr.handState.Players[winner.seat]
+		holeCards := player.HoleCards.Cards()
+		handRank := hr.handState.HandRank(winner.seat)
 
-		msg := &protocol.HandResult{
-			Type:     "hand_result",
-			HandID:   hr.handID,
-			Winners:  winnerInfo,
-			Board:    boardCards,
-			Showdown: showdownHands,
+		winnerInfo[i] = protocol.Winner{
+			Name:      hr.displayName(observerSeat, winner.seat),
+			Amount:    winner.amount,
+			HoleCards: holeCards,
+			HandRank:  handRank.String(),
 		}
+		winnerSeats[winner.seat] = true
+	}
 
-		if bot.IsClosed() {
-			continue
-		}
-		if err := bot.SendMessage(msg); err != nil {
-			if !errors.Is(err, ErrBotClosed) {
-				hr.logger.Error().Err(err).Str("bot_id", bot.ID).Msg("Failed to send hand result")
+	var showdownHands []protocol.ShowdownHand
+	if hr.handState.Street == game.Showdown {
+		for _, player := range hr.handState.Players {
+			if player.Folded || winnerSeats[player.Seat] || player.HoleCards == 0 {
+				continue
 			}
+
+			holeCards := player.HoleCards.Cards()
+			handRank := hr.handState.HandRank(player.Seat)
+
+			showdownHands = append(showdownHands, protocol.ShowdownHand{
+				Name:      hr.displayName(observerSeat, player.Seat),
+				HoleCards: holeCards,
+				HandRank:  handRank.String(),
+			})
 		}
 	}
+
+	return &protocol.HandResult{
+		Type:       "hand_result",
+		HandID:     hr.handID,
+		Winners:    winnerInfo,
+		Board:      boardCards,
+		Showdown:   showdownHands,
+		EndReason:  hr.endReason(),
+		RabbitHunt: hr.rabbitHuntStrings(),
+	}
 }
 
 // GetHandState returns the current hand state (for testing)
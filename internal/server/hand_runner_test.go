@@ -1,10 +1,12 @@
 package server
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"slices"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -446,6 +448,80 @@ func TestHandRunnerForceFoldOnDisconnect(t *testing.T) {
 	}
 }
 
+// TestHandResultEndReason verifies the hand result reports how the hand ended.
+func TestHandResultEndReason(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		play func(runner *HandRunner, bots []*Bot)
+		want string
+	}{
+		{
+			name: "all folded",
+			play: func(runner *HandRunner, bots []*Bot) {
+				// Heads-up the button is the small blind and acts first preflop
+				runner.processAction(0, game.Fold, 0)
+			},
+			want: protocol.HandEndAllFolded,
+		},
+		{
+			name: "opponent disconnected",
+			play: func(runner *HandRunner, bots []*Bot) {
+				bots[1].mu.Lock()
+				bots[1].closed = true
+				bots[1].mu.Unlock()
+				close(bots[1].done)
+				runner.foldDisconnectedPlayers(-1)
+			},
+			want: protocol.HandEndSinglePlayerRemaining,
+		},
+		{
+			name: "forced fold",
+			play: func(runner *HandRunner, bots []*Bot) {
+				// The small blind can't check facing the big blind
+				runner.processAction(0, game.Check, 0)
+			},
+			want: protocol.HandEndForcedFold,
+		},
+		{
+			name: "showdown",
+			play: func(runner *HandRunner, bots []*Bot) {
+				for !runner.handState.IsComplete() {
+					action := game.Check
+					if runner.handState.Betting.CurrentBet > runner.handState.Players[runner.handState.ActivePlayer].Bet {
+						action = game.Call
+					}
+					runner.processAction(runner.handState.ActivePlayer, action, 0)
+				}
+			},
+			want: protocol.HandEndShowdown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			bots := []*Bot{
+				{ID: "bot1", send: make(chan []byte, 100), done: make(chan struct{})},
+				{ID: "bot2", send: make(chan []byte, 100), done: make(chan struct{})},
+			}
+			runner := NewHandRunner(testLogger(), bots, "end-reason", 0, randutil.New(2158))
+			runner.handState = game.NewHandState(randutil.New(2158), []string{"bot1", "bot2"}, 0, 5, 10, game.WithChips(1000))
+			runner.playerLabels = []string{"bot1", "bot2"}
+			runner.lastStreet = runner.handState.Street
+
+			tt.play(runner, bots)
+			winners := runner.resolveHand()
+
+			result := runner.handResultMessage(0, winners, runner.boardStrings())
+			if result.EndReason != tt.want {
+				t.Fatalf("end reason = %q, want %q", result.EndReason, tt.want)
+			}
+		})
+	}
+}
+
 // TestValidActionsGeneration tests that valid actions are always generated correctly
 func TestValidActionsGeneration(t *testing.T) {
 	t.Parallel()
@@ -945,3 +1021,652 @@ func TestBankrollDeltaCalculation(t *testing.T) {
 		t.Errorf("Bot2 bankroll = %d, expected %d", bot2.bankroll, expectedBankroll2)
 	}
 }
+
+// TestAutoRebuyResetsStacks verifies that with auto-rebuy every hand starts at the
+// table's starting stack even after a bot's bankroll has dropped below it.
+func TestAutoRebuyResetsStacks(t *testing.T) {
+	t.Parallel()
+
+	config := DefaultConfig(2, 2)
+	config.AutoRebuy = true
+	pool := NewBotPool(testLogger(), randutil.New(2157), config)
+
+	bots := []*Bot{
+		NewBot(testLogger(), "rebuy-bot-1", nil, pool),
+		NewBot(testLogger(), "rebuy-bot-2", nil, pool),
+	}
+	for _, bot := range bots {
+		bot.bankroll = config.StartChips // A single buy-in, so any loss leaves them short
+	}
+
+	rebuys := 0
+	for hand := range 4 {
+		for _, bot := range bots {
+			if bot.bankroll < config.StartChips {
+				rebuys++ // Lost the blinds last hand, so this buy-in is a rebuy
+			}
+		}
+
+		runner := NewHandRunnerWithConfig(testLogger(), bots, fmt.Sprintf("rebuy-%d", hand), hand%2, randutil.New(int64(hand)), pool.config)
+		runner.SetPool(pool)
+		runFoldingHand(runner)
+
+		for seat, buyIn := range runner.seatBuyIns {
+			if buyIn != config.StartChips {
+				t.Errorf("hand %d seat %d started with %d chips, want %d", hand, seat, buyIn, config.StartChips)
+			}
+		}
+	}
+	if rebuys == 0 {
+		t.Fatal("expected a bot to start a hand short of the starting stack")
+	}
+
+	bots[0].bankroll = 0
+	if !bots[0].HasChips() || bots[0].GetBuyIn() != config.StartChips {
+		t.Errorf("busted bot should rebuy, got buy-in %d", bots[0].GetBuyIn())
+	}
+}
+
+func TestAutoRebuyThreshold(t *testing.T) {
+	t.Parallel()
+
+	config := DefaultConfig(2, 2)
+	config.AutoRebuy = true
+	config.RebuyThreshold = config.StartChips / 2
+	pool := NewBotPool(testLogger(), randutil.New(2157), config)
+	bot := NewBot(testLogger(), "threshold-bot", nil, pool)
+
+	// Above the threshold the bot plays what it has left
+	bot.bankroll = config.StartChips - 5
+	if got := bot.GetBuyIn(); got != config.StartChips-5 {
+		t.Errorf("buy-in above threshold = %d, want %d", got, config.StartChips-5)
+	}
+
+	// Below it the bankroll is topped back up
+	bot.bankroll = config.RebuyThreshold - 1
+	if got := bot.GetBuyIn(); got != config.StartChips {
+		t.Errorf("buy-in below threshold = %d, want %d", got, config.StartChips)
+	}
+}
+
+// TestEffectiveStackCap verifies that no hand starts deeper than the configured depth
+// in big blinds, and that short bankrolls are never topped up to it.
+func TestEffectiveStackCap(t *testing.T) {
+	t.Parallel()
+
+	config := DefaultConfig(2, 2)
+	config.EffectiveStackBB = 40
+	pool := NewBotPool(testLogger(), randutil.New(2217), config)
+	depth := config.EffectiveStackBB * config.BigBlind
+
+	bots := []*Bot{
+		NewBot(testLogger(), "deep-bot", nil, pool),
+		NewBot(testLogger(), "short-bot", nil, pool),
+	}
+	bots[0].bankroll = 500 * config.BigBlind // Far deeper than the cap and the starting stack
+	bots[1].bankroll = depth / 4
+
+	for hand := range 4 {
+		want := []int{min(bots[0].bankroll, depth), min(bots[1].bankroll, depth)}
+		runner := NewHandRunnerWithConfig(testLogger(), bots, fmt.Sprintf("effective-%d", hand), hand%2, randutil.New(int64(hand)), pool.config)
+		runner.SetPool(pool)
+		runFoldingHand(runner)
+
+		for seat, buyIn := range runner.seatBuyIns {
+			if buyIn != want[seat] {
+				t.Errorf("hand %d seat %d started with %d chips, want %d", hand, seat, buyIn, want[seat])
+			}
+		}
+	}
+
+	// Chips above the cap stay in the bankroll rather than being reset
+	if bots[0].bankroll < 400*config.BigBlind {
+		t.Errorf("deep bankroll = %d, expected accumulated chips to be kept", bots[0].bankroll)
+	}
+	// A short bankroll is never topped up to the effective depth
+	if bots[1].bankroll >= depth {
+		t.Errorf("short bankroll = %d, expected it to stay below %d", bots[1].bankroll, depth)
+	}
+	if got := bots[1].GetBuyIn(); got != bots[1].bankroll {
+		t.Errorf("short bot buy-in = %d, want its %d bankroll", got, bots[1].bankroll)
+	}
+	bots[1].bankroll = 0
+	if bots[1].HasChips() || bots[1].GetBuyIn() != 0 {
+		t.Errorf("busted bot should not be topped up, got buy-in %d", bots[1].GetBuyIn())
+	}
+}
+
+// TestHandRunnerWalkToBigBlind verifies a full-ring table folding around to the big blind
+// ends preflop with the blinds awarded to the big blind and no showdown.
+func TestHandRunnerWalkToBigBlind(t *testing.T) {
+	t.Parallel()
+
+	const numPlayers = 9
+	pool := NewBotPool(testLogger(), randutil.New(2121), DefaultConfig(2, numPlayers))
+	monitor := &testMonitor{}
+	pool.SetHandMonitor(monitor)
+
+	bots := make([]*Bot, numPlayers)
+	for i := range bots {
+		bots[i] = NewBot(testLogger(), fmt.Sprintf("walk-bot-%d", i), nil, pool)
+	}
+
+	runner := NewHandRunnerWithConfig(testLogger(), bots, "walk", 0, randutil.New(2121), pool.config)
+	runner.SetPool(pool)
+
+	messages := runFoldingHand(runner)
+
+	const bbSeat = 2 // Button 0, small blind 1, big blind 2
+	state := runner.GetHandState()
+	if !state.IsComplete() {
+		t.Fatal("expected hand to be complete")
+	}
+	if state.Board != 0 {
+		t.Fatalf("expected no community cards on a walk, got %s", state.Board)
+	}
+	// No rake is taken, so the big blind collects both blinds
+	if got, want := state.Players[bbSeat].Chips, 1005; got != want {
+		t.Fatalf("expected big blind to finish with %d chips, got %d", want, got)
+	}
+
+	for seat, seatMessages := range messages {
+		var streetChanges int
+		var result *protocol.HandResult
+		for _, data := range seatMessages {
+			switch messageType(data) {
+			case protocol.TypeStreetChange:
+				streetChanges++
+			case protocol.TypeHandResult:
+				result = &protocol.HandResult{}
+				if err := protocol.Unmarshal(data, result); err != nil {
+					t.Fatalf("failed to decode hand result: %v", err)
+				}
+			}
+		}
+		if streetChanges != 0 {
+			t.Errorf("seat %d saw %d street changes on a walk", seat, streetChanges)
+		}
+		if result == nil {
+			t.Fatalf("seat %d did not receive a hand result", seat)
+		}
+		if len(result.Showdown) != 0 || len(result.Board) != 0 {
+			t.Errorf("seat %d expected no showdown or board, got %+v", seat, result)
+		}
+		if len(result.Winners) != 1 || result.Winners[0].Amount != 15 {
+			t.Fatalf("seat %d expected single winner of 15 chips, got %+v", seat, result.Winners)
+		}
+	}
+
+	if monitor.handCallCount != 1 || monitor.lastHandOutcome.Detail == nil {
+		t.Fatalf("expected detailed outcome to be recorded, got %+v", monitor.lastHandOutcome)
+	}
+	detail := monitor.lastHandOutcome.Detail
+	if detail.StreetReached != "preflop" {
+		t.Fatalf("expected street reached preflop, got %q", detail.StreetReached)
+	}
+	for _, outcome := range detail.BotOutcomes {
+		if outcome.WentToShowdown {
+			t.Errorf("seat %d should not be marked as going to showdown", outcome.Position)
+		}
+		if outcome.StreetReached != "preflop" {
+			t.Errorf("seat %d: expected street reached preflop, got %q", outcome.Position, outcome.StreetReached)
+		}
+		if outcome.Position == bbSeat && outcome.NetChips != 5 {
+			t.Errorf("expected big blind to net 5 chips, got %d", outcome.NetChips)
+		}
+	}
+}
+
+// TestHandRunnerAttributesHoleCardCategories verifies the server categorizes each
+// player's hole cards and attributes the hand result to that category.
+func TestHandRunnerAttributesHoleCardCategories(t *testing.T) {
+	t.Parallel()
+
+	config := DefaultConfig(2, 3)
+	config.EnableStats = true
+	pool := NewBotPool(testLogger(), randutil.New(2128), config)
+
+	bots := make([]*Bot, 3)
+	for i := range bots {
+		bots[i] = NewBot(testLogger(), fmt.Sprintf("category-bot-%d", i), nil, pool)
+	}
+
+	runner := NewHandRunnerWithConfig(testLogger(), bots, "category", 0, randutil.New(2128), pool.config)
+	runner.SetPool(pool)
+	runFoldingHand(runner)
+
+	state := runner.GetHandState()
+	for seat, bot := range bots {
+		player := state.Players[seat]
+		category := string(poker.CategorizeHoleCards(player.HoleCards.GetCard(0), player.HoleCards.GetCard(1)))
+		netBB := float64(player.Chips-config.StartChips) / float64(config.BigBlind)
+
+		stats := pool.statsMonitor.GetDetailedStats(bot.ID)
+		if stats == nil {
+			t.Fatalf("seat %d: expected detailed stats", seat)
+		}
+		if len(stats.HandCategoryStats) != 1 {
+			t.Fatalf("seat %d: expected a single category, got %+v", seat, stats.HandCategoryStats)
+		}
+		summary, ok := stats.HandCategoryStats[category]
+		if !ok {
+			t.Fatalf("seat %d: expected result attributed to %s (%s), got %+v", seat, category, player.HoleCards, stats.HandCategoryStats)
+		}
+		if summary.Hands != 1 || summary.NetBB != netBB || summary.BBPerHand != netBB {
+			t.Errorf("seat %d: expected 1 hand netting %.1f BB in %s, got %+v", seat, netBB, category, summary)
+		}
+	}
+}
+
+// runFoldingHand runs the hand with every bot folding as soon as it is asked to act,
+// returning the raw messages each seat received.
+func TestHandRunnerPostsConfiguredStraddle(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		straddle game.Straddle
+		seat     int
+	}{
+		{name: "button", straddle: game.ButtonStraddle, seat: 0},
+		{name: "mississippi", straddle: game.MississippiStraddle, seat: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := DefaultConfig(2, 6)
+			config.Straddle = tt.straddle
+			config.StraddleSeat = 4
+			bots := make([]*Bot, 6)
+			for i := range bots {
+				bots[i] = NewBot(testLogger(), fmt.Sprintf("straddle-bot-%d", i), nil, nil)
+			}
+			runner := NewHandRunnerWithConfig(testLogger(), bots, "straddle", 0, randutil.New(2202), config)
+			runFoldingHand(runner)
+
+			// Everyone folds to the straddler, who collects the blinds
+			state := runner.GetHandState()
+			if got := state.StraddleSeat(); got != tt.seat {
+				t.Fatalf("straddle seat = %d, want %d", got, tt.seat)
+			}
+			if got, want := state.Players[tt.seat].Chips, 1015; got != want {
+				t.Errorf("straddler finished with %d chips, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestHandStartReportsForcedBets(t *testing.T) {
+	t.Parallel()
+
+	config := DefaultConfig(2, 4)
+	config.Ante = 1
+	config.Straddle = game.ButtonStraddle
+	bots := make([]*Bot, 4)
+	for i := range bots {
+		bots[i] = NewBot(testLogger(), fmt.Sprintf("ante-bot-%d", i), nil, nil)
+	}
+	runner := NewHandRunnerWithConfig(testLogger(), bots, "ante", 0, randutil.New(2190), config)
+	messages := runFoldingHand(runner)
+
+	for seat, seatMessages := range messages {
+		var start *protocol.HandStart
+		for _, data := range seatMessages {
+			if messageType(data) == protocol.TypeHandStart {
+				start = &protocol.HandStart{}
+				if err := protocol.Unmarshal(data, start); err != nil {
+					t.Fatalf("failed to decode hand start: %v", err)
+				}
+				break
+			}
+		}
+		if start == nil {
+			t.Fatalf("seat %d: no hand start", seat)
+		}
+		if start.Ante != 1 || start.Straddle != 20 || start.StraddleSeat != 0 || start.Rake != 0 {
+			t.Errorf("seat %d: ante %d, straddle %d from seat %d, rake %v; want ante 1, straddle 20 from seat 0, no rake",
+				seat, start.Ante, start.Straddle, start.StraddleSeat, start.Rake)
+		}
+	}
+
+	// The antes are in the pot the straddler wins when everyone folds
+	if got, want := runner.GetHandState().Players[0].Chips, 1018; got != want {
+		t.Errorf("straddler finished with %d chips, want %d", got, want)
+	}
+}
+
+func runFoldingHand(runner *HandRunner) [][][]byte {
+	messages := make([][][]byte, len(runner.bots))
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	for i, bot := range runner.bots {
+		wg.Go(func() {
+			for {
+				var data []byte
+				select {
+				case data = <-bot.send:
+				case <-done:
+					// Collect anything still queued once the hand has finished
+					select {
+					case data = <-bot.send:
+					default:
+						return
+					}
+				}
+				messages[i] = append(messages[i], data)
+				if messageType(data) == protocol.TypeActionRequest {
+					runner.botActionChan <- ActionEnvelope{BotID: bot.ID, Action: protocol.Action{Type: "action", Action: "fold"}}
+				}
+			}
+		})
+	}
+
+	runner.Run()
+	close(done)
+	wg.Wait()
+	return messages
+}
+
+// messageType decodes just the type field of a server message.
+func messageType(data []byte) string {
+	var envelope protocol.Error
+	if err := protocol.Unmarshal(data, &envelope); err != nil {
+		return ""
+	}
+	return envelope.Type
+}
+
+// TestHandRunnerPostsMissedBlinds verifies a bot returning after sitting out posts a
+// dead small blind plus a live big blind and that the hand start reports it.
+func TestHandRunnerPostsMissedBlinds(t *testing.T) {
+	t.Parallel()
+
+	config := DefaultConfig(2, 4)
+	config.PostMissedBlinds = true
+	pool := NewBotPool(testLogger(), randutil.New(2122), config)
+
+	bots := make([]*Bot, 4)
+	for i := range bots {
+		bots[i] = NewBot(testLogger(), fmt.Sprintf("missed-bot-%d", i), nil, pool)
+	}
+
+	// Seat 3 is under the gun with the button on seat 0; seat 1 is already in the small blind
+	const returningSeat = 3
+	bots[returningSeat].MarkMissedBlinds()
+	bots[1].MarkMissedBlinds()
+
+	runner := NewHandRunnerWithConfig(testLogger(), bots, "missed", 0, randutil.New(2122), config)
+	runner.SetPool(pool)
+
+	messages := runFoldingHand(runner)
+
+	var start protocol.HandStart
+	for _, data := range messages[0] {
+		if messageType(data) == protocol.TypeHandStart {
+			if err := protocol.Unmarshal(data, &start); err != nil {
+				t.Fatalf("failed to decode hand start: %v", err)
+			}
+			break
+		}
+	}
+	if len(start.Players) != len(bots) {
+		t.Fatalf("expected hand start with %d players, got %+v", len(bots), start)
+	}
+	for seat, player := range start.Players {
+		want := 0
+		if seat == returningSeat {
+			want = 5
+		}
+		if player.DeadBlind != want {
+			t.Errorf("seat %d expected dead blind %d, got %d", seat, want, player.DeadBlind)
+		}
+	}
+	if got := start.Players[returningSeat].Chips; got != 985 {
+		t.Errorf("expected returning player to have 985 chips after posting, got %d", got)
+	}
+
+	var posts []string
+	for _, data := range messages[0] {
+		if messageType(data) != protocol.TypePlayerAction {
+			continue
+		}
+		var action protocol.PlayerAction
+		if err := protocol.Unmarshal(data, &action); err != nil {
+			t.Fatalf("failed to decode player action: %v", err)
+		}
+		if action.Seat == returningSeat && strings.HasPrefix(action.Action, "post_") {
+			posts = append(posts, fmt.Sprintf("%s:%d", action.Action, action.AmountPaid))
+		}
+	}
+	if want := []string{"post_dead_blind:5", "post_big_blind:10"}; !slices.Equal(posts, want) {
+		t.Errorf("expected returning player posts %v, got %v", want, posts)
+	}
+
+	// Everyone folds to the big blind, who collects both blinds plus the dead and live blinds
+	if got := runner.GetHandState().Players[2].Chips; got != 1020 {
+		t.Errorf("expected big blind to finish with 1020 chips, got %d", got)
+	}
+
+	for i, bot := range bots {
+		if bot.OwesMissedBlinds() {
+			t.Errorf("bot %d should have settled its missed blinds", i)
+		}
+	}
+}
+
+// TestHandRunnerBroadcastsClampedRaise verifies that when the server adjusts a
+// raise, the player_action broadcast reports the action and amount actually played.
+func TestHandRunnerBroadcastsClampedRaise(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		stack      int
+		raiseTo    int
+		wantAction string
+		wantPaid   int
+	}{
+		{name: "raise below minimum lifts to minimum", stack: 1000, raiseTo: 15, wantAction: "raise", wantPaid: 20},
+		{name: "raise above stack becomes all-in", stack: 300, raiseTo: 500, wantAction: "allin", wantPaid: 300},
+		{name: "short raise below minimum becomes all-in", stack: 15, raiseTo: 12, wantAction: "allin", wantPaid: 15},
+		{name: "valid raise is unchanged", stack: 1000, raiseTo: 40, wantAction: "raise", wantPaid: 40},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			bots := newTestBots(3, nil)
+			runner := NewHandRunner(testLogger(), bots, "clamp-test", 0, randutil.New(42))
+
+			// The button acts first three-handed, facing the big blind with a 10 chip minimum raise
+			runner.handState = game.NewHandState(
+				randutil.New(42),
+				[]string{"alice", "bob", "charlie"},
+				0,
+				5,
+				10,
+				game.WithChipsByPlayer([]int{tt.stack, 1000, 1000}),
+			)
+
+			runner.processAction(0, game.Raise, tt.raiseTo)
+
+			var action protocol.PlayerAction
+			select {
+			case data := <-bots[1].send:
+				if err := protocol.Unmarshal(data, &action); err != nil {
+					t.Fatalf("failed to decode player action: %v", err)
+				}
+			case <-time.After(100 * time.Millisecond):
+				t.Fatal("no player action broadcast")
+			}
+			if action.Action != tt.wantAction || action.AmountPaid != tt.wantPaid {
+				t.Errorf("broadcast %s:%d, want %s:%d", action.Action, action.AmountPaid, tt.wantAction, tt.wantPaid)
+			}
+			if got := runner.handState.Players[0].Bet; got != tt.wantPaid {
+				t.Errorf("seat 0 bet = %d, want %d", got, tt.wantPaid)
+			}
+		})
+	}
+}
+
+// TestHandStartCarriesTableRules verifies hand_start reports the configured variant
+// and betting structure, with a hole card per card the variant deals.
+func TestHandStartCarriesTableRules(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		variant   game.Variant
+		wantName  string
+		holeCards int
+	}{
+		{variant: game.HoldEm, wantName: "holdem", holeCards: 2},
+		{variant: game.OmahaHiLo, wantName: "omaha-hilo", holeCards: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.wantName, func(t *testing.T) {
+			t.Parallel()
+			config := DefaultConfig(2, 3)
+			config.Variant = tt.variant
+
+			bots := newTestBots(3, nil)
+			runner := NewHandRunnerWithConfig(testLogger(), bots, "rules-test", 0, randutil.New(2190), config)
+			messages := runFoldingHand(runner)
+
+			for seat := range bots {
+				var start protocol.HandStart
+				for _, data := range messages[seat] {
+					if messageType(data) == protocol.TypeHandStart {
+						if err := protocol.Unmarshal(data, &start); err != nil {
+							t.Fatalf("failed to decode hand start: %v", err)
+						}
+						break
+					}
+				}
+				if start.Variant != tt.wantName || start.BettingLimit != protocol.NoLimit {
+					t.Errorf("seat %d: hand start rules %q/%q, want %q/%q", seat, start.Variant, start.BettingLimit, tt.wantName, protocol.NoLimit)
+				}
+				if len(start.HoleCards) != tt.holeCards {
+					t.Errorf("seat %d: expected %d hole cards, got %v", seat, tt.holeCards, start.HoleCards)
+				}
+			}
+		})
+	}
+}
+
+// TestPlayerActionContext verifies the server classifies 3-bets, continuation
+// bets and check-raises in player_action.
+func TestPlayerActionContext(t *testing.T) {
+	t.Parallel()
+
+	bots := newTestBots(3, nil)
+	runner := NewHandRunner(testLogger(), bots, "context-test", 0, randutil.New(42))
+	runner.handState = game.NewHandState(
+		randutil.New(42),
+		[]string{"alice", "bob", "charlie"},
+		0,
+		5,
+		10,
+		game.WithChips(1000),
+	)
+
+	steps := []struct {
+		seat   int
+		action game.Action
+		amount int
+		want   string
+	}{
+		{0, game.Raise, 30, ""}, // Open
+		{1, game.Raise, 90, protocol.ContextThreeBet},
+		{2, game.Fold, 0, ""},
+		{0, game.Call, 0, ""},
+		{1, game.Raise, 100, protocol.ContextCBet}, // Flop
+		{0, game.Call, 0, ""},
+		{1, game.Check, 0, ""}, // Turn
+		{0, game.Raise, 100, ""},
+		{1, game.Raise, 300, protocol.ContextCheckRaise},
+	}
+	for i, step := range steps {
+		if active := runner.handState.ActivePlayer; active != step.seat {
+			t.Fatalf("step %d: expected seat %d to act, got %d", i, step.seat, active)
+		}
+		runner.processAction(step.seat, step.action, step.amount)
+
+		var action protocol.PlayerAction
+		if err := protocol.Unmarshal(<-bots[2].send, &action); err != nil {
+			t.Fatalf("step %d: failed to decode player action: %v", i, err)
+		}
+		if action.Seat != step.seat || action.Context != step.want {
+			t.Errorf("step %d: seat %d %s context %q, want seat %d context %q", i, action.Seat, action.Action, action.Context, step.seat, step.want)
+		}
+	}
+}
+
+// TestHandResultRabbitHunt verifies that a hand folded on the turn reveals the
+// river it would have dealt only when rabbit hunting is enabled.
+func TestHandResultRabbitHunt(t *testing.T) {
+	t.Parallel()
+
+	deck := poker.NewDeck(randutil.New(2197))
+	cards := make([]poker.Card, 52)
+	for i := range cards {
+		cards[i] = deck.DealOne()
+	}
+
+	for _, enabled := range []bool{true, false} {
+		t.Run(fmt.Sprintf("enabled=%v", enabled), func(t *testing.T) {
+			t.Parallel()
+			config := DefaultConfig(2, 2)
+			config.RabbitHunt = enabled
+
+			bots := newTestBots(2, nil)
+			runner := NewHandRunnerWithConfig(testLogger(), bots, "rabbit-test", 0, randutil.New(1), config)
+			runner.handState = game.NewHandState(randutil.New(1), []string{"alice", "bob"}, 0, 5, 10,
+				game.WithChips(1000), game.WithDeck(poker.NewStackedDeck(cards...)))
+
+			// Check down to the turn, where the big blind folds to a bet
+			steps := []struct {
+				seat   int
+				action game.Action
+				amount int
+			}{
+				{0, game.Call, 0}, {1, game.Check, 0},
+				{1, game.Check, 0}, {0, game.Check, 0},
+				{1, game.Check, 0}, {0, game.Raise, 20}, {1, game.Fold, 0},
+			}
+			for _, step := range steps {
+				runner.processAction(step.seat, step.action, step.amount)
+			}
+			if runner.handState.Board.CountCards() != 4 {
+				t.Fatalf("expected the hand to end on the turn, board %s", runner.handState.Board)
+			}
+			runner.broadcastHandResult(runner.resolveHand())
+
+			var result protocol.HandResult
+			for range len(bots[1].send) {
+				data := <-bots[1].send
+				if messageType(data) == protocol.TypeHandResult {
+					if err := protocol.Unmarshal(data, &result); err != nil {
+						t.Fatalf("failed to decode hand result: %v", err)
+					}
+				}
+			}
+			if result.Type != protocol.TypeHandResult {
+				t.Fatal("expected a hand result")
+			}
+
+			// Four hole cards, the flop and the turn came first
+			var want []string
+			if enabled {
+				want = []string{cards[8].String()}
+				if slices.Contains(result.Board, want[0]) {
+					t.Errorf("rabbit card %s is already on the board %v", want[0], result.Board)
+				}
+			}
+			if !slices.Equal(result.RabbitHunt, want) {
+				t.Errorf("rabbit hunt = %v, want %v", result.RabbitHunt, want)
+			}
+		})
+	}
+}
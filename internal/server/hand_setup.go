@@ -0,0 +1,107 @@
+package server
+
+import (
+	"fmt"
+	rand "math/rand/v2"
+
+	"github.com/lox/pokerforbots/v2/poker"
+)
+
+// HandSetup forces parts of every hand's deal so bots can be compared on a
+// curated spot instead of random deals. Positions count clockwise from the
+// button, which is position 0; positions past the end of the table are ignored.
+type HandSetup struct {
+	Stacks    []int          // Starting stack per position, 0 keeps the bot's buy-in
+	HoleCards [][]poker.Card // Hole cards per position, nil deals them at random
+	Board     []poker.Card   // Leading board cards, any not given are dealt at random
+}
+
+// Validate checks the setup deals each card at most once and fits on one table.
+func (s *HandSetup) Validate() error {
+	if len(s.Board) > 5 {
+		return fmt.Errorf("board has %d cards, at most 5 allowed", len(s.Board))
+	}
+	var seen poker.Hand
+	use := func(c poker.Card) error {
+		if seen.HasCard(c) {
+			return fmt.Errorf("card %s is used twice", c)
+		}
+		seen.AddCard(c)
+		return nil
+	}
+	for pos, cards := range s.HoleCards {
+		if len(cards) != 0 && len(cards) != 2 {
+			return fmt.Errorf("position %d has %d hole cards, expected 2", pos, len(cards))
+		}
+		for _, c := range cards {
+			if err := use(c); err != nil {
+				return err
+			}
+		}
+	}
+	for _, c := range s.Board {
+		if err := use(c); err != nil {
+			return err
+		}
+	}
+	for pos, stack := range s.Stacks {
+		if stack < 0 {
+			return fmt.Errorf("position %d has negative stack %d", pos, stack)
+		}
+	}
+	return nil
+}
+
+// setupPosition returns the position of seat relative to the button.
+func setupPosition(seat, button, seats int) int {
+	return (seat - button + seats) % seats
+}
+
+// applyStacks overrides chipCounts with the configured stacks. A forced stack is
+// capped at the bot's buy-in so a bot never risks more than it brought.
+func (s *HandSetup) applyStacks(chipCounts []int, button int) {
+	for seat := range chipCounts {
+		pos := setupPosition(seat, button, len(chipCounts))
+		if pos < len(s.Stacks) && s.Stacks[pos] > 0 {
+			chipCounts[seat] = min(chipCounts[seat], s.Stacks[pos])
+		}
+	}
+}
+
+// deck returns a deck stacked in the order HandState deals, one hole card at a
+// time clockwise from the left of the button and then the board, with the forced
+// cards in place and every other card shuffled with rng.
+func (s *HandSetup) deck(rng *rand.Rand, seats, button int) *poker.Deck {
+	order := make([]poker.Card, 2*seats+5)
+	var forced poker.Hand
+	for seat := range seats {
+		pos := setupPosition(seat, button, seats)
+		if pos < len(s.HoleCards) && len(s.HoleCards[pos]) == 2 {
+			// Position 1 is dealt first and the button last
+			first := (pos + seats - 1) % seats
+			order[first] = s.HoleCards[pos][0]
+			order[seats+first] = s.HoleCards[pos][1]
+		}
+	}
+	copy(order[2*seats:], s.Board)
+	for _, c := range order {
+		if c != 0 {
+			forced.AddCard(c)
+		}
+	}
+
+	shuffled := poker.NewDeck(rng)
+	var rest []poker.Card
+	for shuffled.CardsRemaining() > 0 {
+		if c := shuffled.DealOne(); !forced.HasCard(c) {
+			rest = append(rest, c)
+		}
+	}
+	for i := range order {
+		if order[i] == 0 {
+			order[i] = rest[0]
+			rest = rest[1:]
+		}
+	}
+	return poker.NewStackedDeck(append(order, rest...)...)
+}
@@ -0,0 +1,75 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/lox/pokerforbots/v2/internal/game"
+	"github.com/lox/pokerforbots/v2/internal/randutil"
+	"github.com/lox/pokerforbots/v2/poker"
+)
+
+func mustCards(t *testing.T, cards ...string) []poker.Card {
+	t.Helper()
+	parsed := make([]poker.Card, len(cards))
+	for i, c := range cards {
+		card, err := poker.ParseCard(c)
+		if err != nil {
+			t.Fatalf("invalid card %s: %v", c, err)
+		}
+		parsed[i] = card
+	}
+	return parsed
+}
+
+func TestHandSetupDealsForcedCardsByPosition(t *testing.T) {
+	setup := &HandSetup{
+		Stacks:    []int{0, 300},
+		HoleCards: [][]poker.Card{mustCards(t, "As", "Ad"), nil, mustCards(t, "7h", "2c")},
+		Board:     mustCards(t, "Kd", "Qd", "Jd"),
+	}
+	if err := setup.Validate(); err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+
+	const button = 2
+	rng := randutil.New(7)
+	chips := []int{1000, 1000, 1000, 1000}
+	setup.applyStacks(chips, button)
+	h := game.NewHandState(rng, []string{"a", "b", "c", "d"}, button, 5, 10,
+		game.WithChipsByPlayer(chips),
+		game.WithDeck(setup.deck(rng, len(chips), button)),
+		game.WithCardChecks(),
+	)
+
+	// Position 0 is the button (seat 2), position 2 wraps round to seat 0
+	if want := poker.NewHand(mustCards(t, "As", "Ad")...); h.Players[2].HoleCards != want {
+		t.Errorf("button: expected %s, got %s", want, h.Players[2].HoleCards)
+	}
+	if want := poker.NewHand(mustCards(t, "7h", "2c")...); h.Players[0].HoleCards != want {
+		t.Errorf("position 2: expected %s, got %s", want, h.Players[0].HoleCards)
+	}
+	if h.Players[3].Chips+h.Players[3].Bet != 300 {
+		t.Errorf("position 1: expected a 300 stack, got %d", h.Players[3].Chips+h.Players[3].Bet)
+	}
+
+	h.NextStreet()
+	if want := poker.NewHand(mustCards(t, "Kd", "Qd", "Jd")...); h.Board != want {
+		t.Errorf("expected flop %s, got %s", want, h.Board)
+	}
+}
+
+func TestHandSetupValidate(t *testing.T) {
+	tests := map[string]*HandSetup{
+		"duplicate":      {HoleCards: [][]poker.Card{mustCards(t, "As", "Kd")}, Board: mustCards(t, "As", "2c", "3c")},
+		"long board":     {Board: mustCards(t, "2c", "3c", "4c", "5c", "6c", "7c")},
+		"one card":       {HoleCards: [][]poker.Card{mustCards(t, "As")}},
+		"negative stack": {Stacks: []int{-1}},
+	}
+	for name, setup := range tests {
+		t.Run(name, func(t *testing.T) {
+			if err := setup.Validate(); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
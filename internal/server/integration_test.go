@@ -2,13 +2,16 @@ package server
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/lox/pokerforbots/v2/internal/randutil"
 
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -328,6 +331,104 @@ func TestTimeoutActuallyFolds(t *testing.T) {
 	}
 }
 
+// TestBotReconnectPreservesSessionStats verifies that a bot which crashes and
+// reconnects under the same name keeps its seat in the session: the game waits
+// for it, and its stats and bankroll carry on rather than starting over.
+func TestBotReconnectPreservesSessionStats(t *testing.T) {
+	t.Parallel()
+
+	config := DefaultConfig(2, 2)
+	config.EnableStats = true
+	config.HandLimit = 100
+	config.ReconnectGrace = 5 * time.Second
+	pool := NewBotPool(testLogger(), randutil.New(2135), config)
+	server := NewServer(testLogger(), randutil.New(1), WithBotPool(pool))
+	stopPool := startTestPool(t, pool)
+	defer stopPool()
+
+	ts := httptest.NewServer(http.HandlerFunc(server.handleWebSocket))
+	defer ts.Close()
+	wsURL := "ws" + strings.TrimPrefix(ts.URL, "http") + "/ws"
+
+	steady := dialAndConnect(t, wsURL, "Steady", "")
+	defer steady.Close()
+	go foldActions(steady, 0)
+
+	flakyID := botIDForName("Flaky")
+	flaky := dialAndConnect(t, wsURL, "Flaky", "")
+	waitForCondition(t, func() bool {
+		return pool.BotCount() == 2
+	}, time.Second, "Expected both bots to be registered")
+	crashed, _ := pool.GetBot(flakyID)
+
+	// Crash the bot mid-session after it has acted a few times
+	go foldActions(flaky, 10)
+	waitForCondition(t, func() bool {
+		return pool.BotCount() == 1
+	}, 5*time.Second, "Expected the crashed bot to be unregistered")
+	if pool.HandCount() == 0 {
+		t.Fatal("expected hands to be played before the crash")
+	}
+
+	select {
+	case <-pool.Done():
+		t.Fatalf("game ended after the crash instead of waiting for a reconnect (reason %q)", pool.CompletionReason())
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// Restart it under the same name
+	restarted := dialAndConnect(t, wsURL, "Flaky", "")
+	defer restarted.Close()
+	go foldActions(restarted, 0)
+
+	waitForCondition(t, func() bool {
+		bot, ok := pool.GetBot(flakyID)
+		return ok && bot != crashed
+	}, time.Second, "Expected the restarted bot to rejoin")
+
+	select {
+	case <-pool.Done():
+	case <-time.After(10 * time.Second):
+		t.Fatalf("game did not reach its hand limit after the reconnect (%d hands played)", pool.HandCount())
+	}
+	pool.handsWG.Wait()
+
+	if reason := pool.CompletionReason(); reason != reasonHandLimitReached {
+		t.Errorf("expected completion reason %q, got %q", reasonHandLimitReached, reason)
+	}
+
+	hands := int(pool.HandCount())
+	for _, name := range []string{"Steady", "Flaky"} {
+		stats := pool.statsMonitor.GetDetailedStats(botIDForName(name))
+		if stats == nil {
+			t.Fatalf("missing stats for %s", name)
+		}
+		if stats.Hands != hands {
+			t.Errorf("%s: expected stats for all %d session hands, got %d", name, hands, stats.Hands)
+		}
+	}
+}
+
+// foldActions answers action requests on conn with folds, closing the
+// connection after limit actions (0 for no limit).
+func foldActions(conn *websocket.Conn, limit int) {
+	fold, _ := protocol.Marshal(&protocol.Action{Type: "action", Action: "fold"})
+	for actions := 0; limit == 0 || actions < limit; {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		var req protocol.ActionRequest
+		if err := protocol.Unmarshal(data, &req); err == nil && req.Type == "action_request" {
+			if err := conn.WriteMessage(websocket.BinaryMessage, fold); err != nil {
+				return
+			}
+			actions++
+		}
+	}
+	conn.Close()
+}
+
 // Helper function to read bot messages
 func readBotMessages(_ *testing.T, conn *websocket.Conn, actionChan chan string) {
 	for {
@@ -358,3 +459,400 @@ func readBotMessages(_ *testing.T, conn *websocket.Conn, actionChan chan string)
 		}
 	}
 }
+
+func TestPinnedSeatsAcrossHands(t *testing.T) {
+	t.Parallel()
+
+	const hands = 8
+	config := DefaultConfig(2, 2)
+	config.HandLimit = hands
+	pool := NewBotPool(testLogger(), randutil.New(2152), config)
+	server := NewServer(testLogger(), randutil.New(1), WithBotPool(pool))
+	stopPool := startTestPool(t, pool)
+	defer stopPool()
+
+	ts := httptest.NewServer(http.HandlerFunc(server.handleWebSocket))
+	defer ts.Close()
+	wsURL := "ws" + strings.TrimPrefix(ts.URL, "http") + "/ws"
+
+	type seating struct{ seat, button int }
+	seats := map[string][]seating{}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	// Alpha sits wherever it's put; Beta is pinned to the button
+	for name, pin := range map[string]*int{"Alpha": nil, "Beta": new(int)} {
+		conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+		if err != nil {
+			t.Fatalf("failed to dial: %v", err)
+		}
+		defer conn.Close()
+		connect, _ := protocol.Marshal(&protocol.Connect{Type: protocol.TypeConnect, Name: name, ProtocolVersion: "2", Seat: pin})
+		if err := conn.WriteMessage(websocket.BinaryMessage, connect); err != nil {
+			t.Fatalf("failed to send connect: %v", err)
+		}
+
+		wg.Go(func() {
+			fold, _ := protocol.Marshal(&protocol.Action{Type: protocol.TypeAction, Action: "fold"})
+			_ = conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+			for {
+				_, data, err := conn.ReadMessage()
+				if err != nil {
+					return
+				}
+				var envelope protocol.ServerShutdown
+				if err := protocol.Unmarshal(data, &envelope); err != nil {
+					continue
+				}
+				switch envelope.Type {
+				case protocol.TypeHandStart:
+					var start protocol.HandStart
+					if err := protocol.Unmarshal(data, &start); err == nil {
+						mu.Lock()
+						seats[name] = append(seats[name], seating{start.YourSeat, start.Button})
+						mu.Unlock()
+					}
+				case protocol.TypeActionRequest:
+					if err := conn.WriteMessage(websocket.BinaryMessage, fold); err != nil {
+						return
+					}
+				case protocol.TypeGameCompleted:
+					return
+				}
+			}
+		})
+	}
+	wg.Wait()
+
+	for name, want := range map[string]int{"Alpha": 1, "Beta": 0} {
+		if len(seats[name]) != hands {
+			t.Fatalf("%s: expected %d hand starts, got %d", name, hands, len(seats[name]))
+		}
+		for i, s := range seats[name] {
+			if s.seat != want {
+				t.Errorf("%s: hand %d seated at %d, want %d", name, i+1, s.seat, want)
+			}
+			if s.button != 0 {
+				t.Errorf("%s: hand %d button at %d, want the pinned seat 0", name, i+1, s.button)
+			}
+		}
+	}
+}
+
+func TestHandsPerHourPacesHands(t *testing.T) {
+	t.Parallel()
+
+	const hands = 4
+	config := DefaultConfig(2, 2)
+	config.HandLimit = hands
+	config.HandsPerHour = 36000 // One hand every 100ms
+	interval := time.Hour / time.Duration(config.HandsPerHour)
+	pool := NewBotPool(testLogger(), randutil.New(2178), config)
+	server := NewServer(testLogger(), randutil.New(1), WithBotPool(pool))
+	stopPool := startTestPool(t, pool)
+	defer stopPool()
+
+	ts := httptest.NewServer(http.HandlerFunc(server.handleWebSocket))
+	defer ts.Close()
+	wsURL := "ws" + strings.TrimPrefix(ts.URL, "http") + "/ws"
+
+	var starts []time.Time
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for _, name := range []string{"Alpha", "Beta"} {
+		conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+		if err != nil {
+			t.Fatalf("failed to dial: %v", err)
+		}
+		defer conn.Close()
+		connect, _ := protocol.Marshal(&protocol.Connect{Type: protocol.TypeConnect, Name: name, ProtocolVersion: "2"})
+		if err := conn.WriteMessage(websocket.BinaryMessage, connect); err != nil {
+			t.Fatalf("failed to send connect: %v", err)
+		}
+
+		wg.Go(func() {
+			fold, _ := protocol.Marshal(&protocol.Action{Type: protocol.TypeAction, Action: "fold"})
+			_ = conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+			for {
+				_, data, err := conn.ReadMessage()
+				if err != nil {
+					return
+				}
+				var envelope protocol.ServerShutdown
+				if err := protocol.Unmarshal(data, &envelope); err != nil {
+					continue
+				}
+				switch envelope.Type {
+				case protocol.TypeHandStart:
+					if name == "Alpha" {
+						mu.Lock()
+						starts = append(starts, time.Now())
+						mu.Unlock()
+					}
+				case protocol.TypeActionRequest:
+					if err := conn.WriteMessage(websocket.BinaryMessage, fold); err != nil {
+						return
+					}
+				case protocol.TypeGameCompleted:
+					return
+				}
+			}
+		})
+	}
+	wg.Wait()
+
+	if len(starts) != hands {
+		t.Fatalf("expected %d hand starts, got %d", hands, len(starts))
+	}
+	// Allow a little slack for when each client reads its hand start
+	for i := 1; i < len(starts); i++ {
+		if gap := starts[i].Sub(starts[i-1]); gap < interval-10*time.Millisecond {
+			t.Errorf("hand %d started %v after the previous, want at least %v", i+1, gap, interval)
+		}
+	}
+}
+
+func TestAdminStopGameLeavesOtherTablesRunning(t *testing.T) {
+	t.Parallel()
+
+	stoppedPool := NewBotPool(testLogger(), randutil.New(2179), DefaultConfig(2, 2))
+	server := NewServer(testLogger(), randutil.New(1), WithBotPool(stoppedPool))
+	stopStopped := startTestPool(t, stoppedPool)
+	defer stopStopped()
+
+	otherConfig := DefaultConfig(2, 2)
+	otherPool := NewBotPool(testLogger(), randutil.New(2180), otherConfig)
+	server.manager.RegisterGame("other", otherPool, otherConfig)
+	stopOther := startTestPool(t, otherPool)
+	defer stopOther()
+
+	ts := httptest.NewServer(http.HandlerFunc(server.handleWebSocket))
+	defer ts.Close()
+	wsURL := "ws" + strings.TrimPrefix(ts.URL, "http") + "/ws"
+
+	// Each bot folds until the game completes, then reports the reason
+	completed := map[string]chan string{}
+	for _, game := range []string{"default", "other"} {
+		completed[game] = make(chan string, 2)
+		for _, name := range []string{"Alpha", "Beta"} {
+			conn := dialAndConnect(t, wsURL, game+"-"+name, game)
+			defer conn.Close()
+			go func() {
+				fold, _ := protocol.Marshal(&protocol.Action{Type: protocol.TypeAction, Action: "fold"})
+				for {
+					_, data, err := conn.ReadMessage()
+					if err != nil {
+						return
+					}
+					var envelope protocol.ServerShutdown
+					if err := protocol.Unmarshal(data, &envelope); err != nil {
+						continue
+					}
+					switch envelope.Type {
+					case protocol.TypeActionRequest:
+						if err := conn.WriteMessage(websocket.BinaryMessage, fold); err != nil {
+							return
+						}
+					case protocol.TypeGameCompleted:
+						var msg protocol.GameCompleted
+						if err := protocol.Unmarshal(data, &msg); err == nil {
+							completed[game] <- msg.Reason
+						}
+						return
+					}
+				}
+			}()
+		}
+	}
+
+	waitForHands := func(pool *BotPool, n uint64) {
+		t.Helper()
+		deadline := time.Now().Add(5 * time.Second)
+		for pool.HandCount() < n {
+			if time.Now().After(deadline) {
+				t.Fatalf("timed out waiting for %d hands, got %d", n, pool.HandCount())
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+	}
+	waitForHands(stoppedPool, 1)
+	waitForHands(otherPool, 1)
+
+	rec := httptest.NewRecorder()
+	server.handleAdminGame(rec, httptest.NewRequest(http.MethodPost, "/admin/games/default/stop", nil))
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	for range 2 {
+		select {
+		case reason := <-completed["default"]:
+			if reason != reasonAdminStopped {
+				t.Errorf("expected reason %q, got %q", reasonAdminStopped, reason)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for game_completed on the stopped table")
+		}
+	}
+
+	// The stopped table starts no more hands while the other keeps dealing
+	stoppedHands := stoppedPool.HandCount()
+	waitForHands(otherPool, otherPool.HandCount()+10)
+	if got := stoppedPool.HandCount(); got != stoppedHands {
+		t.Errorf("stopped table played %d more hands", got-stoppedHands)
+	}
+	select {
+	case reason := <-completed["other"]:
+		t.Fatalf("other table completed unexpectedly: %s", reason)
+	default:
+	}
+	if _, ok := server.manager.GetGame("default"); !ok {
+		t.Error("expected stopped game to stay registered")
+	}
+
+	// Bots connecting after the stop are turned away rather than left waiting
+	late := dialAndConnect(t, wsURL, "Late", "default")
+	defer late.Close()
+	_ = late.SetReadDeadline(time.Now().Add(5 * time.Second))
+	for {
+		_, _, err := late.ReadMessage()
+		if err == nil {
+			continue
+		}
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			t.Fatal("expected the connection to the stopped game to be closed")
+		}
+		break
+	}
+}
+
+// handSizeMonitor reports the number of players dealt into each hand.
+type handSizeMonitor struct {
+	NullHandMonitor
+	sizes chan int
+}
+
+func (m *handSizeMonitor) OnHandStart(_ string, players []HandPlayer, _ int, _ Blinds) {
+	select {
+	case m.sizes <- len(players):
+	default:
+	}
+}
+
+func TestAdminOpenAndCloseSeats(t *testing.T) {
+	t.Parallel()
+
+	pool := NewBotPool(testLogger(), randutil.New(2195), DefaultConfig(2, 3))
+	monitor := &handSizeMonitor{sizes: make(chan int, 1024)}
+	pool.SetHandMonitor(monitor)
+	server := NewServer(testLogger(), randutil.New(1), WithBotPool(pool))
+	stopPool := startTestPool(t, pool)
+	defer stopPool()
+
+	ts := httptest.NewServer(http.HandlerFunc(server.handleWebSocket))
+	defer ts.Close()
+	wsURL := "ws" + strings.TrimPrefix(ts.URL, "http") + "/ws"
+
+	changeSeats := func(endpoint string, wantCode, wantSeats int) {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		server.handleAdminGame(rec, httptest.NewRequest(http.MethodPost, "/admin/games/default/"+endpoint, nil))
+		if rec.Code != wantCode {
+			t.Fatalf("%s: expected %d, got %d: %s", endpoint, wantCode, rec.Code, rec.Body.String())
+		}
+		if pool.OpenSeats() != wantSeats {
+			t.Fatalf("%s: expected %d open seats, got %d", endpoint, wantSeats, pool.OpenSeats())
+		}
+	}
+	nextHand := func() int {
+		t.Helper()
+		select {
+		case size := <-monitor.sizes:
+			return size
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for a hand to start")
+		}
+		return 0
+	}
+
+	// Start heads-up with the third seat closed
+	changeSeats("close-seat", http.StatusOK, 2)
+
+	// Bots fold every decision, except that Gamma holds its first decision in a
+	// three-handed hand until released
+	held := make(chan struct{})
+	release := make(chan struct{})
+	play := func(name string) {
+		conn := dialAndConnect(t, wsURL, name, "default")
+		t.Cleanup(func() { conn.Close() })
+		go func() {
+			fold, _ := protocol.Marshal(&protocol.Action{Type: protocol.TypeAction, Action: "fold"})
+			players, holding := 0, name == "Gamma"
+			for {
+				_, data, err := conn.ReadMessage()
+				if err != nil {
+					return
+				}
+				var envelope protocol.ServerShutdown
+				if err := protocol.Unmarshal(data, &envelope); err != nil {
+					continue
+				}
+				switch envelope.Type {
+				case protocol.TypeHandStart:
+					var start protocol.HandStart
+					if err := protocol.Unmarshal(data, &start); err == nil {
+						players = len(start.Players)
+					}
+				case protocol.TypeActionRequest:
+					if holding && players == 3 {
+						holding = false
+						close(held)
+						<-release
+					}
+					if err := conn.WriteMessage(websocket.BinaryMessage, fold); err != nil {
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	play("Alpha")
+	play("Beta")
+	for range 5 {
+		if size := nextHand(); size != 2 {
+			t.Fatalf("expected heads-up hands with one open seat closed, got %d players", size)
+		}
+	}
+
+	// Opening a seat deals the next bot to connect into the table
+	changeSeats("open-seat", http.StatusOK, 3)
+	play("Gamma")
+	size := nextHand()
+	for size != 3 {
+		size = nextHand()
+	}
+
+	// Closing the seat mid-hand lets that hand finish three-handed, then the
+	// table deals heads-up again
+	select {
+	case <-held:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for Gamma to act in a three-handed hand")
+	}
+	changeSeats("close-seat", http.StatusOK, 2)
+	for len(monitor.sizes) > 0 {
+		<-monitor.sizes
+	}
+	close(release)
+	for range 10 {
+		if size = nextHand(); size != 2 {
+			t.Fatalf("expected heads-up hands after closing a seat, got %d players", size)
+		}
+	}
+
+	// Seats stay within the configured player bounds
+	changeSeats("close-seat", http.StatusConflict, 2)
+}
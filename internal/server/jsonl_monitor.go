@@ -0,0 +1,173 @@
+package server
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// JSONLMonitor implements HandMonitor by writing one JSON object per completed hand,
+// with the players, every action, the board and the winners. The format is simpler
+// to consume than PHH for ad-hoc session analysis.
+type JSONLMonitor struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+	hands   map[string]*JSONLHand
+	err     error
+}
+
+// JSONLHand is a single hand record written by JSONLMonitor.
+type JSONLHand struct {
+	HandID        string        `json:"hand_id"`
+	Button        int           `json:"button"`
+	SmallBlind    int           `json:"small_blind"`
+	BigBlind      int           `json:"big_blind"`
+	Players       []JSONLPlayer `json:"players"`
+	Actions       []JSONLAction `json:"actions"`
+	Board         []string      `json:"board"`
+	StreetReached string        `json:"street_reached,omitempty"`
+	TotalPot      int           `json:"total_pot"`
+	Winners       []JSONLWinner `json:"winners"`
+
+	street string // Street in progress while the hand is being recorded
+}
+
+// JSONLPlayer describes a seated player at the start of a hand.
+type JSONLPlayer struct {
+	Seat        int      `json:"seat"`
+	BotID       string   `json:"bot_id"`
+	DisplayName string   `json:"display_name,omitempty"`
+	Chips       int      `json:"chips"`
+	HoleCards   []string `json:"hole_cards,omitempty"`
+	NetChips    int      `json:"net_chips"`
+}
+
+// JSONLAction is a single action, including blind posts.
+type JSONLAction struct {
+	Street string `json:"street"`
+	Seat   int    `json:"seat"`
+	Action string `json:"action"`
+	Amount int    `json:"amount"`
+	Stack  int    `json:"stack"`
+}
+
+// JSONLWinner records a player who finished the hand with a profit.
+type JSONLWinner struct {
+	Seat     int `json:"seat"`
+	NetChips int `json:"net_chips"`
+}
+
+// NewJSONLMonitor creates a monitor writing hand records to w.
+func NewJSONLMonitor(w io.Writer) *JSONLMonitor {
+	return &JSONLMonitor{
+		encoder: json.NewEncoder(w),
+		hands:   make(map[string]*JSONLHand),
+	}
+}
+
+// Err returns the first error encountered writing records, if any.
+func (m *JSONLMonitor) Err() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.err
+}
+
+// OnGameStart implements HandMonitor.
+func (m *JSONLMonitor) OnGameStart(uint64) {}
+
+// OnGameComplete implements HandMonitor.
+func (m *JSONLMonitor) OnGameComplete(uint64, string) {}
+
+// OnHandStart implements HandMonitor.
+func (m *JSONLMonitor) OnHandStart(handID string, players []HandPlayer, button int, blinds Blinds) {
+	hand := &JSONLHand{
+		HandID:     handID,
+		Button:     button,
+		SmallBlind: blinds.Small,
+		BigBlind:   blinds.Big,
+		Players:    make([]JSONLPlayer, len(players)),
+		Actions:    []JSONLAction{},
+		Board:      []string{},
+		Winners:    []JSONLWinner{},
+		street:     "preflop",
+	}
+	for i, player := range players {
+		hand.Players[i] = JSONLPlayer{
+			Seat:        player.Seat,
+			BotID:       player.Name,
+			DisplayName: player.DisplayName,
+			Chips:       player.Chips,
+			HoleCards:   player.HoleCards,
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hands[handID] = hand
+}
+
+// OnPlayerAction implements HandMonitor.
+func (m *JSONLMonitor) OnPlayerAction(handID string, seat int, action string, amount int, stack int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	hand := m.hands[handID]
+	if hand == nil {
+		return
+	}
+	hand.Actions = append(hand.Actions, JSONLAction{
+		Street: hand.street,
+		Seat:   seat,
+		Action: action,
+		Amount: amount,
+		Stack:  stack,
+	})
+}
+
+// OnStreetChange implements HandMonitor.
+func (m *JSONLMonitor) OnStreetChange(handID string, street string, cards []string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	hand := m.hands[handID]
+	if hand == nil {
+		return
+	}
+	hand.street = street
+	hand.Board = append([]string(nil), cards...)
+}
+
+// OnHandComplete implements HandMonitor.
+func (m *JSONLMonitor) OnHandComplete(outcome HandOutcome) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	hand := m.hands[outcome.HandID]
+	if hand == nil {
+		return
+	}
+	delete(m.hands, outcome.HandID)
+
+	if detail := outcome.Detail; detail != nil {
+		hand.TotalPot = detail.TotalPot
+		hand.StreetReached = detail.StreetReached
+		if len(detail.Board) > 0 {
+			hand.Board = detail.Board
+		}
+		for _, botOutcome := range detail.BotOutcomes {
+			for i := range hand.Players {
+				if hand.Players[i].Seat == botOutcome.Position {
+					hand.Players[i].NetChips = botOutcome.NetChips
+				}
+			}
+			if botOutcome.NetChips > 0 {
+				hand.Winners = append(hand.Winners, JSONLWinner{Seat: botOutcome.Position, NetChips: botOutcome.NetChips})
+			}
+		}
+	}
+
+	if m.err != nil {
+		return
+	}
+	m.err = m.encoder.Encode(hand)
+}
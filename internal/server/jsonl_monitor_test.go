@@ -0,0 +1,64 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/lox/pokerforbots/v2/internal/randutil"
+)
+
+func TestJSONLMonitorRecordsPlayedHand(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	pool := NewBotPool(testLogger(), randutil.New(2131), DefaultConfig(2, 3))
+	pool.SetHandMonitor(NewJSONLMonitor(&buf))
+
+	bots := make([]*Bot, 3)
+	for i := range bots {
+		bots[i] = NewBot(testLogger(), fmt.Sprintf("jsonl-bot-%d", i), nil, pool)
+	}
+
+	runner := NewHandRunnerWithConfig(testLogger(), bots, "jsonl", 0, randutil.New(2131), pool.config)
+	runner.SetPool(pool)
+	runFoldingHand(runner)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("expected one JSONL record, got %d:\n%s", len(lines), buf.String())
+	}
+
+	var hand JSONLHand
+	if err := json.Unmarshal([]byte(lines[0]), &hand); err != nil {
+		t.Fatalf("failed to decode record: %v", err)
+	}
+	if hand.HandID != "jsonl" || len(hand.Players) != 3 {
+		t.Fatalf("unexpected hand record: %+v", hand)
+	}
+
+	// Small blind, big blind, then the button and small blind fold
+	wantActions := []string{"post_small_blind", "post_big_blind", "fold", "fold"}
+	if len(hand.Actions) != len(wantActions) {
+		t.Fatalf("expected %d actions, got %+v", len(wantActions), hand.Actions)
+	}
+	for i, want := range wantActions {
+		if hand.Actions[i].Action != want || hand.Actions[i].Street != "preflop" {
+			t.Errorf("action %d: expected preflop %s, got %+v", i, want, hand.Actions[i])
+		}
+	}
+	if hand.Actions[1].Amount != 10 {
+		t.Errorf("expected big blind of 10, got %d", hand.Actions[1].Amount)
+	}
+
+	if len(hand.Winners) != 1 || hand.Winners[0].Seat != 2 || hand.Winners[0].NetChips != 5 {
+		t.Errorf("expected big blind to win 5 net chips, got %+v", hand.Winners)
+	}
+	for _, player := range hand.Players {
+		if len(player.HoleCards) != 2 {
+			t.Errorf("seat %d: expected hole cards, got %v", player.Seat, player.HoleCards)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// latencyBuckets are the upper bounds (in seconds) of the action latency histogram.
+var latencyBuckets = []float64{0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1}
+
+// latencyHistogram is a minimal Prometheus-style histogram for action response latency.
+type latencyHistogram struct {
+	mu     sync.Mutex
+	counts []uint64 // Non-cumulative count per bucket, with a trailing +Inf bucket
+	count  uint64
+	sum    float64
+}
+
+func newLatencyHistogram() *latencyHistogram {
+	return &latencyHistogram{counts: make([]uint64, len(latencyBuckets)+1)}
+}
+
+// Observe records a single latency sample.
+func (h *latencyHistogram) Observe(d time.Duration) {
+	seconds := d.Seconds()
+	idx := len(latencyBuckets)
+	for i, bound := range latencyBuckets {
+		if seconds <= bound {
+			idx = i
+			break
+		}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.counts[idx]++
+	h.count++
+	h.sum += seconds
+}
+
+// snapshot returns cumulative bucket counts along with the total count and sum.
+func (h *latencyHistogram) snapshot() ([]uint64, uint64, float64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	cumulative := make([]uint64, len(h.counts))
+	var running uint64
+	for i, c := range h.counts {
+		running += c
+		cumulative[i] = running
+	}
+	return cumulative, h.count, h.sum
+}
+
+// handleMetrics exposes per-game runtime metrics in the Prometheus text format.
+func (s *Server) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	writeMetrics(w, s.manager.Games())
+}
+
+// writeMetrics renders metrics for the given games in the Prometheus text exposition format.
+func writeMetrics(w io.Writer, games []*GameInstance) {
+	writeHeader(w, "pokerforbots_hands_total", "counter", "Total number of hands completed.")
+	for _, g := range games {
+		fmt.Fprintf(w, "pokerforbots_hands_total{game=%q} %d\n", g.ID, g.Pool.HandCount())
+	}
+
+	writeHeader(w, "pokerforbots_hands_per_second", "gauge", "Average hands completed per second since the game started.")
+	for _, g := range games {
+		fmt.Fprintf(w, "pokerforbots_hands_per_second{game=%q} %s\n", g.ID, formatFloat(g.Pool.HandsPerSecond()))
+	}
+
+	writeHeader(w, "pokerforbots_connected_bots", "gauge", "Number of bots currently connected.")
+	for _, g := range games {
+		fmt.Fprintf(w, "pokerforbots_connected_bots{game=%q} %d\n", g.ID, g.Pool.BotCount())
+	}
+
+	writeHeader(w, "pokerforbots_action_errors_total", "counter", "Action requests that did not complete with a valid action.")
+	for _, g := range games {
+		fmt.Fprintf(w, "pokerforbots_action_errors_total{game=%q,type=\"timeout\"} %d\n", g.ID, g.Pool.TimeoutCount())
+		fmt.Fprintf(w, "pokerforbots_action_errors_total{game=%q,type=\"invalid\"} %d\n", g.ID, g.Pool.InvalidActionCount())
+		fmt.Fprintf(w, "pokerforbots_action_errors_total{game=%q,type=\"disconnect\"} %d\n", g.ID, g.Pool.DisconnectCount())
+	}
+
+	writeHeader(w, "pokerforbots_action_latency_seconds", "histogram", "Time taken by bots to respond to action requests.")
+	for _, g := range games {
+		if g.Pool.actionLatency == nil {
+			continue
+		}
+		buckets, count, sum := g.Pool.actionLatency.snapshot()
+		for i, bound := range latencyBuckets {
+			fmt.Fprintf(w, "pokerforbots_action_latency_seconds_bucket{game=%q,le=%q} %d\n", g.ID, formatFloat(bound), buckets[i])
+		}
+		fmt.Fprintf(w, "pokerforbots_action_latency_seconds_bucket{game=%q,le=\"+Inf\"} %d\n", g.ID, buckets[len(buckets)-1])
+		fmt.Fprintf(w, "pokerforbots_action_latency_seconds_sum{game=%q} %s\n", g.ID, formatFloat(sum))
+		fmt.Fprintf(w, "pokerforbots_action_latency_seconds_count{game=%q} %d\n", g.ID, count)
+	}
+}
+
+func writeHeader(w io.Writer, name, kind, help string) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
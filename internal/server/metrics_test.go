@@ -0,0 +1,96 @@
+package server
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lox/pokerforbots/v2/internal/randutil"
+)
+
+func TestMetricsEndpoint(t *testing.T) {
+	t.Parallel()
+
+	config := DefaultConfig(2, 2)
+	config.Timeout = 20 * time.Millisecond
+	config.EnableMetrics = true
+	srv := NewServer(testLogger(), randutil.New(99), WithConfig(config))
+	stopPool := startTestPool(t, srv.pool)
+	defer stopPool()
+
+	srv.ensureRoutes()
+	ts := httptest.NewServer(srv.mux)
+	defer ts.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(ts.URL, "http") + "/ws"
+	for _, name := range []string{"metrics-a", "metrics-b"} {
+		conn := dialAndConnect(t, wsURL, name, "")
+		defer conn.Close()
+		// Drain messages without responding so every action request times out
+		go func() {
+			for {
+				if _, _, err := conn.ReadMessage(); err != nil {
+					return
+				}
+			}
+		}()
+	}
+
+	waitForCondition(t, func() bool {
+		return srv.pool.HandCount() >= 2
+	}, 5*time.Second, "expected hands to be played")
+
+	resp, err := http.Get(ts.URL + "/metrics")
+	if err != nil {
+		t.Fatalf("failed to fetch metrics: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("failed to read metrics body: %v", err)
+	}
+	body := string(data)
+
+	expected := []string{
+		"# TYPE pokerforbots_hands_total counter",
+		`pokerforbots_hands_total{game="default"}`,
+		`pokerforbots_hands_per_second{game="default"}`,
+		`pokerforbots_connected_bots{game="default"} 2`,
+		`pokerforbots_action_errors_total{game="default",type="timeout"}`,
+		`pokerforbots_action_errors_total{game="default",type="invalid"} 0`,
+		"# TYPE pokerforbots_action_latency_seconds histogram",
+		`pokerforbots_action_latency_seconds_bucket{game="default",le="+Inf"}`,
+		`pokerforbots_action_latency_seconds_count{game="default"}`,
+	}
+	for _, want := range expected {
+		if !strings.Contains(body, want) {
+			t.Errorf("expected metrics output to contain %q, got:\n%s", want, body)
+		}
+	}
+	if strings.Contains(body, `type="timeout"} 0`) {
+		t.Errorf("expected timeouts to be counted, got:\n%s", body)
+	}
+}
+
+func TestMetricsEndpointDisabled(t *testing.T) {
+	t.Parallel()
+
+	srv := NewServer(testLogger(), randutil.New(1))
+	srv.ensureRoutes()
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	rec := httptest.NewRecorder()
+	srv.mux.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected 404 when metrics disabled, got %d", rec.Code)
+	}
+}
@@ -69,8 +69,11 @@ type BotHandOutcome struct {
 	ButtonDistance int
 	HoleCards      []string
 	NetChips       int
+	StreetReached  string // Furthest street seen without folding ("showdown" if shown down)
 	WentToShowdown bool
 	WonAtShowdown  bool
+	ShowdownHand   string // Hand class shown down (e.g. "Two Pair"), empty without a showdown
+	WonLowOnly     bool   // Won at showdown only with the low half of a hi-lo pot
 	Actions        map[string]string
 	TimedOut       bool
 	InvalidActions int
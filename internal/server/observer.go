@@ -0,0 +1,190 @@
+package server
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/lox/pokerforbots/v2/protocol"
+	"github.com/rs/zerolog"
+)
+
+// spectatorSeat is the perspective used for messages sent to observers: nobody's
+// hole cards are dealt to it and players are shown by display name.
+const spectatorSeat = -1
+
+// observerBufferSize is how many messages may queue for an observer before it is
+// considered too slow and disconnected. Observers never hold up a hand.
+const observerBufferSize = 256
+
+// observer is a read-only spectator connection.
+type observer struct {
+	conn      *websocket.Conn
+	send      chan []byte
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func (o *observer) close() {
+	o.closeOnce.Do(func() {
+		close(o.done)
+	})
+}
+
+// flushQueued writes any messages still waiting in the send buffer.
+// Returns false if a write fails.
+func (o *observer) flushQueued() bool {
+	for {
+		select {
+		case message := <-o.send:
+			_ = o.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := o.conn.WriteMessage(websocket.BinaryMessage, message); err != nil {
+				return false
+			}
+		default:
+			return true
+		}
+	}
+}
+
+// observerHub fans public game events out to the observers watching a game.
+type observerHub struct {
+	mu        sync.RWMutex
+	observers map[*observer]struct{}
+	logger    zerolog.Logger
+}
+
+func newObserverHub(logger zerolog.Logger) *observerHub {
+	return &observerHub{
+		observers: make(map[*observer]struct{}),
+		logger:    logger,
+	}
+}
+
+// add starts serving a spectator connection until it disconnects.
+func (h *observerHub) add(conn *websocket.Conn) {
+	o := &observer{
+		conn: conn,
+		send: make(chan []byte, observerBufferSize),
+		done: make(chan struct{}),
+	}
+
+	h.mu.Lock()
+	h.observers[o] = struct{}{}
+	h.mu.Unlock()
+
+	go h.writePump(o)
+	go h.readPump(o)
+}
+
+func (h *observerHub) remove(o *observer) {
+	h.mu.Lock()
+	delete(h.observers, o)
+	h.mu.Unlock()
+	o.close()
+}
+
+// active reports whether anyone is watching, so callers can skip building messages.
+func (h *observerHub) active() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.observers) > 0
+}
+
+// count returns the number of connected observers.
+func (h *observerHub) count() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.observers)
+}
+
+// broadcast queues msg for every observer, dropping any that have fallen behind.
+func (h *observerHub) broadcast(msg any) {
+	if !h.active() {
+		return
+	}
+	data, err := protocol.Marshal(msg)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to marshal observer message")
+		return
+	}
+
+	var slow []*observer
+	h.mu.RLock()
+	for o := range h.observers {
+		select {
+		case o.send <- data:
+		default:
+			slow = append(slow, o)
+		}
+	}
+	h.mu.RUnlock()
+
+	for _, o := range slow {
+		h.logger.Warn().Msg("Disconnecting slow observer")
+		h.remove(o)
+	}
+}
+
+// closeAll disconnects every observer after writing any queued messages.
+func (h *observerHub) closeAll() {
+	h.mu.Lock()
+	observers := make([]*observer, 0, len(h.observers))
+	for o := range h.observers {
+		observers = append(observers, o)
+	}
+	clear(h.observers)
+	h.mu.Unlock()
+
+	for _, o := range observers {
+		o.close()
+	}
+}
+
+// readPump discards anything the observer sends and detects disconnects.
+func (h *observerHub) readPump(o *observer) {
+	defer h.remove(o)
+
+	_ = o.conn.SetReadDeadline(time.Now().Add(pongWait))
+	o.conn.SetPongHandler(func(string) error {
+		return o.conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+	for {
+		if _, _, err := o.conn.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
+
+func (h *observerHub) writePump(o *observer) {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		_ = o.conn.Close()
+	}()
+
+	for {
+		select {
+		case message := <-o.send:
+			_ = o.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := o.conn.WriteMessage(websocket.BinaryMessage, message); err != nil {
+				return
+			}
+
+		case <-o.done:
+			// Flush what's queued (e.g. game_completed) before closing
+			if !o.flushQueued() {
+				return
+			}
+			_ = o.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			_ = o.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, ""))
+			return
+
+		case <-ticker.C:
+			_ = o.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := o.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
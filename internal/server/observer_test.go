@@ -0,0 +1,99 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/lox/pokerforbots/v2/internal/randutil"
+	"github.com/lox/pokerforbots/v2/protocol"
+)
+
+func TestObserverReceivesPublicEvents(t *testing.T) {
+	t.Parallel()
+
+	config := DefaultConfig(2, 2)
+	config.HandLimit = 5
+	pool := NewBotPool(testLogger(), randutil.New(2150), config)
+	server := NewServer(testLogger(), randutil.New(1), WithBotPool(pool))
+	stopPool := startTestPool(t, pool)
+	defer stopPool()
+
+	ts := httptest.NewServer(http.HandlerFunc(server.handleWebSocket))
+	defer ts.Close()
+	wsURL := "ws" + strings.TrimPrefix(ts.URL, "http") + "/ws"
+
+	spectator, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	if err != nil {
+		t.Fatalf("dial observer: %v", err)
+	}
+	defer spectator.Close()
+	connect, _ := protocol.Marshal(&protocol.Connect{Type: protocol.TypeConnect, Name: "dashboard", Role: protocol.RoleObserver})
+	if err := spectator.WriteMessage(websocket.BinaryMessage, connect); err != nil {
+		t.Fatalf("send observer connect: %v", err)
+	}
+	waitForCondition(t, func() bool {
+		return pool.ObserverCount() == 1
+	}, time.Second, "Expected observer to be registered")
+
+	for _, name := range []string{"Alpha", "Beta"} {
+		conn := dialAndConnect(t, wsURL, name, "")
+		defer conn.Close()
+		go foldActions(conn, 0)
+	}
+
+	var handStarts, handResults, actions int
+	_ = spectator.SetReadDeadline(time.Now().Add(10 * time.Second))
+	for {
+		_, data, err := spectator.ReadMessage()
+		if err != nil {
+			t.Fatalf("observer read failed after %d hand starts: %v", handStarts, err)
+		}
+
+		// Every message has a type field; decoding skips the fields it doesn't know
+		var envelope protocol.ServerShutdown
+		if err := protocol.Unmarshal(data, &envelope); err != nil {
+			continue
+		}
+		switch envelope.Type {
+		case protocol.TypeHandStart:
+			var start protocol.HandStart
+			if err := protocol.Unmarshal(data, &start); err != nil {
+				t.Fatalf("decode hand_start: %v", err)
+			}
+			handStarts++
+			if len(start.HoleCards) != 0 || start.YourSeat != spectatorSeat {
+				t.Errorf("expected spectator hand_start without hole cards, got seat %d cards %v", start.YourSeat, start.HoleCards)
+			}
+			var names []string
+			for _, player := range start.Players {
+				names = append(names, player.Name)
+			}
+			slices.Sort(names)
+			if !slices.Equal(names, []string{"Alpha", "Beta"}) {
+				t.Errorf("expected players shown by display name, got %v", names)
+			}
+		case protocol.TypePlayerAction:
+			actions++
+		case protocol.TypeActionRequest:
+			t.Fatal("observer should never be asked to act")
+		case protocol.TypeHandResult:
+			handResults++
+		case protocol.TypeGameCompleted:
+			if handStarts != 5 || handResults != 5 {
+				t.Errorf("expected 5 hand starts and results, got %d and %d", handStarts, handResults)
+			}
+			if actions == 0 {
+				t.Error("expected player actions to be broadcast to the observer")
+			}
+			if pool.BotCount() != 2 {
+				t.Errorf("observer should not be seated, pool has %d bots", pool.BotCount())
+			}
+			return
+		}
+	}
+}
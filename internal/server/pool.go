@@ -3,6 +3,7 @@ package server
 import (
 	"github.com/lox/pokerforbots/v2/internal/randutil"
 
+	"context"
 	"fmt"
 	rand "math/rand/v2"
 	"sort"
@@ -10,6 +11,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/gorilla/websocket"
 	"github.com/lox/pokerforbots/v2/protocol"
 	"github.com/rs/zerolog"
 )
@@ -17,12 +19,14 @@ import (
 // BotPool manages available bots and matches them into hands
 type BotPool struct {
 	bots              map[string]*Bot
+	departed          map[string]*Bot   // Disconnected bots whose bankroll is held for Config.ReconnectGrace
+	departedAt        map[string]uint64 // Hand count when each departed bot left
 	available         chan *Bot
 	register          chan *Bot
 	unregister        chan *Bot
 	mu                sync.RWMutex
 	minPlayers        int
-	maxPlayers        int
+	maxPlayers        int // Open seats, between minPlayers and Config.MaxPlayers; guarded by mu
 	handCounter       uint64
 	handLimit         uint64      // 0 means unlimited
 	handLimitLogged   atomic.Bool // Track if we've logged the hand limit message
@@ -32,14 +36,20 @@ type BotPool struct {
 	logger            zerolog.Logger
 	rng               *rand.Rand
 	rngMutex          sync.Mutex // Protect RNG access
+	handSeed          int64      // Master seed that hand IDs and per-hand RNGs derive from
 	config            Config     // Server configuration
 	gameID            string
 	matchTrigger      chan struct{}
 	matcherWG         sync.WaitGroup
+	handsWG           sync.WaitGroup // In-flight hands, waited on when draining
+	nextHandAt        time.Time      // Earliest start of the next hand under Config.HandsPerHour
 	runOnce           sync.Once
 
 	// Metrics
 	timeoutCounter   uint64
+	invalidCounter   uint64
+	disconnectCount  uint64
+	actionLatency    *latencyHistogram // nil unless metrics export is enabled
 	handStartTime    time.Time
 	gameEndTime      time.Time
 	metricsLock      sync.RWMutex
@@ -48,6 +58,9 @@ type BotPool struct {
 	progressMonitor    HandMonitor
 	handHistoryMonitor HandMonitor
 	statsMonitor       *StatsMonitor
+
+	observers *observerHub // Spectators receiving public events
+	tables    tableHands   // Public state of hands in progress
 }
 
 // WithRNG executes fn with exclusive access to the pool's RNG.
@@ -57,7 +70,11 @@ func (p *BotPool) WithRNG(fn func(*rand.Rand)) {
 	fn(p.rng)
 }
 
-const reasonHandLimitReached = "hand_limit_reached"
+const (
+	reasonHandLimitReached = "hand_limit_reached"
+	reasonServerShutdown   = "server_shutdown"
+	reasonAdminStopped     = "admin_stopped"
+)
 
 // DefaultConfig returns a config with sensible defaults
 func DefaultConfig(minPlayers, maxPlayers int) Config {
@@ -87,8 +104,16 @@ func NewBotPool(logger zerolog.Logger, rng *rand.Rand, config Config) *BotPool {
 			Msg("Statistics collection enabled")
 	}
 
+	// Without a configured seed, draw one so hands can still be reproduced from their IDs
+	handSeed := config.Seed
+	if handSeed == 0 {
+		handSeed = rng.Int64()
+	}
+
 	pool := &BotPool{
 		bots:          make(map[string]*Bot),
+		departed:      make(map[string]*Bot),
+		departedAt:    make(map[string]uint64),
 		available:     make(chan *Bot, 100),
 		register:      make(chan *Bot, 10),
 		unregister:    make(chan *Bot, 10),
@@ -98,12 +123,17 @@ func NewBotPool(logger zerolog.Logger, rng *rand.Rand, config Config) *BotPool {
 		stopCh:        make(chan struct{}),
 		logger:        logger.With().Str("component", "pool").Logger(),
 		rng:           rng,
+		handSeed:      handSeed,
 		config:        config,
 		handStartTime: time.Time{},
 		matchTrigger:  make(chan struct{}, 1),
 		statsMonitor:  statsMonitor,
 	}
+	pool.observers = newObserverHub(pool.logger)
 	pool.completionReason.Store("")
+	if config.EnableMetrics {
+		pool.actionLatency = newLatencyHistogram()
+	}
 
 	statsMonitor.OnGameStart(config.HandLimit)
 
@@ -138,14 +168,25 @@ func (p *BotPool) ensureMatchLoop() {
 	})
 }
 
-// RecordActionLatency forwards latency metrics to the stats monitor when enabled.
+// RecordActionLatency forwards latency metrics to the metrics histogram and stats monitor when enabled.
 func (p *BotPool) RecordActionLatency(botID string, duration time.Duration, outcome ResponseOutcome) {
-	if p == nil || !p.config.EnableLatencyTracking || p.statsMonitor == nil {
+	if p == nil {
+		return
+	}
+	if p.actionLatency != nil {
+		p.actionLatency.Observe(duration)
+	}
+	if !p.config.EnableLatencyTracking || p.statsMonitor == nil {
 		return
 	}
 	p.statsMonitor.RecordResponse(botID, duration, outcome)
 }
 
+// tracksActionLatency reports whether hand runners should time action responses.
+func (p *BotPool) tracksActionLatency() bool {
+	return p.config.EnableLatencyTracking || p.actionLatency != nil
+}
+
 // GetHandMonitor returns the combined monitor (both progress and stats)
 func (p *BotPool) GetHandMonitor() HandMonitor {
 	monitors := []HandMonitor{}
@@ -172,6 +213,9 @@ func (p *BotPool) GameID() string {
 func (p *BotPool) Run() {
 	p.ensureMatchLoop()
 
+	// Armed while the pool is short of players but disconnected bots may still return
+	var reconnectExpired <-chan time.Time
+
 	for {
 		select {
 		case <-p.stopCh:
@@ -182,9 +226,29 @@ func (p *BotPool) Run() {
 				continue
 			}
 			p.mu.Lock()
+			if p.config.ReconnectGrace > 0 {
+				// A returning bot picks up its bankroll where it left off
+				if previous, exists := p.bots[bot.ID]; exists && previous != bot {
+					bot.inheritBankroll(previous)
+				} else if previous, exists := p.departed[bot.ID]; exists {
+					bot.inheritBankroll(previous)
+					// Hands dealt while it was away went ahead without it, so it owes blinds
+					if p.config.PostMissedBlinds && atomic.LoadUint64(&p.handCounter) > p.departedAt[bot.ID] {
+						bot.MarkMissedBlinds()
+					}
+				}
+				delete(p.departed, bot.ID)
+				delete(p.departedAt, bot.ID)
+			}
 			p.bots[bot.ID] = bot
+			enoughBots := len(p.bots) >= p.minPlayers
 			p.mu.Unlock()
 
+			if enoughBots && reconnectExpired != nil {
+				p.logger.Info().Str("bot_id", bot.ID).Msg("Bot reconnected, resuming game")
+				reconnectExpired = nil
+			}
+
 			// Add to available queue if not in hand
 			if !bot.IsInHand() {
 				select {
@@ -205,17 +269,46 @@ func (p *BotPool) Run() {
 			// (handles case where bot reconnected with same ID)
 			if currentBot, exists := p.bots[bot.ID]; exists && currentBot == bot {
 				delete(p.bots, bot.ID)
+				if p.config.ReconnectGrace > 0 {
+					p.departed[bot.ID] = bot
+					p.departedAt[bot.ID] = atomic.LoadUint64(&p.handCounter)
+				}
 			}
 			remainingBots := len(p.bots)
 			p.mu.Unlock()
 
 			if remainingBots < p.minPlayers {
+				if p.config.ReconnectGrace > 0 {
+					if reconnectExpired == nil {
+						p.logger.Warn().
+							Int("remaining_bots", remainingBots).
+							Int("min_players", p.minPlayers).
+							Dur("grace", p.config.ReconnectGrace).
+							Msg("Insufficient bots remaining, waiting for reconnects")
+						reconnectExpired = time.After(p.config.ReconnectGrace)
+					}
+					continue
+				}
 				p.logger.Warn().
 					Int("remaining_bots", remainingBots).
 					Int("min_players", p.minPlayers).
 					Msg("Insufficient bots remaining, ending game early")
 				p.notifyGameCompleted("insufficient_players")
 			}
+
+		case <-reconnectExpired:
+			reconnectExpired = nil
+			p.mu.RLock()
+			remainingBots := len(p.bots)
+			p.mu.RUnlock()
+
+			if remainingBots < p.minPlayers {
+				p.logger.Warn().
+					Int("remaining_bots", remainingBots).
+					Int("min_players", p.minPlayers).
+					Msg("Bots did not reconnect in time, ending game early")
+				p.notifyGameCompleted("insufficient_players")
+			}
 		}
 	}
 }
@@ -279,8 +372,18 @@ func (p *BotPool) tryMatch() {
 		return
 	}
 
+	// Hold the next hand back until the pacing allows it, then recount since bots
+	// may have come and gone while waiting
+	if !p.waitForPace() {
+		return
+	}
+	availableCount = len(p.available)
+	if availableCount < p.minPlayers {
+		return
+	}
+
 	// Determine number of players for this hand
-	numPlayers := min(availableCount, p.maxPlayers)
+	numPlayers := min(availableCount, p.OpenSeats())
 
 	// Collect all available bots first for random selection
 	allBots := make([]*Bot, 0, availableCount)
@@ -333,6 +436,11 @@ collectLoop:
 	})
 	p.rngMutex.Unlock()
 
+	// Bots pinned to a seat are always dealt in; the rest keep their shuffled order
+	sort.SliceStable(allBots, func(i, j int) bool {
+		return allBots[i].PinnedSeat() >= 0 && allBots[j].PinnedSeat() < 0
+	})
+
 	// Take the first numPlayers after shuffle
 	bots := make([]*Bot, 0, numPlayers)
 	if numPlayers > len(allBots) {
@@ -341,6 +449,7 @@ collectLoop:
 	for i := 0; i < numPlayers; i++ {
 		bots = append(bots, allBots[i])
 	}
+	bots = seatPinnedBots(bots)
 
 	// Return unused bots to available queue
 	for i := numPlayers; i < len(allBots); i++ {
@@ -369,8 +478,12 @@ collectLoop:
 			bot.SetInHand(true)
 		}
 
-		// Start hand runner (will be implemented)
-		go p.runHand(bots)
+		if p.config.HandsPerHour > 0 {
+			p.nextHandAt = time.Now().Add(time.Hour / time.Duration(p.config.HandsPerHour))
+		}
+		p.handsWG.Go(func() {
+			p.runHand(bots)
+		})
 	} else {
 		// Return bots to available queue
 		for _, bot := range bots {
@@ -383,6 +496,44 @@ collectLoop:
 	}
 }
 
+// waitForPace blocks until Config.HandsPerHour allows another hand to start.
+// Returns false if the pool stopped while waiting.
+func (p *BotPool) waitForPace() bool {
+	wait := time.Until(p.nextHandAt)
+	if p.config.HandsPerHour <= 0 || wait <= 0 {
+		return true
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-p.stopCh:
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// seatPinnedBots moves bots pinned to a seat into it, filling the remaining seats
+// with the other bots in order. Bots pinned to a taken or missing seat are seated
+// like unpinned bots.
+func seatPinnedBots(bots []*Bot) []*Bot {
+	seated := make([]*Bot, len(bots))
+	var unseated []*Bot
+	for _, bot := range bots {
+		if seat := bot.PinnedSeat(); seat >= 0 && seat < len(seated) && seated[seat] == nil {
+			seated[seat] = bot
+			continue
+		}
+		unseated = append(unseated, bot)
+	}
+	for seat := range seated {
+		if seated[seat] == nil {
+			seated[seat], unseated = unseated[0], unseated[1:]
+		}
+	}
+	return seated
+}
+
 // runHand runs a single hand with the given bots
 func (p *BotPool) runHand(bots []*Bot) {
 	defer func() {
@@ -411,18 +562,14 @@ func (p *BotPool) runHand(bots []*Bot) {
 		}
 	}
 
-	// Generate hand ID
+	// Hand IDs and per-hand RNG streams derive from the master seed and hand
+	// number, so any hand's deck can be reproduced from its ID alone
 	handNum := atomic.AddUint64(&p.handCounter, 1)
-	handID := fmt.Sprintf("hand-%d", handNum)
-
-	// Generate per-hand RNG to avoid concurrent access to the pool RNG
-	p.rngMutex.Lock()
-	handRNGSeed := p.rng.Int64()
-	p.rngMutex.Unlock()
+	handID := HandID(p.handSeed, handNum)
 
 	button := 0 // With freshly shuffled seats, seat 0 acts as the button every hand
 
-	handRNG := randutil.New(handRNGSeed)
+	handRNG := randutil.New(serverSeed(p.handSeed, handNum))
 	p.logger.Debug().
 		Str("hand_id", handID).
 		Int("button_position", button).
@@ -432,6 +579,8 @@ func (p *BotPool) runHand(bots []*Bot) {
 	// Run the hand with the cloned RNG and config
 	runner := NewHandRunnerWithConfig(p.logger, bots, handID, button, handRNG, p.config)
 	runner.SetPool(p) // Pass pool for metrics tracking
+	runner.SetDeckRNG(randutil.New(DeckSeed(p.handSeed, handNum)))
+	runner.SetStudyRNG(randutil.New(studySeed(p.handSeed, handNum)))
 	runner.Run()
 
 	p.logger.Debug().
@@ -473,6 +622,79 @@ func (p *BotPool) Stop() {
 	})
 }
 
+// Drain stops the pool from starting new hands and waits for in-flight hands to finish.
+// Returns the context error if hands are still running when ctx is done.
+func (p *BotPool) Drain(ctx context.Context) error {
+	p.Stop()
+
+	done := make(chan struct{})
+	go func() {
+		p.handsWG.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Finish stops the pool, waits for in-flight hands to finish and then sends
+// game_completed to every bot with the given reason. Bots stay connected so
+// they can read the final results. If ctx is done before the hands finish the
+// bots are notified anyway and the context error is returned.
+func (p *BotPool) Finish(ctx context.Context, reason string) error {
+	err := p.Drain(ctx)
+	p.notifyGameCompleted(reason)
+	return err
+}
+
+// DisconnectAll broadcasts game completion and a shutdown notice to every connected
+// bot and observer, then closes each connection once its queued messages have been written.
+func (p *BotPool) DisconnectAll(reason string) {
+	p.notifyGameCompleted(reason)
+
+	msg := &protocol.ServerShutdown{
+		Type:   protocol.TypeServerShutdown,
+		Reason: reason,
+	}
+
+	p.mu.RLock()
+	bots := make([]*Bot, 0, len(p.bots))
+	for _, bot := range p.bots {
+		bots = append(bots, bot)
+	}
+	p.mu.RUnlock()
+
+	for _, bot := range bots {
+		if err := bot.SendMessage(msg); err != nil {
+			p.logger.Debug().Str("bot_id", bot.ID).Err(err).Msg("failed to send server_shutdown message")
+		}
+		bot.CloseAfterFlush()
+	}
+
+	p.observers.broadcast(msg)
+	p.observers.closeAll()
+}
+
+// AddObserver serves a spectator connection that receives the game's public events
+// until it disconnects.
+func (p *BotPool) AddObserver(conn *websocket.Conn) {
+	p.observers.add(conn)
+}
+
+// ObserverCount returns the number of connected spectators.
+func (p *BotPool) ObserverCount() int {
+	return p.observers.count()
+}
+
+// TableHands returns the public state of the hands in progress, oldest first.
+func (p *BotPool) TableHands() []TableHand {
+	return p.tables.snapshot()
+}
+
 // GetBot returns a bot by ID
 func (p *BotPool) GetBot(id string) (*Bot, bool) {
 	p.mu.RLock()
@@ -488,6 +710,47 @@ func (p *BotPool) BotCount() int {
 	return len(p.bots)
 }
 
+// OpenSeats returns the number of seats dealt into each new hand.
+func (p *BotPool) OpenSeats() int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.maxPlayers
+}
+
+// OpenSeat opens one more seat, up to Config.MaxPlayers, so the next hand can
+// deal in another waiting bot. It returns the number of open seats.
+func (p *BotPool) OpenSeat() (int, error) {
+	p.mu.Lock()
+	if p.maxPlayers >= p.config.MaxPlayers {
+		p.mu.Unlock()
+		return p.config.MaxPlayers, fmt.Errorf("all %d seats are already open", p.config.MaxPlayers)
+	}
+	p.maxPlayers++
+	seats := p.maxPlayers
+	p.mu.Unlock()
+
+	p.logger.Info().Int("open_seats", seats).Msg("Seat opened")
+	p.triggerMatch()
+	return seats, nil
+}
+
+// CloseSeat closes one seat, down to Config.MinPlayers. Hands in progress keep
+// their players and the next hand deals in one bot fewer. It returns the number
+// of open seats.
+func (p *BotPool) CloseSeat() (int, error) {
+	p.mu.Lock()
+	if p.maxPlayers <= p.minPlayers {
+		p.mu.Unlock()
+		return p.minPlayers, fmt.Errorf("cannot close seats below the minimum of %d players", p.minPlayers)
+	}
+	p.maxPlayers--
+	seats := p.maxPlayers
+	p.mu.Unlock()
+
+	p.logger.Info().Int("open_seats", seats).Msg("Seat closed")
+	return seats, nil
+}
+
 // HandCount returns the number of hands completed
 func (p *BotPool) HandCount() uint64 {
 	return atomic.LoadUint64(&p.handCounter)
@@ -515,6 +778,16 @@ func (p *BotPool) Done() <-chan struct{} {
 	return p.stopCh
 }
 
+// Stopped reports whether the pool has stopped and will deal no more hands.
+func (p *BotPool) Stopped() bool {
+	select {
+	case <-p.stopCh:
+		return true
+	default:
+		return false
+	}
+}
+
 // IncrementTimeoutCounter increments the timeout counter
 func (p *BotPool) IncrementTimeoutCounter() {
 	atomic.AddUint64(&p.timeoutCounter, 1)
@@ -525,6 +798,26 @@ func (p *BotPool) TimeoutCount() uint64 {
 	return atomic.LoadUint64(&p.timeoutCounter)
 }
 
+// IncrementInvalidActionCounter increments the invalid action counter
+func (p *BotPool) IncrementInvalidActionCounter() {
+	atomic.AddUint64(&p.invalidCounter, 1)
+}
+
+// InvalidActionCount returns the number of invalid actions that were forced to fold
+func (p *BotPool) InvalidActionCount() uint64 {
+	return atomic.LoadUint64(&p.invalidCounter)
+}
+
+// IncrementDisconnectCounter increments the mid-hand disconnect counter
+func (p *BotPool) IncrementDisconnectCounter() {
+	atomic.AddUint64(&p.disconnectCount, 1)
+}
+
+// DisconnectCount returns the number of bots that disconnected while action was on them
+func (p *BotPool) DisconnectCount() uint64 {
+	return atomic.LoadUint64(&p.disconnectCount)
+}
+
 // HandsPerSecond returns the current hands per second rate
 func (p *BotPool) HandsPerSecond() float64 {
 	p.metricsLock.RLock()
@@ -679,6 +972,7 @@ func (p *BotPool) notifyGameCompleted(reason string) {
 			p.logger.Debug().Str("bot_id", bot.ID).Err(err).Msg("failed to send game_completed message")
 		}
 	}
+	p.observers.broadcast(msg)
 
 	p.logger.Info().
 		Str("game_id", msg.GameID).
@@ -703,6 +997,7 @@ type GameStats struct {
 	TimeoutMs        int                            `json:"timeout_ms"`
 	MinPlayers       int                            `json:"min_players"`
 	MaxPlayers       int                            `json:"max_players"`
+	OpenSeats        int                            `json:"open_seats"`
 	InfiniteBankroll bool                           `json:"infinite_bankroll"`
 	HandsCompleted   uint64                         `json:"hands_completed"`
 	HandLimit        uint64                         `json:"hand_limit"`
@@ -6,6 +6,7 @@ import (
 	"github.com/lox/pokerforbots/v2/internal/randutil"
 
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -360,6 +361,124 @@ func TestBotPoolReconnectWithSameID(t *testing.T) {
 	}
 }
 
+func TestBotPoolReconnectKeepsBankroll(t *testing.T) {
+	t.Parallel()
+
+	for _, grace := range []time.Duration{0, time.Second} {
+		// Three seats minimum so no hands are dealt while bots come and go
+		config := testPoolConfig(3, 4)
+		config.ReconnectGrace = grace
+		pool := NewBotPool(testLogger(), randutil.New(42), config)
+		stopPool := startTestPool(t, pool)
+
+		bots := newTestBots(3, pool)
+		bots[0].bankroll = 700
+		for _, bot := range bots {
+			pool.Register(bot)
+		}
+		waitForCondition(t, func() bool {
+			return pool.BotCount() == 3
+		}, 200*time.Millisecond, "Expected 3 bots to be registered")
+
+		pool.Unregister(bots[0])
+		waitForCondition(t, func() bool {
+			return pool.BotCount() == 2
+		}, 200*time.Millisecond, "Expected the bot to be unregistered")
+
+		select {
+		case <-pool.Done():
+			if grace > 0 {
+				t.Fatalf("game ended during the reconnect grace period (reason %q)", pool.CompletionReason())
+			}
+		case <-time.After(20 * time.Millisecond):
+			if grace == 0 {
+				t.Fatal("expected the game to end without a reconnect grace period")
+			}
+		}
+		if grace == 0 {
+			stopPool()
+			continue
+		}
+
+		reconnected := newTestBot(bots[0].ID, pool)
+		pool.Register(reconnected)
+		waitForCondition(t, func() bool {
+			bot, ok := pool.GetBot(bots[0].ID)
+			return ok && bot == reconnected
+		}, 200*time.Millisecond, "Expected the bot to reconnect")
+
+		if got := reconnected.GetBuyIn(); got != 700 {
+			t.Errorf("expected reconnected bot to keep its 700 bankroll, got %d", got)
+		}
+		stopPool()
+	}
+}
+
+func TestBotPoolSeatLimitDoesNotOweMissedBlinds(t *testing.T) {
+	t.Parallel()
+
+	config := testPoolConfig(2, 2)
+	config.PostMissedBlinds = true
+	pool := NewBotPool(testLogger(), randutil.New(42), config)
+
+	bots := newTestBots(3, pool)
+	for _, bot := range bots {
+		pool.bots[bot.ID] = bot
+		pool.available <- bot
+	}
+
+	pool.tryMatch()
+	pool.handsWG.Wait()
+
+	// The bot left out of the heads-up hand was waiting for a seat, not sitting out
+	for _, bot := range bots {
+		if bot.OwesMissedBlinds() {
+			t.Errorf("bot %s left out by the seat limit should not owe missed blinds", bot.ID)
+		}
+	}
+}
+
+func TestBotPoolReconnectAfterMissedHandsOwesBlinds(t *testing.T) {
+	t.Parallel()
+
+	for _, enabled := range []bool{true, false} {
+		// Four seats minimum so no hand can start and settle the owed blinds
+		config := testPoolConfig(4, 4)
+		config.ReconnectGrace = time.Second
+		config.PostMissedBlinds = enabled
+		pool := NewBotPool(testLogger(), randutil.New(42), config)
+		stopPool := startTestPool(t, pool)
+
+		bots := newTestBots(3, pool)
+		for _, bot := range bots {
+			pool.Register(bot)
+		}
+		waitForCondition(t, func() bool {
+			return pool.BotCount() == 3
+		}, 200*time.Millisecond, "Expected 3 bots to be registered")
+
+		pool.Unregister(bots[0])
+		waitForCondition(t, func() bool {
+			return pool.BotCount() == 2
+		}, 200*time.Millisecond, "Expected the bot to be unregistered")
+
+		// A hand is dealt while the bot is away
+		atomic.AddUint64(&pool.handCounter, 1)
+
+		reconnected := newTestBot(bots[0].ID, pool)
+		pool.Register(reconnected)
+		waitForCondition(t, func() bool {
+			bot, ok := pool.GetBot(bots[0].ID)
+			return ok && bot == reconnected
+		}, 200*time.Millisecond, "Expected the bot to reconnect")
+
+		if got := reconnected.OwesMissedBlinds(); got != enabled {
+			t.Errorf("missed blinds enabled=%v: expected reconnected bot owing=%v, got %v", enabled, enabled, got)
+		}
+		stopPool()
+	}
+}
+
 // waitForCondition waits for a condition to be true with timeout
 func waitForCondition(t *testing.T, condition func() bool, timeout time.Duration, errMsg string) {
 	t.Helper()
@@ -372,3 +491,23 @@ func waitForCondition(t *testing.T, condition func() bool, timeout time.Duration
 	}
 	t.Fatalf("%s (timed out after %v)", errMsg, timeout)
 }
+
+func TestSeatPinnedBots(t *testing.T) {
+	t.Parallel()
+
+	bots := make([]*Bot, 4)
+	for i := range bots {
+		bots[i] = NewBot(testLogger(), fmt.Sprintf("bot-%d", i), nil, nil)
+	}
+	bots[1].SetPinnedSeat(3)
+	bots[2].SetPinnedSeat(3) // Conflicts with bots[1], so it's seated like an unpinned bot
+	bots[3].SetPinnedSeat(0)
+
+	seated := seatPinnedBots(bots)
+	want := []string{"bot-3", "bot-0", "bot-2", "bot-1"}
+	for seat, bot := range seated {
+		if bot.ID != want[seat] {
+			t.Errorf("seat %d: got %s, want %s", seat, bot.ID, want[seat])
+		}
+	}
+}
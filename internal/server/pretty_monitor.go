@@ -3,8 +3,12 @@ package server
 import (
 	"fmt"
 	"io"
+	rand "math/rand/v2"
 	"os"
 	"strings"
+
+	"github.com/lox/pokerforbots/v2/poker"
+	"github.com/lox/pokerforbots/v2/sdk/analysis"
 )
 
 const (
@@ -44,16 +48,49 @@ type PrettyPrintMonitor struct {
 	handsComplete uint64
 	handLimit     uint64
 	currentHand   *prettyHandState
+
+	// Live equity display (optional, costs a Monte Carlo run per street)
+	equitySimulations int
+	equityRNG         *rand.Rand
+
+	// step is called after each action is printed, pausing the hand until it returns
+	step func()
+}
+
+// PrettyPrintOption configures a PrettyPrintMonitor.
+type PrettyPrintOption func(*PrettyPrintMonitor)
+
+// WithEquityDisplay shows each live player's equity against the other players'
+// known hole cards once cards are dealt and after every street, along with their
+// current made hand postflop. Equities are estimated with the given number of
+// Monte Carlo runouts.
+func WithEquityDisplay(simulations int, rng *rand.Rand) PrettyPrintOption {
+	return func(p *PrettyPrintMonitor) {
+		p.equitySimulations = simulations
+		p.equityRNG = rng
+	}
+}
+
+// WithStepper calls step after every action is printed, blocking the hand until it
+// returns. Use it to pause between actions and inspect the hand when debugging.
+func WithStepper(step func()) PrettyPrintOption {
+	return func(p *PrettyPrintMonitor) {
+		p.step = step
+	}
 }
 
 // NewPrettyPrintMonitor creates a new pretty print monitor
-func NewPrettyPrintMonitor(writer io.Writer) *PrettyPrintMonitor {
+func NewPrettyPrintMonitor(writer io.Writer, opts ...PrettyPrintOption) *PrettyPrintMonitor {
 	if writer == nil {
 		writer = os.Stdout
 	}
-	return &PrettyPrintMonitor{
+	p := &PrettyPrintMonitor{
 		writer: writer,
 	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
 }
 
 // OnGameStart is called when the game starts
@@ -128,6 +165,26 @@ func (p *PrettyPrintMonitor) OnPlayerAction(handID string, seat int, action stri
 		return
 	}
 
+	// Print hole cards header if this is first non-blind action, before the
+	// action updates fold state so equities cover every dealt player
+	if !p.currentHand.printedHole && p.currentHand.currentStreet == "preflop" &&
+		action != "post_small_blind" && action != "post_big_blind" && action != "post_dead_blind" {
+		p.currentHand.printedHole = true
+		fmt.Fprintln(p.writer)
+		fmt.Fprintln(p.writer, colorize("*** HOLE CARDS ***", colorBold+colorBlue))
+
+		// Print hole cards for all players (for testing/debugging)
+		for _, player := range p.currentHand.players {
+			if cards, ok := p.currentHand.playerHoleCards[player.Seat]; ok && len(cards) > 0 {
+				name := fallbackName(player.DisplayName, player.Seat)
+				fmt.Fprintf(p.writer, "Dealt to %s %s\n",
+					colorize(name, colorBold),
+					formatCards(cards))
+			}
+		}
+		p.printEquities()
+	}
+
 	// Update player state
 	p.currentHand.playerStacks[seat] = stack
 
@@ -147,24 +204,6 @@ func (p *PrettyPrintMonitor) OnPlayerAction(handID string, seat int, action stri
 		p.currentHand.roles[seat] = append(p.currentHand.roles[seat], "big blind")
 	}
 
-	// Print hole cards header if this is first non-blind action
-	if !p.currentHand.printedHole && p.currentHand.currentStreet == "preflop" &&
-		action != "post_small_blind" && action != "post_big_blind" {
-		p.currentHand.printedHole = true
-		fmt.Fprintln(p.writer)
-		fmt.Fprintln(p.writer, colorize("*** HOLE CARDS ***", colorBold+colorBlue))
-
-		// Print hole cards for all players (for testing/debugging)
-		for _, player := range p.currentHand.players {
-			if cards, ok := p.currentHand.playerHoleCards[player.Seat]; ok && len(cards) > 0 {
-				name := fallbackName(player.DisplayName, player.Seat)
-				fmt.Fprintf(p.writer, "Dealt to %s %s\n",
-					colorize(name, colorBold),
-					formatCards(cards))
-			}
-		}
-	}
-
 	// Get player name
 	playerName := "Unknown"
 	for _, player := range p.currentHand.players {
@@ -178,6 +217,10 @@ func (p *PrettyPrintMonitor) OnPlayerAction(handID string, seat int, action stri
 	nameLabel := p.formatActionName(seat, playerName, p.currentHand.playerFolded[seat], p.currentHand.playerAllIn[seat])
 	actionDesc := p.describePlayerAction(action, amount, stack)
 	fmt.Fprintf(p.writer, "%s: %s\n", nameLabel, actionDesc)
+
+	if p.step != nil {
+		p.step()
+	}
 }
 
 // OnStreetChange is called when the street changes
@@ -196,6 +239,7 @@ func (p *PrettyPrintMonitor) OnStreetChange(handID string, street string, cards
 		header := formatStreetHeader(street, cards)
 		fmt.Fprintln(p.writer)
 		fmt.Fprintln(p.writer, colorize(header, colorBold+colorBlue))
+		p.printEquities()
 	}
 }
 
@@ -319,6 +363,46 @@ func (p *PrettyPrintMonitor) OnHandComplete(outcome HandOutcome) {
 
 // Helper methods
 
+// printEquities prints each live player's equity when equity display is enabled.
+// Nothing is printed unless at least two live players have known hole cards.
+func (p *PrettyPrintMonitor) printEquities() {
+	if p.equitySimulations <= 0 || p.equityRNG == nil || p.currentHand == nil {
+		return
+	}
+
+	board, err := poker.HandFromStrings(p.currentHand.board)
+	if err != nil {
+		return
+	}
+
+	var live []HandPlayer
+	var hands []poker.Hand
+	for _, player := range p.currentHand.players {
+		if p.currentHand.playerFolded[player.Seat] {
+			continue
+		}
+		hand, err := poker.HandFromStrings(p.currentHand.playerHoleCards[player.Seat])
+		if err != nil || hand.CountCards() != 2 {
+			continue
+		}
+		live = append(live, player)
+		hands = append(hands, hand)
+	}
+
+	shares := analysis.CalculateMultiwayEquity(hands, board, p.equitySimulations, p.equityRNG)
+	if shares == nil {
+		return
+	}
+
+	for i, player := range live {
+		line := fmt.Sprintf("%s: %s", colorize(fallbackName(player.DisplayName, player.Seat), colorBold), formatEquity(shares[i]))
+		if board != 0 {
+			line += colorize(" ("+poker.DescribeHand(hands[i], board)+")", colorDim)
+		}
+		fmt.Fprintln(p.writer, line)
+	}
+}
+
 func (p *PrettyPrintMonitor) formatPlayerName(seat int, name string, folded, allIn bool) string {
 	display := fallbackName(name, seat)
 	var suffix string
@@ -404,6 +488,8 @@ func (p *PrettyPrintMonitor) describePlayerAction(action string, amount, stack i
 		return fmt.Sprintf("posts small blind %s", formatAmount(amount))
 	case "post_big_blind":
 		return fmt.Sprintf("posts big blind %s", formatAmount(amount))
+	case "post_dead_blind":
+		return fmt.Sprintf("posts dead blind %s", formatAmount(amount))
 	case "timeout_fold":
 		return colorize("times out and folds", colorRed)
 	case "bet":
@@ -516,6 +602,10 @@ func formatCard(card string) string {
 	return colorize(rank+emoji, colorBold+color)
 }
 
+func formatEquity(share float64) string {
+	return colorize(fmt.Sprintf("%.1f%%", share*100), colorCyan) + " equity"
+}
+
 func formatAmount(amount int) string {
 	return colorize(fmt.Sprintf("%d", amount), colorBold+colorYellow)
 }
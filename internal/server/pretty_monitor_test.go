@@ -0,0 +1,106 @@
+package server
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/lox/pokerforbots/v2/internal/randutil"
+)
+
+var ansiEscape = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+// playScriptedPrettyHand drives the monitor through a three-handed hand where
+// Carol folds preflop and Alice's aces hold against Bob's kings.
+func playScriptedPrettyHand(monitor *PrettyPrintMonitor) {
+	players := []HandPlayer{
+		{Seat: 0, Name: "alice", DisplayName: "Alice", Chips: 1000, HoleCards: []string{"As", "Ad"}},
+		{Seat: 1, Name: "bob", DisplayName: "Bob", Chips: 1000, HoleCards: []string{"Ks", "Kd"}},
+		{Seat: 2, Name: "carol", DisplayName: "Carol", Chips: 1000, HoleCards: []string{"7c", "2h"}},
+	}
+	monitor.OnGameStart(1)
+	monitor.OnHandStart("scripted", players, 0, Blinds{Small: 5, Big: 10})
+	monitor.OnPlayerAction("scripted", 1, "post_small_blind", 5, 995)
+	monitor.OnPlayerAction("scripted", 2, "post_big_blind", 10, 990)
+	monitor.OnPlayerAction("scripted", 0, "call", 10, 990)
+	monitor.OnPlayerAction("scripted", 1, "call", 5, 990)
+	monitor.OnPlayerAction("scripted", 2, "fold", 0, 990)
+	monitor.OnStreetChange("scripted", "flop", []string{"Ah", "Kh", "2c"})
+	monitor.OnStreetChange("scripted", "turn", []string{"Ah", "Kh", "2c", "3d"})
+	monitor.OnStreetChange("scripted", "river", []string{"Ah", "Kh", "2c", "3d", "9s"})
+}
+
+func TestPrettyPrintMonitorShowsEquities(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	monitor := NewPrettyPrintMonitor(&buf, WithEquityDisplay(2000, randutil.New(2129)))
+	playScriptedPrettyHand(monitor)
+
+	output := ansiEscape.ReplaceAllString(buf.String(), "")
+	preflop, postflop, ok := strings.Cut(output, "*** FLOP ***")
+	if !ok {
+		t.Fatalf("expected flop header in output:\n%s", output)
+	}
+
+	// Preflop equities cover every dealt player, including the one about to fold
+	for _, name := range []string{"Alice", "Bob", "Carol"} {
+		if !regexp.MustCompile(name + `: \d+\.\d% equity\n`).MatchString(preflop) {
+			t.Errorf("expected preflop equity for %s, got:\n%s", name, preflop)
+		}
+	}
+
+	// The river is evaluated exactly, with each player's made hand
+	for _, want := range []string{
+		"Alice: 100.0% equity (Three of a Kind, Aces)",
+		"Bob: 0.0% equity (Three of a Kind, Kings)",
+	} {
+		if !strings.Contains(postflop, want) {
+			t.Errorf("expected %q in output:\n%s", want, postflop)
+		}
+	}
+	if strings.Contains(postflop, "Carol: ") {
+		t.Errorf("folded player should not be shown postflop:\n%s", postflop)
+	}
+}
+
+func TestPrettyPrintMonitorEquitiesDisabledByDefault(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	playScriptedPrettyHand(NewPrettyPrintMonitor(&buf))
+
+	if output := ansiEscape.ReplaceAllString(buf.String(), ""); strings.Contains(output, "equity") {
+		t.Errorf("expected no equities without WithEquityDisplay, got:\n%s", output)
+	}
+}
+
+func TestPrettyPrintMonitorStepsAfterEachAction(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	var steps []string
+	monitor := NewPrettyPrintMonitor(&buf, WithStepper(func() {
+		// Record the last printed line to check the step follows its action
+		lines := strings.Split(strings.TrimSpace(ansiEscape.ReplaceAllString(buf.String(), "")), "\n")
+		steps = append(steps, lines[len(lines)-1])
+	}))
+	playScriptedPrettyHand(monitor)
+
+	want := []string{
+		"Bob (SB): posts small blind 5",
+		"Carol (BB): posts big blind 10",
+		"Alice (BTN): calls 10",
+		"Bob (SB): calls 5",
+		"Carol (BB): folds",
+	}
+	if len(steps) != len(want) {
+		t.Fatalf("expected %d steps, got %d: %q", len(want), len(steps), steps)
+	}
+	for i := range want {
+		if steps[i] != want[i] {
+			t.Errorf("step %d: expected to follow %q, got %q", i, want[i], steps[i])
+		}
+	}
+}
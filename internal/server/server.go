@@ -2,6 +2,7 @@ package server
 
 import (
 	"github.com/lox/pokerforbots/v2/internal/auth"
+	"github.com/lox/pokerforbots/v2/internal/game"
 	"github.com/lox/pokerforbots/v2/internal/randutil"
 	handhistory "github.com/lox/pokerforbots/v2/internal/server/hand_history"
 
@@ -48,13 +49,29 @@ type Config struct {
 	StartChips            int
 	Timeout               time.Duration
 	MinActionTime         time.Duration // Minimum time to wait before processing action (prevents timing tells)
+	MaxActionsPerStreet   int           // Cap on actions per street before forcing folds (0 uses default)
+	PostMissedBlinds      bool          // Bots reconnecting after hands were dealt without them post a dead small blind plus a live big blind
 	MinPlayers            int
 	MaxPlayers            int
 	Seed                  int64
-	EnableStats           bool // Collect detailed statistics
-	MaxStatsHands         int  // Maximum hands to track for stats (default 10000)
-	EnableLatencyTracking bool // Collect per-action response latency
-	AuthRequired          bool // Fail closed on auth unavailable (default: fail open)
+	EnableStats           bool          // Collect detailed statistics
+	MaxStatsHands         int           // Recent hand results kept per bot for stats (default 10000)
+	EnableLatencyTracking bool          // Collect per-action response latency
+	EnableMetrics         bool          // Expose Prometheus metrics on /metrics
+	DrainTimeout          time.Duration // Maximum time to wait for in-flight hands on shutdown
+	AuthRequired          bool          // Fail closed on auth unavailable (default: fail open)
+	ReconnectGrace        time.Duration // How long disconnected bots keep their bankroll and the game waits for them (0 ends the game immediately)
+	AutoRebuy             bool          // Top bankrolls back up to StartChips between hands so bots never play short or bust
+	RebuyThreshold        int           // With AutoRebuy, only top up bankrolls below this (0 means StartChips, i.e. every hand starts at StartChips)
+	EffectiveStackBB      int           // Cap every starting stack at this many big blinds, overriding StartChips; shorter bankrolls play what they have (0 disables)
+	HandSetup             *HandSetup    // Force stacks and cards on every hand (nil deals normally)
+	HandsPerHour          int           // Pace hand starts to this rate, like a live table (0 runs as fast as possible)
+	Variant               game.Variant  // Game played at the table (Hold'em by default)
+	Ante                  int           // Ante every player posts before the blinds (0 disables)
+	Straddle              game.Straddle // Straddle posted every hand (none by default)
+	StraddleSeat          int           // With a Mississippi straddle, the seat left of the button that posts it
+	RabbitHunt            bool          // Reveal the undealt board in hand results of hands that end before the river
+	StudyRunouts          int           // Run the remaining board this many times when betting closes before the river and log each seat's share of wins (0 disables)
 
 	// Legacy fields (deprecated - will be removed)
 	HandLimit              uint64 // Deprecated: Use spawner for hand limits
@@ -67,6 +84,7 @@ type Config struct {
 	HandHistoryFlushSecs        int
 	HandHistoryFlushHands       int
 	HandHistoryIncludeHoleCards bool
+	HandHistoryAnonymize        bool // Record players as Player1..PlayerN
 }
 
 // serverConfig holds the configuration for building a server
@@ -209,6 +227,7 @@ func NewServer(logger zerolog.Logger, rng *rand.Rand, opts ...ServerOption) *Ser
 			BigBlind:                    10,
 			StartChips:                  1000,
 			Timeout:                     100 * time.Millisecond,
+			DrainTimeout:                5 * time.Second,
 			MinPlayers:                  2,
 			MaxPlayers:                  9,
 			HandLimit:                   0,
@@ -268,6 +287,7 @@ func NewServer(logger zerolog.Logger, rng *rand.Rand, opts ...ServerOption) *Ser
 			FlushInterval:    time.Duration(flushSecs) * time.Second,
 			FlushHands:       flushHands,
 			IncludeHoleCards: cfg.config.HandHistoryIncludeHoleCards,
+			AnonymizePlayers: cfg.config.HandHistoryAnonymize,
 		})
 	}
 
@@ -351,15 +371,30 @@ func (s *Server) ensureRoutes() {
 		s.mux.HandleFunc("/games", s.handleGames)
 		s.mux.HandleFunc("/admin/games", s.handleAdminGames)
 		s.mux.HandleFunc("/admin/games/", s.handleAdminGame)
+		if s.config.EnableMetrics {
+			s.mux.HandleFunc("/metrics", s.handleMetrics)
+		}
 	})
 }
 
-// Shutdown gracefully shuts down the server
+// Shutdown gracefully shuts down the server. In-flight hands are allowed to finish
+// (bounded by Config.DrainTimeout and ctx), then every bot receives a
+// server_shutdown notice before its connection is closed.
 func (s *Server) Shutdown(ctx context.Context) error {
 	s.logger.Info().Msg("Starting graceful server shutdown")
 
-	// Stop all game pools first
-	s.manager.StopAll()
+	drainCtx := ctx
+	if s.config.DrainTimeout > 0 {
+		var cancel context.CancelFunc
+		drainCtx, cancel = context.WithTimeout(ctx, s.config.DrainTimeout)
+		defer cancel()
+	}
+
+	// Stop all game pools and let running hands complete
+	if err := s.manager.DrainAll(drainCtx); err != nil {
+		s.logger.Warn().Err(err).Msg("Timed out waiting for in-flight hands, disconnecting bots")
+	}
+	s.manager.DisconnectAll(reasonServerShutdown)
 
 	// Shutdown the HTTP server
 	if s.httpServer != nil {
@@ -597,14 +632,30 @@ func (s *Server) handleWebSocket(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// A stopped game stays listed for its stats but will never deal again
+	if game.Pool.Stopped() {
+		s.logger.Warn().
+			Str("game_id", game.ID).
+			Str("name", connectMsg.Name).
+			Msg("Connection to stopped game rejected")
+		_ = conn.Close()
+		return
+	}
+
+	if connectMsg.Role == protocol.RoleObserver {
+		game.Pool.AddObserver(conn)
+		s.logger.Debug().
+			Str("game_id", game.ID).
+			Str("name", connectMsg.Name).
+			Int("observers", game.Pool.ObserverCount()).
+			Msg("Observer connected")
+		return
+	}
+
 	// Generate deterministic bot ID based on name (or auth token in future)
-	// Use a simple hash of the name for a short, consistent ID
 	var botID string
 	if connectMsg.Name != "" {
-		// Hash the name and take first 8 hex chars for a short ID
-		h := fnv.New32a()
-		h.Write([]byte(connectMsg.Name))
-		botID = fmt.Sprintf("%08x", h.Sum32())
+		botID = botIDForName(connectMsg.Name)
 	} else {
 		// Fallback to generated ID if no name provided
 		botID = s.botIDGen()
@@ -625,6 +676,13 @@ func (s *Server) handleWebSocket(w http.ResponseWriter, r *http.Request) {
 	bot := NewBot(s.logger, botID, conn, game.Pool)
 	bot.SetDisplayName(connectMsg.Name)
 	bot.SetGameID(game.ID)
+	if seat := connectMsg.Seat; seat != nil {
+		if *seat >= 0 && *seat < game.Config.MaxPlayers {
+			bot.SetPinnedSeat(*seat)
+		} else {
+			s.logger.Warn().Str("bot_id", botID).Int("seat", *seat).Msg("Ignoring out of range seat request")
+		}
+	}
 	bot.ProtocolVersion = protocolVersion
 	bot.AuthBotID = authBotID
 	bot.OwnerID = ownerID
@@ -744,6 +802,19 @@ func (s *Server) handleAdminGames(w http.ResponseWriter, r *http.Request) {
 	config.HandHistoryFlushSecs = s.config.HandHistoryFlushSecs
 	config.HandHistoryFlushHands = s.config.HandHistoryFlushHands
 	config.HandHistoryIncludeHoleCards = s.config.HandHistoryIncludeHoleCards
+	config.HandHistoryAnonymize = s.config.HandHistoryAnonymize
+	config.EnableMetrics = s.config.EnableMetrics
+	config.PostMissedBlinds = s.config.PostMissedBlinds
+	config.AutoRebuy = s.config.AutoRebuy
+	config.RebuyThreshold = s.config.RebuyThreshold
+	config.EffectiveStackBB = s.config.EffectiveStackBB
+	config.HandsPerHour = s.config.HandsPerHour
+	config.Ante = s.config.Ante
+	config.Straddle = s.config.Straddle
+	config.StraddleSeat = s.config.StraddleSeat
+	config.Variant = s.config.Variant
+	config.RabbitHunt = s.config.RabbitHunt
+	config.StudyRunouts = s.config.StudyRunouts
 
 	if req.InfiniteBankroll != nil {
 		config.InfiniteBankroll = *req.InfiniteBankroll
@@ -795,6 +866,8 @@ func (s *Server) handleAdminGame(w http.ResponseWriter, r *http.Request) {
 	}
 
 	switch r.Method {
+	case http.MethodPost:
+		s.serveAdminGamePost(w, r, id, sub)
 	case http.MethodDelete:
 		s.serveAdminGameDelete(w, id, len(parts))
 	case http.MethodGet:
@@ -826,10 +899,72 @@ func (s *Server) serveAdminGameDelete(w http.ResponseWriter, id string, partsLen
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func (s *Server) serveAdminGamePost(w http.ResponseWriter, r *http.Request, id, sub string) {
+	if sub != "stop" && sub != "open-seat" && sub != "close-seat" {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte("endpoint not found"))
+		return
+	}
+
+	instance, ok := s.manager.GetGame(id)
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte("game not found"))
+		return
+	}
+
+	if sub != "stop" {
+		s.serveAdminGameSeats(w, instance, sub)
+		return
+	}
+
+	ctx := r.Context()
+	if s.config.DrainTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.config.DrainTimeout)
+		defer cancel()
+	}
+
+	// Let the current hand finish, then tell the table's bots the game is over
+	if err := instance.Pool.Finish(ctx, reasonAdminStopped); err != nil {
+		s.logger.Warn().Err(err).Str("game_id", id).Msg("Timed out waiting for in-flight hands of stopped game")
+	}
+	s.logger.Info().Str("game_id", id).Msg("Admin stopped game")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// adminSeatsResponse reports a game's open seats after opening or closing one.
+type adminSeatsResponse struct {
+	OpenSeats int `json:"open_seats"`
+}
+
+func (s *Server) serveAdminGameSeats(w http.ResponseWriter, instance *GameInstance, sub string) {
+	change := instance.Pool.OpenSeat
+	if sub == "close-seat" {
+		change = instance.Pool.CloseSeat
+	}
+	seats, err := change()
+	if err != nil {
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(err.Error()))
+		return
+	}
+
+	s.logger.Info().Str("game_id", instance.ID).Int("open_seats", seats).Msg("Admin changed open seats")
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(adminSeatsResponse{OpenSeats: seats}); err != nil {
+		s.logger.Error().Err(err).Msg("failed to encode seats response")
+	}
+}
+
 func (s *Server) serveAdminGameGet(w http.ResponseWriter, id, sub string) {
-	if sub == "stats" {
+	switch sub {
+	case "stats":
 		s.serveAdminGameStatsJSON(w, id)
 		return
+	case "state":
+		s.serveAdminGameStateJSON(w, id)
+		return
 	}
 	w.WriteHeader(http.StatusNotFound)
 	_, _ = w.Write([]byte("endpoint not found"))
@@ -849,6 +984,20 @@ func (s *Server) serveAdminGameStatsJSON(w http.ResponseWriter, id string) {
 	}
 }
 
+func (s *Server) serveAdminGameStateJSON(w http.ResponseWriter, id string) {
+	state, ok := s.manager.TableState(id)
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte("game not found"))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(state); err != nil {
+		s.logger.Error().Err(err).Msg("failed to encode game state response")
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
 func (s *Server) attachHandHistoryMonitor(gameID string, pool *BotPool, config Config) {
 	if s.handHistoryManager == nil || !config.EnableHandHistory {
 		return
@@ -886,3 +1035,12 @@ func WaitForHealthy(ctx context.Context, baseURL string) error {
 		}
 	}
 }
+
+// botIDForName derives a short, stable bot ID from a connect name: the first 8
+// hex characters of its FNV-1a hash. Reconnecting with the same name keeps the
+// same ID.
+func botIDForName(name string) string {
+	h := fnv.New32a()
+	h.Write([]byte(name))
+	return fmt.Sprintf("%08x", h.Sum32())
+}
@@ -1,13 +1,16 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
+	"github.com/lox/pokerforbots/v2/internal/game"
 	"github.com/lox/pokerforbots/v2/internal/randutil"
 
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -337,6 +340,66 @@ func TestAdminCreateAndDeleteGame(t *testing.T) {
 	// NPCs cleanup is now handled externally by the spawner
 }
 
+// TestAdminGameInheritsServerSettings verifies that admin-created games pick up the
+// server-wide table settings that the request does not carry.
+func TestAdminGameInheritsServerSettings(t *testing.T) {
+	t.Parallel()
+
+	serverConfig := DefaultConfig(2, 6)
+	serverConfig.HandsPerHour = 120
+	serverConfig.Ante = 5
+	serverConfig.Straddle = game.MississippiStraddle
+	serverConfig.StraddleSeat = 4
+	serverConfig.Variant = game.OmahaHiLo
+	serverConfig.RabbitHunt = true
+	serverConfig.StudyRunouts = 8
+	srv := NewServer(testLogger(), randutil.New(99), WithConfig(serverConfig))
+
+	createPayload := `{
+		"id": "inherit",
+		"small_blind": 10,
+		"big_blind": 20,
+		"start_chips": 1500,
+		"timeout_ms": 200,
+		"min_players": 2,
+		"max_players": 6
+	}`
+	req := httptest.NewRequest(http.MethodPost, "/admin/games", strings.NewReader(createPayload))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	srv.handleAdminGames(rec, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", rec.Code)
+	}
+	instance, ok := srv.manager.GetGame("inherit")
+	if !ok {
+		t.Fatal("expected game to be registered")
+	}
+	t.Cleanup(instance.Pool.Stop)
+
+	if instance.Config.HandsPerHour != serverConfig.HandsPerHour {
+		t.Errorf("HandsPerHour = %d, want %d", instance.Config.HandsPerHour, serverConfig.HandsPerHour)
+	}
+	if instance.Config.Ante != serverConfig.Ante {
+		t.Errorf("Ante = %d, want %d", instance.Config.Ante, serverConfig.Ante)
+	}
+	if instance.Config.Straddle != serverConfig.Straddle || instance.Config.StraddleSeat != serverConfig.StraddleSeat {
+		t.Errorf("Straddle = %v at seat %d, want %v at seat %d",
+			instance.Config.Straddle, instance.Config.StraddleSeat, serverConfig.Straddle, serverConfig.StraddleSeat)
+	}
+	if instance.Config.Variant != serverConfig.Variant {
+		t.Errorf("Variant = %v, want %v", instance.Config.Variant, serverConfig.Variant)
+	}
+	if !instance.Config.RabbitHunt {
+		t.Error("expected RabbitHunt to be inherited")
+	}
+	if instance.Config.StudyRunouts != serverConfig.StudyRunouts {
+		t.Errorf("StudyRunouts = %d, want %d", instance.Config.StudyRunouts, serverConfig.StudyRunouts)
+	}
+}
+
 func TestAdminGameStatsEndpoint(t *testing.T) {
 	t.Parallel()
 	srv := NewServer(testLogger(), randutil.New(7))
@@ -556,3 +619,190 @@ func TestUnlimitedHandsWithZeroLimit(t *testing.T) {
 
 	t.Logf("SUCCESS: Unlimited hands setting (handLimit=0) configured correctly")
 }
+
+// TestShutdownDrainsInFlightHand verifies that Shutdown lets the running hand finish
+// and notifies connected bots before closing their connections.
+func TestShutdownDrainsInFlightHand(t *testing.T) {
+	t.Parallel()
+
+	config := DefaultConfig(2, 2)
+	config.Timeout = 200 * time.Millisecond
+	config.DrainTimeout = 2 * time.Second
+	srv := NewServer(testLogger(), randutil.New(2119), WithConfig(config))
+	stopPool := startTestPool(t, srv.pool)
+	defer stopPool()
+
+	srv.ensureRoutes()
+	ts := httptest.NewServer(srv.mux)
+	defer ts.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(ts.URL, "http") + "/ws"
+
+	type result struct {
+		types    []string
+		closeErr error
+	}
+
+	handStarted := make(chan struct{}, 2)
+	results := make(chan result, 2)
+	for _, name := range []string{"drain-a", "drain-b"} {
+		conn := dialAndConnect(t, wsURL, name, "")
+		defer conn.Close()
+
+		// Never respond so the hand is still in progress when shutdown begins
+		go func() {
+			var res result
+			for {
+				_, data, err := conn.ReadMessage()
+				if err != nil {
+					res.closeErr = err
+					results <- res
+					return
+				}
+				var envelope protocol.ServerShutdown
+				if err := protocol.Unmarshal(data, &envelope); err != nil {
+					continue
+				}
+				res.types = append(res.types, envelope.Type)
+				if envelope.Type == protocol.TypeHandStart {
+					handStarted <- struct{}{}
+				}
+			}
+		}()
+	}
+
+	select {
+	case <-handStarted:
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for hand to start")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		t.Fatalf("shutdown failed: %v", err)
+	}
+
+	for range 2 {
+		var res result
+		select {
+		case res = <-results:
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for connection to close")
+		}
+
+		if !websocket.IsCloseError(res.closeErr, websocket.CloseGoingAway) {
+			t.Errorf("expected going-away close, got %v", res.closeErr)
+		}
+		if len(res.types) == 0 || res.types[len(res.types)-1] != protocol.TypeServerShutdown {
+			t.Fatalf("expected server_shutdown as final message, got %v", res.types)
+		}
+
+		var started, finished int
+		for _, typ := range res.types {
+			switch typ {
+			case protocol.TypeHandStart:
+				started++
+			case protocol.TypeHandResult:
+				finished++
+			}
+		}
+		if started == 0 || started != finished {
+			t.Errorf("expected every started hand to finish before shutdown, got %d starts and %d results: %v", started, finished, res.types)
+		}
+	}
+}
+
+func TestAdminGameStateEndpoint(t *testing.T) {
+	t.Parallel()
+
+	config := DefaultConfig(2, 2)
+	config.Timeout = 5 * time.Second // Long enough to inspect the hand while a bot is to act
+	pool := NewBotPool(testLogger(), randutil.New(2151), config)
+	srv := NewServer(testLogger(), randutil.New(1), WithBotPool(pool))
+	stopPool := startTestPool(t, pool)
+	defer stopPool()
+
+	ts := httptest.NewServer(http.HandlerFunc(srv.handleWebSocket))
+	defer ts.Close()
+	wsURL := "ws" + strings.TrimPrefix(ts.URL, "http") + "/ws"
+
+	type flopTurn struct {
+		name  string
+		board []string
+	}
+	onFlop := make(chan flopTurn, 2)
+	call, _ := protocol.Marshal(&protocol.Action{Type: "action", Action: "call"})
+	for _, name := range []string{"Alpha", "Beta"} {
+		conn := dialAndConnect(t, wsURL, name, "")
+		defer conn.Close()
+
+		// Call down preflop, then report the flop when first asked to act on it
+		go func() {
+			var board []string
+			for {
+				_, data, err := conn.ReadMessage()
+				if err != nil {
+					return
+				}
+				var street protocol.StreetChange
+				if err := protocol.Unmarshal(data, &street); err == nil && street.Type == protocol.TypeStreetChange {
+					board = street.Board
+					continue
+				}
+				var req protocol.ActionRequest
+				if err := protocol.Unmarshal(data, &req); err != nil || req.Type != protocol.TypeActionRequest {
+					continue
+				}
+				if board != nil {
+					onFlop <- flopTurn{name: name, board: board}
+					return
+				}
+				if err := conn.WriteMessage(websocket.BinaryMessage, call); err != nil {
+					return
+				}
+			}
+		}()
+	}
+
+	var turn flopTurn
+	select {
+	case turn = <-onFlop:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the flop")
+	}
+
+	rec := httptest.NewRecorder()
+	srv.handleAdminGame(rec, httptest.NewRequest(http.MethodGet, "/admin/games/default/state", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	if strings.Contains(rec.Body.String(), "hole") {
+		t.Fatalf("state should not include hole cards: %s", rec.Body.String())
+	}
+
+	var state TableState
+	if err := json.Unmarshal(rec.Body.Bytes(), &state); err != nil {
+		t.Fatalf("failed to decode state response: %v", err)
+	}
+	if state.GameID != "default" || len(state.Hands) != 1 {
+		t.Fatalf("expected one hand in progress for default, got %+v", state)
+	}
+
+	hand := state.Hands[0]
+	if hand.Street != "flop" || !slices.Equal(hand.Board, turn.board) {
+		t.Errorf("expected flop board %v, got %s %v", turn.board, hand.Street, hand.Board)
+	}
+	if want := 2 * config.BigBlind; hand.Pot != want {
+		t.Errorf("expected pot %d after preflop calls, got %d", want, hand.Pot)
+	}
+	if hand.ToAct < 0 || hand.ToAct >= len(hand.Players) || hand.Players[hand.ToAct].Name != turn.name {
+		t.Errorf("expected %s to act, got seat %d of %+v", turn.name, hand.ToAct, hand.Players)
+	}
+
+	notFound := httptest.NewRecorder()
+	srv.handleAdminGame(notFound, httptest.NewRequest(http.MethodGet, "/admin/games/missing/state", nil))
+	if notFound.Code != http.StatusNotFound {
+		t.Fatalf("expected 404 for missing game, got %d", notFound.Code)
+	}
+}
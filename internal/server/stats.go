@@ -2,16 +2,25 @@ package server
 
 import (
 	"fmt"
+	"maps"
 	"math"
+	"slices"
 	"sort"
 	"sync"
 	"time"
 
+	"github.com/lox/pokerforbots/v2/poker"
 	"github.com/lox/pokerforbots/v2/protocol"
 )
 
 const responseReservoirSize = 128
 
+// statsStreets lists streets in the order a hand progresses through them.
+var statsStreets = []string{"preflop", "flop", "turn", "river", "showdown"}
+
+// lowShowdownClass is the hand class recorded for showdowns won only with a hi-lo low.
+const lowShowdownClass = "Low"
+
 // ResponseOutcome categorizes how an action request completed from the server's perspective.
 type ResponseOutcome int
 
@@ -28,11 +37,18 @@ type BotStatistics struct {
 	hands           int
 	sumBB           float64
 	sumBB2          float64   // Sum of squares for variance
-	values          []float64 // All BB results for median/percentile
+	values          []float64 // BB results of the most recent hands for median/percentile
+	valueIndex      int       // Oldest result once values holds window results
+	window          int       // Recent results kept in values (0 keeps every hand)
 	winningHands    int
 	showdownWins    int
 	nonShowdownWins int
 	showdownLosses  int
+	showdowns       int
+	furthestStreets map[string]int // Hands by furthest street reached without folding
+	showdownHands   map[string]int // Showdowns won by winning hand class
+	categories      map[string]*categoryResult
+	positions       map[int]*positionResult // Results by button distance
 	showdownBB      float64
 	nonShowdownBB   float64
 	vpipHands       int // Number of hands where player voluntarily put money in pot
@@ -64,6 +80,15 @@ func NewBotStatistics(bigBlind int) *BotStatistics {
 	}
 }
 
+// NewWindowedBotStatistics creates a BotStatistics that keeps only the last
+// window hand results for the median, while hands, net and variance still
+// cover every hand. Memory stays bounded however long the session runs.
+func NewWindowedBotStatistics(bigBlind, window int) *BotStatistics {
+	b := NewBotStatistics(bigBlind)
+	b.window = window
+	return b
+}
+
 // AddResult incorporates a new hand result
 func (b *BotStatistics) AddResult(netBB float64, wentToShowdown, wonAtShowdown bool) {
 	b.mu.Lock()
@@ -72,7 +97,12 @@ func (b *BotStatistics) AddResult(netBB float64, wentToShowdown, wonAtShowdown b
 	b.hands++
 	b.sumBB += netBB
 	b.sumBB2 += netBB * netBB
-	b.values = append(b.values, netBB)
+	if b.window <= 0 || len(b.values) < b.window {
+		b.values = append(b.values, netBB)
+	} else {
+		b.values[b.valueIndex] = netBB
+		b.valueIndex = (b.valueIndex + 1) % b.window
+	}
 
 	// Track wins/losses
 	if netBB > 0 {
@@ -88,12 +118,102 @@ func (b *BotStatistics) AddResult(netBB float64, wentToShowdown, wonAtShowdown b
 
 	// Track showdown vs non-showdown BB
 	if wentToShowdown {
+		b.showdowns++
 		b.showdownBB += netBB
 	} else {
 		b.nonShowdownBB += netBB
 	}
 }
 
+// RecordStreetReached counts a hand by the furthest street the player reached
+// without folding. Unknown street names are ignored.
+func (b *BotStatistics) RecordStreetReached(street string) {
+	if !slices.Contains(statsStreets, street) {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.furthestStreets == nil {
+		b.furthestStreets = make(map[string]int, len(statsStreets))
+	}
+	b.furthestStreets[street]++
+}
+
+// RecordShowdownWin counts a showdown won with the given hand class.
+func (b *BotStatistics) RecordShowdownWin(handClass string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.showdownHands == nil {
+		b.showdownHands = make(map[string]int)
+	}
+	b.showdownHands[handClass]++
+}
+
+// categoryResult accumulates results for one hole card category.
+type categoryResult struct {
+	hands int
+	netBB float64
+}
+
+// RecordCategoryResult attributes a hand result to the player's hole card category.
+func (b *BotStatistics) RecordCategoryResult(category string, netBB float64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.categories == nil {
+		b.categories = make(map[string]*categoryResult)
+	}
+	result := b.categories[category]
+	if result == nil {
+		result = &categoryResult{}
+		b.categories[category] = result
+	}
+	result.hands++
+	result.netBB += netBB
+}
+
+// positionResult accumulates results for one button distance.
+type positionResult struct {
+	hands  int
+	sumBB  float64
+	sumBB2 float64
+}
+
+// RecordPositionResult attributes a hand result to the player's distance from the button.
+func (b *BotStatistics) RecordPositionResult(buttonDistance int, netBB float64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.positions == nil {
+		b.positions = make(map[int]*positionResult)
+	}
+	result := b.positions[buttonDistance]
+	if result == nil {
+		result = &positionResult{}
+		b.positions[buttonDistance] = result
+	}
+	result.hands++
+	result.sumBB += netBB
+	result.sumBB2 += netBB * netBB
+}
+
+// ButtonDistanceCI95 returns the 95% confidence interval of the win rate in BB/100
+// at the given distance from the button. An interval that excludes zero means the
+// position is significantly winning or losing. Returns zeros until the position
+// has 30 hands, the same threshold as the overall interval.
+func (b *BotStatistics) ButtonDistanceCI95(dist int) (low, high float64) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	result := b.positions[dist]
+	if result == nil || result.hands < 30 {
+		return 0, 0
+	}
+	n := float64(result.hands)
+	mean := result.sumBB / n
+	variance := max((result.sumBB2-n*mean*mean)/(n-1), 0)
+	margin := 1.96 * math.Sqrt(variance/n)
+	return (mean - margin) * 100, (mean + margin) * 100
+}
+
 // RecordHandStart increments the count of hands where the player could act preflop
 // Must be called without holding the lock
 func (b *BotStatistics) RecordHandStart() {
@@ -217,6 +337,24 @@ func (b *BotStatistics) ToProtocolStats() *protocol.PlayerDetailedStats {
 		result.CI95High = (mean + margin) * 100
 	}
 
+	result.ShowdownRate = float64(b.showdowns) / float64(b.hands)
+	result.StreetReached = b.streetReachedLocked()
+
+	if len(b.categories) > 0 {
+		result.HandCategoryStats = make(map[string]protocol.CategoryStatSummary, len(b.categories))
+		for category, cr := range b.categories {
+			result.HandCategoryStats[category] = protocol.CategoryStatSummary{
+				Hands:     cr.hands,
+				NetBB:     cr.netBB,
+				BBPerHand: cr.netBB / float64(cr.hands),
+			}
+		}
+	}
+
+	if len(b.showdownHands) > 0 {
+		result.ShowdownWinsByHand = maps.Clone(b.showdownHands)
+	}
+
 	// Calculate showdown win rate
 	showdownsTotal := b.showdownWins + b.showdownLosses
 	if showdownsTotal > 0 {
@@ -251,6 +389,33 @@ func (b *BotStatistics) ToProtocolStats() *protocol.PlayerDetailedStats {
 	return result
 }
 
+// streetReachedLocked returns the fraction of hands that reached each street or
+// later. Returns nil if no streets were recorded. Caller must hold the lock.
+func (b *BotStatistics) streetReachedLocked() map[string]float64 {
+	if len(b.furthestStreets) == 0 {
+		return nil
+	}
+	total := 0
+	for _, count := range b.furthestStreets {
+		total += count
+	}
+	reached := make(map[string]float64, len(statsStreets))
+	remaining := total
+	for _, street := range statsStreets {
+		reached[street] = float64(remaining) / float64(total)
+		remaining -= b.furthestStreets[street]
+	}
+	return reached
+}
+
+// RecentResults returns the BB results still held for the median, oldest
+// first: every hand, or the last window hands for windowed statistics.
+func (b *BotStatistics) RecentResults() []float64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return append(slices.Clone(b.values[b.valueIndex:]), b.values[:b.valueIndex]...)
+}
+
 // Hands returns the total number of hands
 func (b *BotStatistics) Hands() int {
 	b.mu.RLock()
@@ -368,31 +533,35 @@ type BasicBotStats struct {
 }
 
 // StatsMonitor collects both basic and detailed statistics and satisfies HandMonitor and StatsProvider.
+// It is safe for concurrent use by hands running on several tables at once.
 type StatsMonitor struct {
 	mu             sync.RWMutex
 	basicStats     map[string]*BasicBotStats
 	detailedStats  map[string]*BotStatistics
 	enableDetailed bool
 	bigBlind       int
-	maxHands       int
-	currentHands   int
-	currentStreet  string          // Track current street for VPIP/PFR
-	seatToBotID    map[int]string  // Map seat to bot ID for current hand
-	handStarted    map[string]bool // Track if bot has started the hand (for VPIP/PFR denominator)
-	seatBets       map[int]int     // Track current bet per seat to distinguish raise vs call all-ins
-	highestBet     int             // Track highest bet in current betting round
+	maxHands       int                   // Recent hand results kept per bot for the median (0 keeps all)
+	hands          map[string]*statsHand // Hands in progress by hand ID
 }
 
-// NewStatsMonitor creates a new statistics monitor.
+// statsHand tracks the betting of one hand in progress for VPIP/PFR, kept per
+// hand so concurrent hands don't overwrite each other's state.
+type statsHand struct {
+	street      string         // Current street
+	seatToBotID map[int]string // Bot ID seated at each seat
+	seatBets    map[int]int    // Current bet per seat to distinguish raise vs call all-ins
+	highestBet  int            // Highest bet in the current betting round
+}
+
+// NewStatsMonitor creates a new statistics monitor. Totals cover every hand of
+// the session while each bot keeps only its last maxHands results in memory.
 func NewStatsMonitor(bigBlind int, enableDetailed bool, maxHands int) *StatsMonitor {
 	monitor := &StatsMonitor{
 		basicStats:     make(map[string]*BasicBotStats),
 		enableDetailed: enableDetailed,
 		bigBlind:       bigBlind,
 		maxHands:       maxHands,
-		seatToBotID:    make(map[int]string),
-		handStarted:    make(map[string]bool),
-		seatBets:       make(map[int]int),
+		hands:          make(map[string]*statsHand),
 	}
 	if enableDetailed {
 		monitor.detailedStats = make(map[string]*BotStatistics)
@@ -411,24 +580,24 @@ func (s *StatsMonitor) OnHandStart(handID string, players []HandPlayer, button i
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	// Reset hand-specific tracking
-	s.currentStreet = "preflop"
-	s.seatToBotID = make(map[int]string)
-	s.handStarted = make(map[string]bool)
-	s.seatBets = make(map[int]int)
 	// Initialize highestBet to big blind since blinds are already posted
-	s.highestBet = blinds.Big
+	hand := &statsHand{
+		street:      "preflop",
+		seatToBotID: make(map[int]string, len(players)),
+		seatBets:    make(map[int]int, len(players)),
+		highestBet:  blinds.Big,
+	}
+	s.hands[handID] = hand
 
 	// Map seats to bot IDs and track hand starts for VPIP/PFR calculation
 	for _, player := range players {
 		botID := player.Name
-		s.seatToBotID[player.Seat] = botID
-		s.handStarted[botID] = true
+		hand.seatToBotID[player.Seat] = botID
 
 		// Track that this bot has started a hand (for VPIP/PFR denominator)
 		if s.enableDetailed {
 			if s.detailedStats[botID] == nil {
-				s.detailedStats[botID] = NewBotStatistics(s.bigBlind)
+				s.detailedStats[botID] = NewWindowedBotStatistics(s.bigBlind, s.maxHands)
 			}
 			s.detailedStats[botID].RecordHandStart()
 		}
@@ -445,12 +614,13 @@ func (s *StatsMonitor) OnPlayerAction(handID string, seat int, action string, am
 	defer s.mu.Unlock()
 
 	// Only track preflop actions for VPIP/PFR
-	if s.currentStreet != "preflop" {
+	hand := s.hands[handID]
+	if hand == nil || hand.street != "preflop" {
 		return
 	}
 
 	// Get bot ID from seat
-	botID, ok := s.seatToBotID[seat]
+	botID, ok := hand.seatToBotID[seat]
 	if !ok {
 		return
 	}
@@ -458,16 +628,21 @@ func (s *StatsMonitor) OnPlayerAction(handID string, seat int, action string, am
 	// Get or create detailed stats for this bot
 	detailed := s.detailedStats[botID]
 	if detailed == nil {
-		detailed = NewBotStatistics(s.bigBlind)
+		detailed = NewWindowedBotStatistics(s.bigBlind, s.maxHands)
 		s.detailedStats[botID] = detailed
 	}
 
+	// Dead blinds go straight to the pot and don't count toward the seat's bet
+	if action == "post_dead_blind" {
+		return
+	}
+
 	// Handle blind posting to track initial bets
 	if action == "post_small_blind" || action == "post_big_blind" {
 		// Track the blind amounts
-		s.seatBets[seat] = amount
+		hand.seatBets[seat] = amount
 		if action == "post_big_blind" {
-			s.highestBet = amount
+			hand.highestBet = amount
 		}
 		return
 	}
@@ -475,9 +650,9 @@ func (s *StatsMonitor) OnPlayerAction(handID string, seat int, action string, am
 	// Track preflop action (excludes posting blinds)
 	// amount is the delta (additional chips put in), not the total bet
 	// We need to track cumulative contributions
-	currentBet := s.seatBets[seat]
+	currentBet := hand.seatBets[seat]
 	newTotalBet := currentBet + amount
-	s.seatBets[seat] = newTotalBet
+	hand.seatBets[seat] = newTotalBet
 
 	// Determine if this action increases the bet (for PFR tracking)
 	isRaise := false
@@ -486,19 +661,19 @@ func (s *StatsMonitor) OnPlayerAction(handID string, seat int, action string, am
 	case "bet":
 		// Bet is always an aggressive action (opening the betting)
 		isRaise = true
-		s.highestBet = newTotalBet
+		hand.highestBet = newTotalBet
 	case "raise":
 		// Raise is always aggressive
 		isRaise = true
-		s.highestBet = newTotalBet
+		hand.highestBet = newTotalBet
 	case "call":
 		// Call matches the current bet - no need to update highestBet
 		// The newTotalBet should equal highestBet for a true call
 	case "allin":
 		// All-in could be a call or raise depending on total amount
-		if newTotalBet > s.highestBet {
+		if newTotalBet > hand.highestBet {
 			isRaise = true
-			s.highestBet = newTotalBet
+			hand.highestBet = newTotalBet
 		}
 	}
 
@@ -509,23 +684,24 @@ func (s *StatsMonitor) OnPlayerAction(handID string, seat int, action string, am
 func (s *StatsMonitor) OnStreetChange(handID string, street string, cards []string) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	s.currentStreet = street
+	hand := s.hands[handID]
+	if hand == nil {
+		return
+	}
+	hand.street = street
 	// Reset bet tracking for new betting round
-	s.seatBets = make(map[int]int)
-	s.highestBet = 0
+	clear(hand.seatBets)
+	hand.highestBet = 0
 }
 
 // OnHandComplete records the provided outcome and updates aggregates.
 func (s *StatsMonitor) OnHandComplete(outcome HandOutcome) {
-	if outcome.Detail == nil {
-		return
-	}
-
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if s.maxHands > 0 && s.currentHands >= s.maxHands {
-		s.resetLocked()
+	delete(s.hands, outcome.HandID)
+	if outcome.Detail == nil {
+		return
 	}
 
 	now := time.Now()
@@ -580,15 +756,24 @@ func (s *StatsMonitor) OnHandComplete(outcome HandOutcome) {
 			botID := botOutcome.Bot.ID
 			detailed := s.detailedStats[botID]
 			if detailed == nil {
-				detailed = NewBotStatistics(s.bigBlind)
+				detailed = NewWindowedBotStatistics(s.bigBlind, s.maxHands)
 				s.detailedStats[botID] = detailed
 			}
 			netBB := float64(botOutcome.NetChips) / float64(s.bigBlind)
 			detailed.AddResult(netBB, botOutcome.WentToShowdown, botOutcome.WonAtShowdown)
+			detailed.RecordStreetReached(botOutcome.StreetReached)
+			detailed.RecordPositionResult(botOutcome.ButtonDistance, netBB)
+			if len(botOutcome.HoleCards) == 2 {
+				detailed.RecordCategoryResult(poker.CategorizeHoleCardsFromStrings(botOutcome.HoleCards), netBB)
+			}
+			if botOutcome.WonLowOnly {
+				// The high hand class didn't win anything, so file it under the low
+				detailed.RecordShowdownWin(lowShowdownClass)
+			} else if botOutcome.WonAtShowdown && botOutcome.ShowdownHand != "" {
+				detailed.RecordShowdownWin(botOutcome.ShowdownHand)
+			}
 		}
 	}
-
-	s.currentHands++
 }
 
 // GetPlayerStats returns a deterministic snapshot of player statistics.
@@ -683,12 +868,7 @@ func (s *StatsMonitor) GetDetailedStats(botID string) *protocol.PlayerDetailedSt
 func (s *StatsMonitor) Reset() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	s.resetLocked()
-}
-
-func (s *StatsMonitor) resetLocked() {
 	s.basicStats = make(map[string]*BasicBotStats)
-	s.currentHands = 0
 	if s.enableDetailed {
 		s.detailedStats = make(map[string]*BotStatistics)
 	}
@@ -705,7 +885,7 @@ func (s *StatsMonitor) RecordResponse(botID string, duration time.Duration, outc
 
 	detailed := s.detailedStats[botID]
 	if detailed == nil {
-		detailed = NewBotStatistics(s.bigBlind)
+		detailed = NewWindowedBotStatistics(s.bigBlind, s.maxHands)
 		s.detailedStats[botID] = detailed
 	}
 	detailed.RecordResponse(duration, outcome)
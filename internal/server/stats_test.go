@@ -1,9 +1,17 @@
 package server
 
 import (
+	"fmt"
+	"maps"
 	"math"
+	"slices"
+	"sync"
 	"testing"
 	"time"
+
+	"github.com/lox/pokerforbots/v2/internal/game"
+	"github.com/lox/pokerforbots/v2/internal/randutil"
+	"github.com/lox/pokerforbots/v2/poker"
 )
 
 func TestStatsMonitorBasicTracking(t *testing.T) {
@@ -123,8 +131,8 @@ func TestStatsMonitorDetailedStats(t *testing.T) {
 	}
 }
 
-func TestStatsMonitorResetsAtLimit(t *testing.T) {
-	monitor := NewStatsMonitor(10, false, 2)
+func TestStatsMonitorKeepsRecentHandsPastLimit(t *testing.T) {
+	monitor := NewStatsMonitor(10, true, 3)
 
 	bot := &Bot{ID: "bot1", done: make(chan struct{})}
 
@@ -143,9 +151,10 @@ func TestStatsMonitorResetsAtLimit(t *testing.T) {
 		monitor.OnHandComplete(outcome)
 	}
 
-	record(10) // hand 1
-	record(20) // hand 2
-	record(-5) // triggers reset and records as first hand
+	// Twice the window, so the ring wraps past its start
+	for _, delta := range []int{10, 20, -5, 40, -30, 60} {
+		record(delta)
+	}
 
 	players := monitor.GetPlayerStats()
 	if len(players) != 1 {
@@ -153,11 +162,22 @@ func TestStatsMonitorResetsAtLimit(t *testing.T) {
 	}
 
 	ps := players[0]
-	if ps.Hands != 1 {
-		t.Errorf("expected hands reset to 1, got %d", ps.Hands)
+	if ps.Hands != 6 {
+		t.Errorf("expected 6 hands, got %d", ps.Hands)
+	}
+	if ps.NetChips != 95 {
+		t.Errorf("expected net chips 95, got %d", ps.NetChips)
+	}
+
+	detailed := monitor.detailedStats["bot1"]
+	if got := detailed.Hands(); got != 6 {
+		t.Errorf("expected 6 detailed hands, got %d", got)
+	}
+	if got, want := detailed.RecentResults(), []float64{4, -3, 6}; !slices.Equal(got, want) {
+		t.Errorf("recent results = %v, want %v", got, want)
 	}
-	if ps.NetChips != -5 {
-		t.Errorf("expected net chips -5 after reset, got %d", ps.NetChips)
+	if got := monitor.GetDetailedStats("bot1").BB100; math.Abs(got-158.33) > 0.01 {
+		t.Errorf("expected BB/100 over every hand to be 158.33, got %.2f", got)
 	}
 }
 
@@ -201,3 +221,251 @@ func TestBotStatisticsRecordResponse(t *testing.T) {
 		t.Errorf("expected stddev ~62.36 ms, got %.2f", proto.ResponseStdMs)
 	}
 }
+
+func TestStatsMonitorStreetReachedBreakdown(t *testing.T) {
+	monitor := NewStatsMonitor(10, true, 0)
+
+	hero := &Bot{ID: "hero", done: make(chan struct{})}
+	villain := &Bot{ID: "villain", done: make(chan struct{})}
+
+	// Hero folds preflop, folds on the flop, wins uncontested on the turn and
+	// goes to showdown once; villain sees every street of every hand.
+	script := []struct {
+		heroStreet    string
+		villainStreet string
+		showdown      bool
+	}{
+		{"preflop", "preflop", false},
+		{"flop", "flop", false},
+		{"turn", "turn", false},
+		{"showdown", "showdown", true},
+	}
+	for i, hand := range script {
+		monitor.OnHandComplete(HandOutcome{
+			HandID: fmt.Sprintf("hand-%d", i),
+			Detail: &HandOutcomeDetail{
+				BotOutcomes: []BotHandOutcome{
+					{Bot: hero, Position: 0, StreetReached: hand.heroStreet, WentToShowdown: hand.showdown},
+					{Bot: villain, Position: 1, StreetReached: hand.villainStreet, WentToShowdown: hand.showdown},
+				},
+			},
+		})
+	}
+
+	stats := monitor.GetDetailedStats("hero")
+	if stats == nil {
+		t.Fatal("expected detailed stats for hero")
+	}
+	expected := map[string]float64{
+		"preflop":  1,
+		"flop":     0.75,
+		"turn":     0.5,
+		"river":    0.25,
+		"showdown": 0.25,
+	}
+	if len(stats.StreetReached) != len(expected) {
+		t.Fatalf("expected %d streets, got %v", len(expected), stats.StreetReached)
+	}
+	for street, want := range expected {
+		if got := stats.StreetReached[street]; math.Abs(got-want) > 1e-9 {
+			t.Errorf("street %s: expected %.2f reached, got %.2f", street, want, got)
+		}
+	}
+	if stats.ShowdownRate != 0.25 {
+		t.Errorf("expected showdown rate 0.25, got %.2f", stats.ShowdownRate)
+	}
+}
+
+func TestBotStatisticsButtonDistanceCI95(t *testing.T) {
+	stats := NewBotStatistics(10)
+
+	// The button alternates +3/-1 BB (mean +1 BB/hand, std dev ~2), the big
+	// blind alternates +1/-3 BB (mean -1 BB/hand) and the cutoff breaks even.
+	for i := range 400 {
+		button, bigBlind, cutoff := 3.0, 1.0, 2.0
+		if i%2 == 1 {
+			button, bigBlind, cutoff = -1, -3, -2
+		}
+		stats.RecordPositionResult(0, button)
+		stats.RecordPositionResult(2, bigBlind)
+		stats.RecordPositionResult(5, cutoff)
+	}
+	for i := range 10 {
+		stats.RecordPositionResult(3, float64(i))
+	}
+
+	low, high := stats.ButtonDistanceCI95(0)
+	if low <= 0 || low >= 100 || high <= 100 {
+		t.Errorf("button: expected an interval above zero containing 100 BB/100, got [%.1f, %.1f]", low, high)
+	}
+	// Standard error is 2/sqrt(400) = 0.1 BB/hand, so the margin is ~19.6 BB/100
+	if width := high - low; math.Abs(width-2*19.6) > 0.5 {
+		t.Errorf("button: expected interval width ~39.2 BB/100, got %.1f", width)
+	}
+
+	low, high = stats.ButtonDistanceCI95(2)
+	if high >= 0 || low >= -100 || high <= -100 {
+		t.Errorf("big blind: expected an interval below zero containing -100 BB/100, got [%.1f, %.1f]", low, high)
+	}
+
+	low, high = stats.ButtonDistanceCI95(5)
+	if low >= 0 || high <= 0 {
+		t.Errorf("cutoff: expected an interval spanning zero, got [%.1f, %.1f]", low, high)
+	}
+
+	for _, dist := range []int{3, 4} {
+		if low, high := stats.ButtonDistanceCI95(dist); low != 0 || high != 0 {
+			t.Errorf("distance %d: expected no interval with too few hands, got [%.1f, %.1f]", dist, low, high)
+		}
+	}
+}
+
+func TestStatsMonitorShowdownWinsByHand(t *testing.T) {
+	tests := []struct {
+		name    string
+		variant game.Variant
+		cards   []string // Hole cards dealt big blind first, then the board
+		alice   map[string]int
+		bob     map[string]int
+	}{
+		{
+			// Alice makes two pair, Bob has seven high
+			name:  "hold'em",
+			cards: []string{"7c", "As", "2d", "Ks", "Ah", "Kd", "9s", "4c", "3h"},
+			alice: map[string]int{"Two Pair": 1},
+		},
+		{
+			// Alice scoops the high with trip kings, Bob takes the low with a pair of nines
+			name:    "hi-lo low only",
+			variant: game.OmahaHiLo,
+			cards:   []string{"Ac", "Kd", "3s", "Kh", "9d", "Js", "9h", "Tc", "2c", "5d", "8h", "Ks", "Qd"},
+			alice:   map[string]int{"Three of a Kind": 1},
+			bob:     map[string]int{"Low": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cards []poker.Card
+			for _, s := range tt.cards {
+				card, err := poker.ParseCard(s)
+				if err != nil {
+					t.Fatalf("failed to parse %s: %v", s, err)
+				}
+				cards = append(cards, card)
+			}
+
+			// Heads-up with the button in seat 0, so the big blind is dealt first
+			bots := []*Bot{
+				{ID: "alice", send: make(chan []byte, 100)},
+				{ID: "bob", send: make(chan []byte, 100)},
+			}
+			runner := NewHandRunner(testLogger(), bots, "showdown-classes", 0, randutil.New(2196))
+			runner.handState = game.NewHandState(randutil.New(1), []string{"alice", "bob"}, 0, 5, 10,
+				game.WithChips(1000), game.WithDeck(poker.NewStackedDeck(cards...)), game.WithVariant(tt.variant))
+			runner.seatBuyIns = []int{1000, 1000}
+
+			runner.processAction(0, game.Call, 0)
+			runner.processAction(1, game.Check, 0)
+			winners := runner.resolveHand()
+
+			monitor := NewStatsMonitor(10, true, 0)
+			monitor.OnHandComplete(HandOutcome{HandID: "showdown-classes", Detail: runner.buildDetailedOutcome(winners)})
+
+			for id, want := range map[string]map[string]int{"alice": tt.alice, "bob": tt.bob} {
+				stats := monitor.GetDetailedStats(id)
+				if stats == nil {
+					t.Fatalf("expected detailed stats for %s", id)
+				}
+				if !maps.Equal(stats.ShowdownWinsByHand, want) {
+					t.Errorf("%s showdown wins by hand = %v, want %v", id, stats.ShowdownWinsByHand, want)
+				}
+			}
+		})
+	}
+}
+
+// statsHandEvents returns the monitor events of a heads-up hand in which the
+// raiser raises preflop and the folder folds.
+func statsHandEvents(monitor *StatsMonitor, handID string, raiser, folder *Bot, raiserSeat int) []func() {
+	folderSeat := 1 - raiserSeat
+	events := []func(){
+		func() {
+			monitor.OnHandStart(handID, []HandPlayer{
+				{Seat: raiserSeat, Name: raiser.ID, Chips: 1000},
+				{Seat: folderSeat, Name: folder.ID, Chips: 1000},
+			}, 0, Blinds{Small: 5, Big: 10})
+		},
+		func() { monitor.OnPlayerAction(handID, 0, "post_small_blind", 5, 995) },
+		func() { monitor.OnPlayerAction(handID, 1, "post_big_blind", 10, 990) },
+	}
+	if raiserSeat == 0 {
+		events = append(events,
+			func() { monitor.OnPlayerAction(handID, 0, "raise", 25, 970) },
+			func() { monitor.OnPlayerAction(handID, 1, "fold", 0, 990) })
+	} else {
+		events = append(events,
+			func() { monitor.OnPlayerAction(handID, 0, "fold", 0, 995) },
+			func() { monitor.OnPlayerAction(handID, 1, "raise", 20, 970) })
+	}
+	return append(events, func() {
+		monitor.OnHandComplete(HandOutcome{
+			HandID: handID,
+			Detail: &HandOutcomeDetail{
+				HandID: handID,
+				BotOutcomes: []BotHandOutcome{
+					{Bot: raiser, Position: raiserSeat, NetChips: 10, StreetReached: "preflop"},
+					{Bot: folder, Position: folderSeat, NetChips: -10, StreetReached: "preflop"},
+				},
+			},
+		})
+	})
+}
+
+func TestStatsMonitorConcurrentHands(t *testing.T) {
+	monitor := NewStatsMonitor(10, true, 0)
+	raiser := &Bot{ID: "raiser", done: make(chan struct{})}
+	folder := &Bot{ID: "folder", done: make(chan struct{})}
+
+	// Each goroutine runs two tables whose events interleave step by step, with
+	// the players seated the opposite way round at each
+	const goroutines, rounds = 16, 100
+	var wg sync.WaitGroup
+	for g := range goroutines {
+		wg.Go(func() {
+			for round := range rounds {
+				first := statsHandEvents(monitor, fmt.Sprintf("g%d-r%d-a", g, round), raiser, folder, 0)
+				second := statsHandEvents(monitor, fmt.Sprintf("g%d-r%d-b", g, round), raiser, folder, 1)
+				for i := range first {
+					first[i]()
+					second[i]()
+				}
+			}
+		})
+	}
+	wg.Wait()
+
+	const hands = goroutines * rounds * 2
+	for _, tt := range []struct {
+		bot       *Bot
+		netBB     float64
+		vpip, pfr float64
+	}{
+		{bot: raiser, netBB: hands, vpip: 100, pfr: 100},
+		{bot: folder, netBB: -hands, vpip: 0, pfr: 0},
+	} {
+		stats := monitor.GetDetailedStats(tt.bot.ID)
+		if stats == nil {
+			t.Fatalf("expected detailed stats for %s", tt.bot.ID)
+		}
+		if stats.Hands != hands || stats.NetBB != tt.netBB {
+			t.Errorf("%s: expected %d hands and %.0f BB, got %d hands and %.2f BB", tt.bot.ID, hands, tt.netBB, stats.Hands, stats.NetBB)
+		}
+		if stats.VPIP != tt.vpip || stats.PFR != tt.pfr {
+			t.Errorf("%s: expected VPIP/PFR %.0f/%.0f, got %.2f/%.2f", tt.bot.ID, tt.vpip, tt.pfr, stats.VPIP, stats.PFR)
+		}
+	}
+	if len(monitor.hands) != 0 {
+		t.Errorf("expected no hands left in progress, got %d", len(monitor.hands))
+	}
+}
@@ -0,0 +1,72 @@
+package server
+
+import (
+	"slices"
+	"sync"
+)
+
+// TableState is the public state of a game's hands in progress, for clients that
+// poll over HTTP instead of watching over a websocket.
+type TableState struct {
+	GameID string      `json:"game_id"`
+	Hands  []TableHand `json:"hands"` // Hands in progress, oldest first
+}
+
+// TableHand is the public view of a hand in progress. Hole cards are never included.
+type TableHand struct {
+	HandID  string             `json:"hand_id"`
+	Street  string             `json:"street"`
+	Board   []string           `json:"board"`
+	Pot     int                `json:"pot"`
+	Button  int                `json:"button"`
+	ToAct   int                `json:"to_act"` // Seat of the player to act, -1 if nobody
+	Players []TableStatePlayer `json:"players"`
+}
+
+// TableStatePlayer is a seated player's public state.
+type TableStatePlayer struct {
+	Seat   int    `json:"seat"`
+	Name   string `json:"name"`
+	Chips  int    `json:"chips"`
+	Bet    int    `json:"bet"`
+	Folded bool   `json:"folded"`
+	AllIn  bool   `json:"all_in"`
+}
+
+// tableHands tracks the public state of the hands a pool is running.
+type tableHands struct {
+	mu    sync.RWMutex
+	hands []TableHand
+}
+
+// update replaces the state of state.HandID, adding it if the hand is new.
+func (h *tableHands) update(state TableHand) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	idx := slices.IndexFunc(h.hands, func(existing TableHand) bool {
+		return existing.HandID == state.HandID
+	})
+	if idx < 0 {
+		h.hands = append(h.hands, state)
+		return
+	}
+	h.hands[idx] = state
+}
+
+// remove forgets a finished hand.
+func (h *tableHands) remove(handID string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.hands = slices.DeleteFunc(h.hands, func(existing TableHand) bool {
+		return existing.HandID == handID
+	})
+}
+
+// snapshot returns the hands in progress, oldest first.
+func (h *tableHands) snapshot() []TableHand {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	hands := make([]TableHand, len(h.hands))
+	copy(hands, h.hands)
+	return hands
+}
@@ -0,0 +1,53 @@
+package poker
+
+import "math/bits"
+
+// BoardSuitCounts returns how many board cards there are of each suit, indexed
+// by the suit constants.
+func BoardSuitCounts(board Hand) [4]int {
+	var counts [4]int
+	for suit := range uint8(4) {
+		counts[suit] = bits.OnesCount16(board.GetSuitMask(suit))
+	}
+	return counts
+}
+
+// BoardHasFlushPossible reports whether a player could hold a flush, which
+// takes three board cards of one suit.
+func BoardHasFlushPossible(board Hand) bool {
+	for _, count := range BoardSuitCounts(board) {
+		if count >= 3 {
+			return true
+		}
+	}
+	return false
+}
+
+// BoardHasStraightPossible reports whether a player could hold a straight,
+// which takes three board ranks within a five-rank window, counting the ace
+// as low for the wheel.
+func BoardHasStraightPossible(board Hand) bool {
+	// GetRankMask repeats the ace above it, so shift up a bit to put a copy of
+	// the ace below the deuce as well
+	ranks := board.GetRankMask()
+	ranks = ranks<<1 | ranks>>Ace&1
+	for low := range 10 {
+		if bits.OnesCount16(ranks>>low&0x1F) >= 3 {
+			return true
+		}
+	}
+	return false
+}
+
+// BoardIsPaired reports whether two or more board cards share a rank.
+func BoardIsPaired(board Hand) bool {
+	var seen uint16
+	for suit := range uint8(4) {
+		mask := board.GetSuitMask(suit)
+		if seen&mask != 0 {
+			return true
+		}
+		seen |= mask
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+package poker
+
+import "testing"
+
+func TestBoardPredicates(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		board    string
+		suits    [4]int // Clubs, diamonds, hearts, spades
+		flush    bool
+		straight bool
+		paired   bool
+	}{
+		{"monotone flop", "Ah 8h 3h", [4]int{0, 0, 3, 0}, true, false, false},
+		{"two-tone flop", "Kd 9d 2c", [4]int{1, 2, 0, 0}, false, false, false},
+		{"two-tone turn with three of a suit", "Kd 9d 2c 4d", [4]int{1, 3, 0, 0}, true, false, false},
+		{"connected flop", "9c 8d 7s", [4]int{1, 1, 0, 1}, false, true, false},
+		{"gapped straight", "Jc 9d 7s", [4]int{1, 1, 0, 1}, false, true, false},
+		{"too spread for a straight", "Qc 9d 6s", [4]int{1, 1, 0, 1}, false, false, false},
+		{"wheel draw", "Ac 4d 3s", [4]int{1, 1, 0, 1}, false, true, false},
+		{"broadway", "Ac Kd Ts", [4]int{1, 1, 0, 1}, false, true, false},
+		{"no wraparound", "Kc Ad 2s", [4]int{1, 1, 0, 1}, false, false, false},
+		{"paired flop", "Qs Qd 4c", [4]int{1, 1, 0, 1}, false, false, true},
+		{"paired river", "Ts 8s 2h 5d 2c", [4]int{1, 1, 1, 2}, false, false, true},
+		{"empty board", "", [4]int{}, false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			board := mustParseHand(t, tt.board)
+			if got := BoardSuitCounts(board); got != tt.suits {
+				t.Errorf("BoardSuitCounts = %v, want %v", got, tt.suits)
+			}
+			if got := BoardHasFlushPossible(board); got != tt.flush {
+				t.Errorf("BoardHasFlushPossible = %v, want %v", got, tt.flush)
+			}
+			if got := BoardHasStraightPossible(board); got != tt.straight {
+				t.Errorf("BoardHasStraightPossible = %v, want %v", got, tt.straight)
+			}
+			if got := BoardIsPaired(board); got != tt.paired {
+				t.Errorf("BoardIsPaired = %v, want %v", got, tt.paired)
+			}
+		})
+	}
+}
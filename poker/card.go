@@ -170,6 +170,12 @@ func ParseHand(cardStrs ...string) (Hand, error) {
 	return hand, nil
 }
 
+// HandFromStrings parses wire-format card strings (e.g. []string{"As", "Kh"}) into a Hand.
+// Returns an error if any card string is invalid or duplicated.
+func HandFromStrings(cards []string) (Hand, error) {
+	return ParseHand(cards...)
+}
+
 // NewHand creates a hand from multiple cards
 func NewHand(cards ...Card) Hand {
 	var h Hand
@@ -184,6 +190,20 @@ func (h *Hand) AddCard(c Card) {
 	*h |= Hand(c)
 }
 
+// Add parses a card string like "As" and adds it to the hand.
+// Returns an error if the string is invalid or the card is already in the hand.
+func (h *Hand) Add(cardStr string) error {
+	card, err := ParseCard(cardStr)
+	if err != nil {
+		return err
+	}
+	if h.HasCard(card) {
+		return fmt.Errorf("duplicate card: %s", cardStr)
+	}
+	h.AddCard(card)
+	return nil
+}
+
 // HasCard checks if the hand contains a specific card
 func (h Hand) HasCard(c Card) bool {
 	return (h & Hand(c)) != 0
@@ -229,6 +249,16 @@ func (h Hand) GetCard(n int) Card {
 	return 0
 }
 
+// Cards returns the wire-format strings for each card in the hand, ordered
+// by bit position (clubs first, then by rank within each suit).
+func (h Hand) Cards() []string {
+	cards := make([]string, 0, h.CountCards())
+	for remaining := uint64(h); remaining != 0; remaining &= remaining - 1 {
+		cards = append(cards, Card(1<<bits.TrailingZeros64(remaining)).String())
+	}
+	return cards
+}
+
 // String returns a string representation of the hand
 func (h Hand) String() string {
 	if h == 0 {
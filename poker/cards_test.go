@@ -292,6 +292,32 @@ func TestDeck(t *testing.T) {
 	}
 }
 
+func TestDeckCheckDealt(t *testing.T) {
+	t.Parallel()
+
+	// A shuffled deck never deals a card twice
+	deck := NewDeck(randutil.New(42))
+	deck.CheckDealt()
+	if cards := deck.Deal(52); len(cards) != 52 {
+		t.Fatalf("expected 52 cards, got %d", len(cards))
+	}
+
+	// Reshuffling forgets what was dealt
+	deck.Reset()
+	deck.Deal(52)
+
+	ace := NewCard(Ace, Spades)
+	stacked := NewStackedDeck(ace, NewCard(King, Spades), ace)
+	stacked.CheckDealt()
+	stacked.Deal(2)
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic when a card is dealt twice")
+		}
+	}()
+	stacked.DealOne()
+}
+
 func BenchmarkCardCreation(b *testing.B) {
 	for b.Loop() {
 		_ = NewCard(Ace, Spades)
@@ -372,3 +398,78 @@ func BenchmarkHandOperations(b *testing.B) {
 		_ = hand.HasCard(c1)
 	}
 }
+
+func TestHandStringConversions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("round trip", func(t *testing.T) {
+		tests := [][]string{
+			nil,
+			{"As"},
+			{"2c", "Ah"},
+			{"2c", "7h", "Kd", "Ts", "9h"},
+		}
+		for _, cards := range tests {
+			hand, err := HandFromStrings(cards)
+			if err != nil {
+				t.Fatalf("HandFromStrings(%v) error: %v", cards, err)
+			}
+			got := hand.Cards()
+			if len(got) != len(cards) {
+				t.Fatalf("Cards() = %v, want %d cards", got, len(cards))
+			}
+			roundTrip, err := HandFromStrings(got)
+			if err != nil || roundTrip != hand {
+				t.Errorf("round trip of %v via %v = %v (err %v), want %v", cards, got, roundTrip, err, hand)
+			}
+		}
+	})
+
+	t.Run("cards ordered by bit position", func(t *testing.T) {
+		hand, _ := HandFromStrings([]string{"As", "2c", "Kh"})
+		got := hand.Cards()
+		want := []string{"2c", "Kh", "As"}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("Cards() = %v, want %v", got, want)
+			}
+		}
+	})
+
+	t.Run("add", func(t *testing.T) {
+		var hand Hand
+		for _, card := range []string{"As", "kh"} {
+			if err := hand.Add(card); err != nil {
+				t.Fatalf("Add(%q) error: %v", card, err)
+			}
+		}
+		want, _ := ParseHand("As", "Kh")
+		if hand != want {
+			t.Errorf("Add built %v, want %v", hand, want)
+		}
+		if err := hand.Add("As"); err == nil {
+			t.Error("Add should reject a duplicate card")
+		}
+		if hand != want {
+			t.Errorf("failed Add should leave hand unchanged, got %v", hand)
+		}
+	})
+
+	t.Run("parse errors", func(t *testing.T) {
+		for _, bad := range []string{"", "A", "Asx", "1s", "Ax", "10h"} {
+			if _, err := HandFromStrings([]string{"Kd", bad}); err == nil {
+				t.Errorf("HandFromStrings should reject %q", bad)
+			}
+			var hand Hand
+			if err := hand.Add(bad); err == nil {
+				t.Errorf("Add should reject %q", bad)
+			}
+			if hand != 0 {
+				t.Errorf("Add(%q) should leave the hand empty, got %v", bad, hand)
+			}
+		}
+		if _, err := HandFromStrings([]string{"Qs", "Qs"}); err == nil {
+			t.Error("HandFromStrings should reject duplicate cards")
+		}
+	})
+}
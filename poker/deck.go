@@ -1,19 +1,24 @@
 package poker
 
 import (
+	"fmt"
 	rand "math/rand/v2"
 )
 
 // Deck represents a standard 52-card deck
 type Deck struct {
-	cards [52]Card // Fixed size array
-	next  int
-	rng   *rand.Rand // Random source for deterministic shuffling
+	cards      [52]Card // Fixed size array
+	size       int      // Cards in the deck, 52 unless stacked
+	next       int
+	rng        *rand.Rand // Random source for deterministic shuffling
+	checkDealt bool       // Panic if a card is dealt twice
+	dealt      Hand       // Cards dealt since the last shuffle, when checking
 }
 
 // NewDeck creates a new shuffled deck with explicit RNG
 func NewDeck(rng *rand.Rand) *Deck {
 	d := &Deck{
+		size: 52,
 		next: 0,
 		rng:  rng,
 	}
@@ -32,10 +37,25 @@ func NewDeck(rng *rand.Rand) *Deck {
 	return d
 }
 
+// NewStackedDeck creates a deck that deals cards in the given order without
+// shuffling, for tests that need a fixed deal. Cards past the 52nd are ignored.
+func NewStackedDeck(cards ...Card) *Deck {
+	d := &Deck{}
+	d.size = copy(d.cards[:], cards)
+	return d
+}
+
+// CheckDealt makes the deck track every card it deals and panic if the same card
+// is dealt twice before the next shuffle. Meant for tests and debugging dealing code.
+func (d *Deck) CheckDealt() {
+	d.checkDealt = true
+}
+
 // Shuffle shuffles the deck using Fisher-Yates
 func (d *Deck) Shuffle() {
 	d.next = 0
-	for i := len(d.cards) - 1; i > 0; i-- {
+	d.dealt = 0
+	for i := d.size - 1; i > 0; i-- {
 		var j int
 		if d.rng != nil {
 			j = d.rng.IntN(i + 1)
@@ -48,30 +68,53 @@ func (d *Deck) Shuffle() {
 
 // Deal deals n cards from the deck
 func (d *Deck) Deal(n int) []Card {
-	if d.next+n > len(d.cards) {
+	if d.next+n > d.size {
 		return nil
 	}
 	cards := d.cards[d.next : d.next+n]
 	d.next += n
+	for _, card := range cards {
+		d.recordDealt(card)
+	}
 	return cards
 }
 
 // DealOne deals a single card from the deck
 func (d *Deck) DealOne() Card {
-	if d.next >= len(d.cards) {
+	if d.next >= d.size {
 		return 0
 	}
 	card := d.cards[d.next]
 	d.next++
+	d.recordDealt(card)
 	return card
 }
 
+// recordDealt panics if card was already dealt, when checking is enabled.
+func (d *Deck) recordDealt(card Card) {
+	if !d.checkDealt {
+		return
+	}
+	if d.dealt.HasCard(card) {
+		panic(fmt.Sprintf("card %s dealt twice", card))
+	}
+	d.dealt.AddCard(card)
+}
+
 // Reset resets and reshuffles the deck
 func (d *Deck) Reset() {
 	d.Shuffle()
 }
 
+// Undealt returns the cards left in the deck in the order they would be dealt,
+// without dealing them.
+func (d *Deck) Undealt() []Card {
+	cards := make([]Card, d.size-d.next)
+	copy(cards, d.cards[d.next:d.size])
+	return cards
+}
+
 // CardsRemaining returns the number of cards left in the deck
 func (d *Deck) CardsRemaining() int {
-	return len(d.cards) - d.next
+	return d.size - d.next
 }
@@ -0,0 +1,83 @@
+package poker
+
+import (
+	"fmt"
+	"strings"
+)
+
+var rankNames = [...]string{"Two", "Three", "Four", "Five", "Six", "Seven", "Eight", "Nine", "Ten", "Jack", "Queen", "King", "Ace"}
+
+var rankPlurals = [...]string{"Twos", "Threes", "Fours", "Fives", "Sixes", "Sevens", "Eights", "Nines", "Tens", "Jacks", "Queens", "Kings", "Aces"}
+
+// DescribeHand returns a human-readable description of the best hand made from
+// the hole cards and board, e.g. "Full House, Kings full of Queens". High card and
+// one pair hands list their kickers. With fewer than five cards (preflop) only the
+// cards present are described. Returns "" for an empty hand.
+func DescribeHand(hole, board Hand) string {
+	cards := hole | board
+	count := cards.CountCards()
+	if count == 0 {
+		return ""
+	}
+
+	var suitMasks [4]uint16
+	var rankMask uint16
+	for suit := range uint8(4) {
+		suitMasks[suit] = cards.GetSuitMask(suit)
+		rankMask |= suitMasks[suit]
+	}
+	rank := rankFromMasks(suitMasks, rankMask)
+
+	primary := rankNibble(rank, 24)
+	secondary := rankNibble(rank, 20)
+
+	switch rank.Type() {
+	case StraightFlush:
+		if primary == Ace {
+			return "Royal Flush"
+		}
+		return fmt.Sprintf("Straight Flush, %s high", rankNames[primary])
+	case FourOfAKind:
+		return fmt.Sprintf("Four of a Kind, %s", rankPlurals[primary])
+	case FullHouse:
+		return fmt.Sprintf("Full House, %s full of %s", rankPlurals[primary], rankPlurals[secondary])
+	case Flush:
+		return fmt.Sprintf("Flush, %s high", rankNames[primary])
+	case Straight:
+		return fmt.Sprintf("Straight, %s high", rankNames[primary])
+	case ThreeOfAKind:
+		return fmt.Sprintf("Three of a Kind, %s", rankPlurals[primary])
+	case TwoPair:
+		return fmt.Sprintf("Two Pair, %s and %s", rankPlurals[primary], rankPlurals[secondary])
+	case Pair:
+		desc := fmt.Sprintf("Pair, %s", rankPlurals[primary])
+		kickers := kickerNames(rank, []uint{20, 16, 12}, count-2)
+		switch len(kickers) {
+		case 0:
+			return desc
+		case 1:
+			return fmt.Sprintf("%s with %s kicker", desc, kickers[0])
+		default:
+			return fmt.Sprintf("%s with %s kickers", desc, strings.Join(kickers, "-"))
+		}
+	default:
+		return "High Card, " + strings.Join(kickerNames(rank, []uint{24, 20, 16, 12, 8}, count), "-")
+	}
+}
+
+// rankNibble extracts the 4-bit rank stored at the given shift of a HandRank.
+func rankNibble(rank HandRank, shift uint) uint8 {
+	return uint8((rank >> shift) & 0xF)
+}
+
+// kickerNames returns the names of up to limit ranks stored at the given shifts.
+func kickerNames(rank HandRank, shifts []uint, limit int) []string {
+	names := make([]string, 0, len(shifts))
+	for _, shift := range shifts {
+		if len(names) >= limit {
+			break
+		}
+		names = append(names, rankNames[rankNibble(rank, shift)])
+	}
+	return names
+}
@@ -0,0 +1,58 @@
+package poker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDescribeHand(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		hole     string
+		board    string
+		expected string
+	}{
+		{"royal flush", "As Ks", "Qs Js Ts 2c 3d", "Royal Flush"},
+		{"straight flush", "9h 8h", "7h 6h 5h Ac Ad", "Straight Flush, Nine high"},
+		{"steel wheel", "Ad 2d", "3d 4d 5d Kc Qs", "Straight Flush, Five high"},
+		{"four of a kind", "Qh Qd", "Qs Qc 7d 3h 2s", "Four of a Kind, Queens"},
+		{"full house", "Kh Kd", "Ks Qc Qd 3h 2s", "Full House, Kings full of Queens"},
+		{"full house from two trips", "8h 8d", "8s 5c 5d 5h As", "Full House, Eights full of Fives"},
+		{"flush", "Ac 9c", "7c 4c 2c Kd Kh", "Flush, Ace high"},
+		{"straight", "Th 9d", "8s 7c 6h 2d 2s", "Straight, Ten high"},
+		{"wheel straight", "Ah 2d", "3s 4c 5h Kd Qs", "Straight, Five high"},
+		{"three of a kind", "7h 7d", "7s Kc 9d 3h 2s", "Three of a Kind, Sevens"},
+		{"two pair", "Ah 8d", "As 8c Kd 3h 2s", "Two Pair, Aces and Eights"},
+		{"one pair with kickers", "Jh Jd", "Ac Kd 9s 4h 2c", "Pair, Jacks with Ace-King-Nine kickers"},
+		{"one pair on the flop", "Jh Jd", "Ac 9s 4h", "Pair, Jacks with Ace-Nine-Four kickers"},
+		{"pocket pair preflop", "Jh Jd", "", "Pair, Jacks"},
+		{"high card with kickers", "Ah Kd", "9s 7c 4h 3d 2s", "High Card, Ace-King-Nine-Seven-Four"},
+		{"high card preflop", "Ah Kd", "", "High Card, Ace-King"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			hole := mustParseHand(t, tt.hole)
+			board := mustParseHand(t, tt.board)
+			if got := DescribeHand(hole, board); got != tt.expected {
+				t.Errorf("DescribeHand(%s, %s) = %q, want %q", tt.hole, tt.board, got, tt.expected)
+			}
+		})
+	}
+
+	if got := DescribeHand(0, 0); got != "" {
+		t.Errorf("DescribeHand of empty hand = %q, want empty string", got)
+	}
+}
+
+func mustParseHand(t *testing.T, cards string) Hand {
+	t.Helper()
+	hand, err := HandFromStrings(strings.Fields(cards))
+	if err != nil {
+		t.Fatalf("failed to parse %q: %v", cards, err)
+	}
+	return hand
+}
@@ -69,6 +69,17 @@ func Evaluate7Cards(hand Hand) HandRank {
 	return evaluate7CardsUnchecked(hand)
 }
 
+// EvaluateCards evaluates the best 5-card hand from 5 to 7 cards, for comparing
+// made hands on the flop or turn. Ranks are only comparable between hands with
+// the same number of cards.
+func EvaluateCards(hand Hand) HandRank {
+	if n := hand.CountCards(); n < 5 || n > 7 {
+		return 0
+	}
+
+	return evaluate7CardsUnchecked(hand)
+}
+
 // Evaluate7CardsBatch evaluates multiple 7-card hands and writes results into out.
 // If out is nil or smaller than hands, a new slice is allocated and returned.
 // Each hand is assumed to contain exactly seven cards; behavior is undefined otherwise.
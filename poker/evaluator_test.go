@@ -171,6 +171,24 @@ func TestEvaluate7CardsBatchMatchesSingle(t *testing.T) {
 	}
 }
 
+func TestEvaluateCardsBeforeRiver(t *testing.T) {
+	t.Parallel()
+	set := EvaluateCards(parseCards("7s", "7h", "Kd", "7c", "2h"))
+	if set.Type() != ThreeOfAKind {
+		t.Fatalf("expected three of a kind on the flop, got %v", set.Type())
+	}
+	topPair := EvaluateCards(parseCards("As", "Kh", "Kd", "7c", "2h", "3s"))
+	if topPair.Type() != Pair {
+		t.Fatalf("expected a pair on the turn, got %v", topPair.Type())
+	}
+	if CompareHands(EvaluateCards(parseCards("As", "Kh", "Kd", "7c", "2h")), EvaluateCards(parseCards("Qs", "Kh", "Kd", "7c", "2h"))) != 1 {
+		t.Error("expected the ace kicker to win")
+	}
+	if EvaluateCards(parseCards("As", "Kh", "Kd", "7c")) != 0 {
+		t.Error("expected 0 for fewer than five cards")
+	}
+}
+
 func BenchmarkEvaluate7Cards(b *testing.B) {
 	hand := parseCards("As", "Kh", "Qd", "Jc", "Ts", "9h", "7d")
 
@@ -0,0 +1,147 @@
+package poker
+
+import (
+	"math"
+	"math/bits"
+)
+
+// LowRank represents the strength of a low hand. It uses the same bit layout
+// as HandRank, but lower values are better lows, so compare them with
+// CompareLowHands rather than CompareHands. Ranks from EvaluateLow27 and
+// EvaluateLowA5 are only comparable with ranks from the same evaluator.
+type LowRank uint32
+
+// NoLow is returned for hands that can't be evaluated and loses to every low.
+const NoLow LowRank = math.MaxUint32
+
+// Type returns the type of the low hand (high card, pair, etc.). A HighCard
+// low has five distinct ranks.
+func (lr LowRank) Type() HandRank {
+	return HandRank(lr).Type()
+}
+
+// EightOrBetter reports whether an ace-to-five low from EvaluateLowA5 qualifies
+// for the low half of a hi-lo pot: five distinct ranks, none higher than an eight.
+func (lr LowRank) EightOrBetter() bool {
+	// Ace-to-five ranks are shifted up one so the ace is zero
+	return lr != NoLow && lr.Type() == HighCard && uint8(lr>>24)&0xF <= Eight+1
+}
+
+// EvaluateLow27 evaluates the best 5-card deuce-to-seven low from 5 to 7 cards.
+// Aces are always high, and straights and flushes count against the hand, so
+// the nut low is 7-5-4-3-2 in mixed suits.
+func EvaluateLow27(hand Hand) LowRank {
+	return bestLow(hand, lowRank27)
+}
+
+// EvaluateLowA5 evaluates the best 5-card ace-to-five low from 5 to 7 cards.
+// Aces are always low and straights and flushes are ignored, so the nut low is
+// the wheel, 5-4-3-2-A.
+func EvaluateLowA5(hand Hand) LowRank {
+	return bestLow(hand, lowRankA5)
+}
+
+// CompareLowHands compares two lows and returns 1 if a wins, -1 if b wins, 0 for tie
+func CompareLowHands(a, b LowRank) int {
+	if a < b {
+		return 1
+	} else if a > b {
+		return -1
+	}
+	return 0
+}
+
+// bestLow returns the best low of every 5-card combination in hand.
+func bestLow(hand Hand, rank func(Hand) LowRank) LowRank {
+	n := hand.CountCards()
+	if n < 5 || n > 7 {
+		return NoLow
+	}
+
+	var cards [7]Hand
+	for i, rest := 0, hand; rest != 0; i, rest = i+1, rest&(rest-1) {
+		cards[i] = rest & -rest
+	}
+
+	best := NoLow
+	for combo := uint(0); combo < 1<<n; combo++ {
+		if bits.OnesCount(combo) != 5 {
+			continue
+		}
+		var five Hand
+		for i := range n {
+			if combo&(1<<i) != 0 {
+				five |= cards[i]
+			}
+		}
+		if r := rank(five); r < best {
+			best = r
+		}
+	}
+	return best
+}
+
+// lowRank27 ranks five cards for deuce-to-seven, which is the high hand rank
+// except that A-2-3-4-5 is ace high rather than a straight.
+func lowRank27(five Hand) LowRank {
+	var suitMasks [4]uint16
+	var rankMask uint16
+	for suit := uint8(0); suit < 4; suit++ {
+		suitMasks[suit] = five.GetSuitMask(suit)
+		rankMask |= suitMasks[suit]
+	}
+
+	rank := rankFromMasks(suitMasks, rankMask)
+	if (rank.Type() == Straight || rank.Type() == StraightFlush) && straightHighMask(rankMask) == Five {
+		typ := HighCard
+		if rank.Type() == StraightFlush {
+			typ = Flush
+		}
+		rank = typ | (HandRank(Ace) << 24) | (HandRank(Five) << 20) | (HandRank(Four) << 16) | (HandRank(Three) << 12) | (HandRank(Two) << 8)
+	}
+	return LowRank(rank)
+}
+
+// lowRankA5 ranks five cards for ace-to-five. Ranks are shifted so the ace is
+// rank zero, and only pairs, trips and quads count against the hand.
+func lowRankA5(five Hand) LowRank {
+	var s [4]uint16
+	var rankMask uint16
+	for suit := uint8(0); suit < 4; suit++ {
+		mask := five.GetSuitMask(suit)
+		s[suit] = ((mask << 1) | (mask >> Ace)) & RankMask
+		rankMask |= s[suit]
+	}
+
+	quadsMask := s[0] & s[1] & s[2] & s[3]
+	tripCandidates := (s[0] & s[1] & s[2]) | (s[0] & s[1] & s[3]) | (s[0] & s[2] & s[3]) | (s[1] & s[2] & s[3])
+	tripsMask := tripCandidates &^ quadsMask
+	pairsMask := ((s[0] & s[1]) | (s[0] & s[2]) | (s[0] & s[3]) | (s[1] & s[2]) | (s[1] & s[3]) | (s[2] & s[3])) &^ tripCandidates
+
+	var rank HandRank
+	switch {
+	case quadsMask != 0:
+		quad := highestRank(quadsMask)
+		kicker := findKicker(rankMask, []uint8{uint8(quad)})
+		rank = FourOfAKind | (HandRank(quad) << 24) | (HandRank(kicker) << 20)
+	case tripsMask != 0 && pairsMask != 0:
+		rank = FullHouse | (HandRank(highestRank(tripsMask)) << 24) | (HandRank(highestRank(pairsMask)) << 20)
+	case tripsMask != 0:
+		trip := highestRank(tripsMask)
+		kickers := findOrderedKickers(rankMask, []uint8{uint8(trip)}, 2)
+		rank = ThreeOfAKind | (HandRank(trip) << 24) | (HandRank(kickers[0]) << 20) | (HandRank(kickers[1]) << 16)
+	case bits.OnesCount16(pairsMask) == 2:
+		pair1 := highestRank(pairsMask)
+		pair2 := highestRank(pairsMask &^ (1 << pair1))
+		kicker := findKicker(rankMask, []uint8{uint8(pair1), uint8(pair2)})
+		rank = TwoPair | (HandRank(pair1) << 24) | (HandRank(pair2) << 20) | (HandRank(kicker) << 16)
+	case pairsMask != 0:
+		pair := highestRank(pairsMask)
+		kickers := findOrderedKickers(rankMask, []uint8{uint8(pair)}, 3)
+		rank = Pair | (HandRank(pair) << 24) | (HandRank(kickers[0]) << 20) | (HandRank(kickers[1]) << 16) | (HandRank(kickers[2]) << 12)
+	default:
+		kickers := findOrderedKickers(rankMask, nil, 5)
+		rank = HighCard | (HandRank(kickers[0]) << 24) | (HandRank(kickers[1]) << 20) | (HandRank(kickers[2]) << 16) | (HandRank(kickers[3]) << 12) | (HandRank(kickers[4]) << 8)
+	}
+	return LowRank(rank)
+}
@@ -0,0 +1,158 @@
+package poker
+
+import "testing"
+
+func TestEvaluateLow27(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		hand1Cards     []string
+		hand2Cards     []string
+		expectedResult int // 1 if hand1 is the better low, -1 if hand2, 0 if tie
+	}{
+		{
+			name:           "75432 beats 76432",
+			hand1Cards:     []string{"7s", "5h", "4d", "3c", "2s"},
+			hand2Cards:     []string{"7h", "6d", "4c", "3s", "2h"},
+			expectedResult: 1,
+		},
+		{
+			name:           "suits don't matter without a flush",
+			hand1Cards:     []string{"7s", "5h", "4d", "3c", "2s"},
+			hand2Cards:     []string{"7h", "5d", "4c", "3s", "2h"},
+			expectedResult: 0,
+		},
+		{
+			name:           "flush counts against the hand",
+			hand1Cards:     []string{"7s", "5s", "4s", "3s", "2s"},
+			hand2Cards:     []string{"Kh", "Qd", "Jc", "9s", "8h"},
+			expectedResult: -1,
+		},
+		{
+			name:           "straight counts against the hand",
+			hand1Cards:     []string{"6s", "5h", "4d", "3c", "2s"},
+			hand2Cards:     []string{"Kh", "Qd", "Jc", "9s", "8h"},
+			expectedResult: -1,
+		},
+		{
+			name:           "ace is high so the wheel is ace high",
+			hand1Cards:     []string{"As", "5h", "4d", "3c", "2s"},
+			hand2Cards:     []string{"Kh", "Qd", "Jc", "9s", "8h"},
+			expectedResult: -1,
+		},
+		{
+			name:           "pair loses to any high card",
+			hand1Cards:     []string{"2s", "2h", "3d", "4c", "5s"},
+			hand2Cards:     []string{"Ah", "Kd", "Qc", "Js", "9h"},
+			expectedResult: -1,
+		},
+		{
+			name:           "best five of seven avoids the straight",
+			hand1Cards:     []string{"6s", "5h", "4d", "3c", "2s", "8h", "Kd"},
+			hand2Cards:     []string{"8s", "5d", "4c", "3d", "2h"},
+			expectedResult: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			low1 := EvaluateLow27(parseCards(tt.hand1Cards...))
+			low2 := EvaluateLow27(parseCards(tt.hand2Cards...))
+			if got := CompareLowHands(low1, low2); got != tt.expectedResult {
+				t.Errorf("expected %d, got %d (low1=%08x, low2=%08x)", tt.expectedResult, got, low1, low2)
+			}
+		})
+	}
+}
+
+func TestEvaluateLowA5(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		hand1Cards     []string
+		hand2Cards     []string
+		expectedResult int // 1 if hand1 is the better low, -1 if hand2, 0 if tie
+	}{
+		{
+			name:           "75432 beats 76432",
+			hand1Cards:     []string{"7s", "5h", "4d", "3c", "2s"},
+			hand2Cards:     []string{"7h", "6d", "4c", "3s", "2h"},
+			expectedResult: 1,
+		},
+		{
+			name:           "wheel is the nut low",
+			hand1Cards:     []string{"As", "2h", "3d", "4c", "5s"},
+			hand2Cards:     []string{"Ah", "2d", "3c", "4s", "6h"},
+			expectedResult: 1,
+		},
+		{
+			name:           "straights and flushes are ignored",
+			hand1Cards:     []string{"As", "2s", "3s", "4s", "5s"},
+			hand2Cards:     []string{"Ah", "2d", "3c", "4s", "5h"},
+			expectedResult: 0,
+		},
+		{
+			name:           "ace is low",
+			hand1Cards:     []string{"As", "6h", "4d", "3c", "2s"},
+			hand2Cards:     []string{"7h", "6d", "4c", "3s", "2h"},
+			expectedResult: 1,
+		},
+		{
+			name:           "pair loses to any high card",
+			hand1Cards:     []string{"As", "Ah", "2d", "3c", "4s"},
+			hand2Cards:     []string{"Kh", "Qd", "Jc", "Ts", "9h"},
+			expectedResult: -1,
+		},
+		{
+			name:           "best five of seven skips the pair",
+			hand1Cards:     []string{"As", "Ah", "2d", "3c", "4s", "8h", "Kd"},
+			hand2Cards:     []string{"8s", "4h", "3c", "2d", "Ah"},
+			expectedResult: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			low1 := EvaluateLowA5(parseCards(tt.hand1Cards...))
+			low2 := EvaluateLowA5(parseCards(tt.hand2Cards...))
+			if got := CompareLowHands(low1, low2); got != tt.expectedResult {
+				t.Errorf("expected %d, got %d (low1=%08x, low2=%08x)", tt.expectedResult, got, low1, low2)
+			}
+		})
+	}
+}
+
+func TestEvaluateLowRejectsShortHands(t *testing.T) {
+	t.Parallel()
+	hand := parseCards("As", "2h", "3d", "4c")
+	if low := EvaluateLow27(hand); low != NoLow {
+		t.Errorf("expected NoLow for 2-7 with 4 cards, got %08x", low)
+	}
+	if low := EvaluateLowA5(hand); low != NoLow {
+		t.Errorf("expected NoLow for A-5 with 4 cards, got %08x", low)
+	}
+}
+
+func TestEightOrBetter(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		cards []string
+		want  bool
+	}{
+		{[]string{"As", "2h", "3d", "4c", "5s"}, true},
+		{[]string{"8s", "7h", "6d", "5c", "4s"}, true},
+		{[]string{"9s", "4h", "3d", "2c", "As"}, false},
+		{[]string{"As", "Ah", "2d", "3c", "4s"}, false},
+	}
+
+	for _, tt := range tests {
+		if got := EvaluateLowA5(parseCards(tt.cards...)).EightOrBetter(); got != tt.want {
+			t.Errorf("%v: expected qualifies=%v, got %v", tt.cards, tt.want, got)
+		}
+	}
+	if NoLow.EightOrBetter() {
+		t.Error("expected NoLow not to qualify")
+	}
+}
@@ -64,6 +64,10 @@ func Marshal(v any) ([]byte, error) {
 		if err := msg.EncodeMsg(writer); err != nil {
 			return nil, err
 		}
+	case *ServerShutdown:
+		if err := msg.EncodeMsg(writer); err != nil {
+			return nil, err
+		}
 	default:
 		return nil, ErrUnknownMessageType
 	}
@@ -103,6 +107,8 @@ func Unmarshal(data []byte, v any) error {
 		return msg.DecodeMsg(reader)
 	case *GameCompleted:
 		return msg.DecodeMsg(reader)
+	case *ServerShutdown:
+		return msg.DecodeMsg(reader)
 	default:
 		return ErrUnknownMessageType
 	}
@@ -11,14 +11,39 @@ const (
 	TypeAction  = "action"
 
 	// Server -> Client
-	TypeHandStart     = "hand_start"
-	TypeActionRequest = "action_request"
-	TypeGameUpdate    = "game_update"
-	TypePlayerAction  = "player_action"
-	TypeStreetChange  = "street_change"
-	TypeHandResult    = "hand_result"
-	TypeError         = "error"
-	TypeGameCompleted = "game_completed"
+	TypeHandStart      = "hand_start"
+	TypeActionRequest  = "action_request"
+	TypeGameUpdate     = "game_update"
+	TypePlayerAction   = "player_action"
+	TypeStreetChange   = "street_change"
+	TypeHandResult     = "hand_result"
+	TypeError          = "error"
+	TypeGameCompleted  = "game_completed"
+	TypeServerShutdown = "server_shutdown"
+)
+
+// RoleObserver is the Connect role for read-only spectators. Observers receive the
+// public events of a game (no hole cards until showdown) and are never seated.
+const RoleObserver = "observer"
+
+// Hand end reasons reported in HandResult.EndReason
+const (
+	HandEndAllFolded             = "all_folded"              // Every opponent folded to the winner
+	HandEndShowdown              = "showdown"                // Two or more players reached showdown
+	HandEndSinglePlayerRemaining = "single_player_remaining" // The last opponent left the hand by disconnecting
+	HandEndForcedFold            = "forced_fold"             // The server folded the last opponent after an invalid action
+)
+
+// Action contexts reported in PlayerAction.Context, classified by the server
+const (
+	ContextCBet       = "cbet"        // First bet of a street by the previous street's last aggressor
+	ContextThreeBet   = "3bet"        // Third bet of a street, counting the big blind preflop
+	ContextCheckRaise = "check_raise" // Raise by a player who checked earlier in the street
+)
+
+// Betting structures reported in HandStart.BettingLimit
+const (
+	NoLimit = "no_limit"
 )
 
 // Card representation as string (e.g., "As", "Kh")
@@ -33,6 +58,8 @@ type Connect struct {
 	Game            string `msg:"game,omitempty"`
 	AuthToken       string `msg:"auth_token,omitempty"`
 	ProtocolVersion string `msg:"protocol_version,omitempty"` // "1" or "2", defaults to "2" if omitted
+	Role            string `msg:"role,omitempty"`             // Empty to play, RoleObserver to spectate
+	Seat            *int   `msg:"seat,omitempty"`             // Seat to pin the bot to (0 is the button), nil to be seated randomly
 }
 
 // Action is sent by client in response to ActionRequest
@@ -54,6 +81,14 @@ type HandStart struct {
 	Players    []Player `msg:"players"`
 	SmallBlind int      `msg:"small_blind"`
 	BigBlind   int      `msg:"big_blind"`
+
+	// Table rules, so bots joining mid-session don't have to infer them
+	Variant      string  `msg:"variant"`       // holdem or omaha-hilo
+	BettingLimit string  `msg:"betting_limit"` // Betting structure, currently always no_limit
+	Ante         int     `msg:"ante"`          // Ante each player posts before the blinds, 0 without antes
+	Straddle     int     `msg:"straddle"`      // Chips the straddler posted, 0 without a straddle
+	StraddleSeat int     `msg:"straddle_seat"` // Seat that straddled, -1 without a straddle
+	Rake         float64 `msg:"rake"`          // Share of each pot the house takes, currently always 0
 }
 
 // Player info in a hand
@@ -64,6 +99,9 @@ type Player struct {
 	Bet    int    `msg:"bet,omitempty"`
 	Folded bool   `msg:"folded,omitempty"`
 	AllIn  bool   `msg:"all_in,omitempty"`
+
+	// DeadBlind is the dead small blind posted on returning from sitting out (hand_start only)
+	DeadBlind int `msg:"dead_blind,omitempty"`
 }
 
 // ActionRequest asks a bot to make a decision
@@ -93,11 +131,12 @@ type PlayerAction struct {
 	Street      string `msg:"street"`
 	Seat        int    `msg:"seat"`
 	PlayerName  string `msg:"player_name"`
-	Action      string `msg:"action"`       // fold, check, call, raise, allin, post_small_blind, post_big_blind, timeout_fold
-	AmountPaid  int    `msg:"amount_paid"`  // Incremental amount paid with this action
-	PlayerBet   int    `msg:"player_bet"`   // Player's total bet after action
-	PlayerChips int    `msg:"player_chips"` // Player's chips after action
-	Pot         int    `msg:"pot"`          // Total pot after action
+	Action      string `msg:"action"`            // fold, check, call, raise, allin, post_small_blind, post_big_blind, post_dead_blind, timeout_fold
+	AmountPaid  int    `msg:"amount_paid"`       // Incremental amount paid with this action
+	PlayerBet   int    `msg:"player_bet"`        // Player's total bet after action
+	PlayerChips int    `msg:"player_chips"`      // Player's chips after action
+	Pot         int    `msg:"pot"`               // Total pot after action
+	Context     string `msg:"context,omitempty"` // cbet, 3bet or check_raise, empty for other actions
 }
 
 // StreetChange is sent when moving to next betting round
@@ -110,11 +149,13 @@ type StreetChange struct {
 
 // HandResult is sent at hand completion
 type HandResult struct {
-	Type     string         `msg:"type"`
-	HandID   string         `msg:"hand_id"`
-	Winners  []Winner       `msg:"winners"`
-	Board    []string       `msg:"board"`
-	Showdown []ShowdownHand `msg:"showdown,omitempty"` // All hands shown at showdown
+	Type       string         `msg:"type"`
+	HandID     string         `msg:"hand_id"`
+	Winners    []Winner       `msg:"winners"`
+	Board      []string       `msg:"board"`
+	Showdown   []ShowdownHand `msg:"showdown,omitempty"`    // All hands shown at showdown
+	EndReason  string         `msg:"end_reason"`            // How the hand ended, one of the HandEnd constants
+	RabbitHunt []string       `msg:"rabbit_hunt,omitempty"` // Undealt board cards when the server rabbit hunts hands that ended early
 }
 
 // GameCompletedPlayer summarizes a bot's performance during the game run.
@@ -147,6 +188,12 @@ type GameCompleted struct {
 	Players        []GameCompletedPlayer `msg:"players" json:"players"`
 }
 
+// ServerShutdown is sent before the server closes connections during a graceful shutdown.
+type ServerShutdown struct {
+	Type   string `msg:"type" json:"type"`
+	Reason string `msg:"reason" json:"reason"`
+}
+
 // Winner info
 type Winner struct {
 	Name      string   `msg:"name"`
@@ -189,6 +236,10 @@ type PlayerDetailedStats struct {
 	ShowdownWinRate float64 `msg:"showdown_win_rate" json:"showdown_win_rate"`
 	ShowdownBB      float64 `msg:"showdown_bb" json:"showdown_bb"`
 	NonShowdownBB   float64 `msg:"non_showdown_bb" json:"non_showdown_bb"`
+	ShowdownRate    float64 `msg:"showdown_rate" json:"showdown_rate"` // Fraction of hands that went to showdown
+
+	// Fraction of hands that reached each street (or later) without folding, keyed by street name
+	StreetReached map[string]float64 `msg:"street_reached,omitempty" json:"street_reached,omitempty"`
 
 	// Pots
 	MaxPotBB float64 `msg:"max_pot_bb" json:"max_pot_bb"`
@@ -216,6 +267,9 @@ type PlayerDetailedStats struct {
 	PositionStats     map[string]PositionStatSummary `msg:"position_stats,omitempty" json:"position_stats,omitempty"`
 	StreetStats       map[string]StreetStatSummary   `msg:"street_stats,omitempty" json:"street_stats,omitempty"`
 	HandCategoryStats map[string]CategoryStatSummary `msg:"hand_category_stats,omitempty" json:"hand_category_stats,omitempty"`
+
+	// Showdowns won, keyed by the winning hand class (e.g. "Two Pair")
+	ShowdownWinsByHand map[string]int `msg:"showdown_wins_by_hand,omitempty" json:"showdown_wins_by_hand,omitempty"`
 }
 
 // PositionStatSummary contains position-specific statistics
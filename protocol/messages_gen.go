@@ -730,6 +730,30 @@ func (z *Connect) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "ProtocolVersion")
 				return
 			}
+		case "role":
+			z.Role, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Role")
+				return
+			}
+		case "seat":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "Seat")
+					return
+				}
+				z.Seat = nil
+			} else {
+				if z.Seat == nil {
+					z.Seat = new(int)
+				}
+				*z.Seat, err = dc.ReadInt()
+				if err != nil {
+					err = msgp.WrapError(err, "Seat")
+					return
+				}
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -744,8 +768,8 @@ func (z *Connect) DecodeMsg(dc *msgp.Reader) (err error) {
 // EncodeMsg implements msgp.Encodable
 func (z *Connect) EncodeMsg(en *msgp.Writer) (err error) {
 	// check for omitted fields
-	zb0001Len := uint32(5)
-	var zb0001Mask uint8 /* 5 bits */
+	zb0001Len := uint32(7)
+	var zb0001Mask uint8 /* 7 bits */
 	_ = zb0001Mask
 	if z.Game == "" {
 		zb0001Len--
@@ -759,6 +783,14 @@ func (z *Connect) EncodeMsg(en *msgp.Writer) (err error) {
 		zb0001Len--
 		zb0001Mask |= 0x10
 	}
+	if z.Role == "" {
+		zb0001Len--
+		zb0001Mask |= 0x20
+	}
+	if z.Seat == nil {
+		zb0001Len--
+		zb0001Mask |= 0x40
+	}
 	// variable map header, size zb0001Len
 	err = en.Append(0x80 | uint8(zb0001Len))
 	if err != nil {
@@ -823,6 +855,37 @@ func (z *Connect) EncodeMsg(en *msgp.Writer) (err error) {
 				return
 			}
 		}
+		if (zb0001Mask & 0x20) == 0 { // if not omitted
+			// write "role"
+			err = en.Append(0xa4, 0x72, 0x6f, 0x6c, 0x65)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.Role)
+			if err != nil {
+				err = msgp.WrapError(err, "Role")
+				return
+			}
+		}
+		if (zb0001Mask & 0x40) == 0 { // if not omitted
+			// write "seat"
+			err = en.Append(0xa4, 0x73, 0x65, 0x61, 0x74)
+			if err != nil {
+				return
+			}
+			if z.Seat == nil {
+				err = en.WriteNil()
+				if err != nil {
+					return
+				}
+			} else {
+				err = en.WriteInt(*z.Seat)
+				if err != nil {
+					err = msgp.WrapError(err, "Seat")
+					return
+				}
+			}
+		}
 	}
 	return
 }
@@ -831,8 +894,8 @@ func (z *Connect) EncodeMsg(en *msgp.Writer) (err error) {
 func (z *Connect) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
 	// check for omitted fields
-	zb0001Len := uint32(5)
-	var zb0001Mask uint8 /* 5 bits */
+	zb0001Len := uint32(7)
+	var zb0001Mask uint8 /* 7 bits */
 	_ = zb0001Mask
 	if z.Game == "" {
 		zb0001Len--
@@ -846,6 +909,14 @@ func (z *Connect) MarshalMsg(b []byte) (o []byte, err error) {
 		zb0001Len--
 		zb0001Mask |= 0x10
 	}
+	if z.Role == "" {
+		zb0001Len--
+		zb0001Mask |= 0x20
+	}
+	if z.Seat == nil {
+		zb0001Len--
+		zb0001Mask |= 0x40
+	}
 	// variable map header, size zb0001Len
 	o = append(o, 0x80|uint8(zb0001Len))
 
@@ -872,6 +943,20 @@ func (z *Connect) MarshalMsg(b []byte) (o []byte, err error) {
 			o = append(o, 0xb0, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e)
 			o = msgp.AppendString(o, z.ProtocolVersion)
 		}
+		if (zb0001Mask & 0x20) == 0 { // if not omitted
+			// string "role"
+			o = append(o, 0xa4, 0x72, 0x6f, 0x6c, 0x65)
+			o = msgp.AppendString(o, z.Role)
+		}
+		if (zb0001Mask & 0x40) == 0 { // if not omitted
+			// string "seat"
+			o = append(o, 0xa4, 0x73, 0x65, 0x61, 0x74)
+			if z.Seat == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendInt(o, *z.Seat)
+			}
+		}
 	}
 	return
 }
@@ -924,6 +1009,29 @@ func (z *Connect) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "ProtocolVersion")
 				return
 			}
+		case "role":
+			z.Role, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Role")
+				return
+			}
+		case "seat":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Seat = nil
+			} else {
+				if z.Seat == nil {
+					z.Seat = new(int)
+				}
+				*z.Seat, bts, err = msgp.ReadIntBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Seat")
+					return
+				}
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -938,7 +1046,12 @@ func (z *Connect) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *Connect) Msgsize() (s int) {
-	s = 1 + 5 + msgp.StringPrefixSize + len(z.Type) + 5 + msgp.StringPrefixSize + len(z.Name) + 5 + msgp.StringPrefixSize + len(z.Game) + 11 + msgp.StringPrefixSize + len(z.AuthToken) + 17 + msgp.StringPrefixSize + len(z.ProtocolVersion)
+	s = 1 + 5 + msgp.StringPrefixSize + len(z.Type) + 5 + msgp.StringPrefixSize + len(z.Name) + 5 + msgp.StringPrefixSize + len(z.Game) + 11 + msgp.StringPrefixSize + len(z.AuthToken) + 17 + msgp.StringPrefixSize + len(z.ProtocolVersion) + 5 + msgp.StringPrefixSize + len(z.Role) + 5
+	if z.Seat == nil {
+		s += msgp.NilSize
+	} else {
+		s += msgp.IntSize
+	}
 	return
 }
 
@@ -2178,6 +2291,31 @@ func (z *HandResult) DecodeMsg(dc *msgp.Reader) (err error) {
 					return
 				}
 			}
+		case "end_reason":
+			z.EndReason, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "EndReason")
+				return
+			}
+		case "rabbit_hunt":
+			var zb0005 uint32
+			zb0005, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "RabbitHunt")
+				return
+			}
+			if cap(z.RabbitHunt) >= int(zb0005) {
+				z.RabbitHunt = (z.RabbitHunt)[:zb0005]
+			} else {
+				z.RabbitHunt = make([]string, zb0005)
+			}
+			for za0004 := range z.RabbitHunt {
+				z.RabbitHunt[za0004], err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "RabbitHunt", za0004)
+					return
+				}
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -2192,13 +2330,17 @@ func (z *HandResult) DecodeMsg(dc *msgp.Reader) (err error) {
 // EncodeMsg implements msgp.Encodable
 func (z *HandResult) EncodeMsg(en *msgp.Writer) (err error) {
 	// check for omitted fields
-	zb0001Len := uint32(5)
-	var zb0001Mask uint8 /* 5 bits */
+	zb0001Len := uint32(7)
+	var zb0001Mask uint8 /* 7 bits */
 	_ = zb0001Mask
 	if z.Showdown == nil {
 		zb0001Len--
 		zb0001Mask |= 0x10
 	}
+	if z.RabbitHunt == nil {
+		zb0001Len--
+		zb0001Mask |= 0x40
+	}
 	// variable map header, size zb0001Len
 	err = en.Append(0x80 | uint8(zb0001Len))
 	if err != nil {
@@ -2280,6 +2422,35 @@ func (z *HandResult) EncodeMsg(en *msgp.Writer) (err error) {
 				}
 			}
 		}
+		// write "end_reason"
+		err = en.Append(0xaa, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.EndReason)
+		if err != nil {
+			err = msgp.WrapError(err, "EndReason")
+			return
+		}
+		if (zb0001Mask & 0x40) == 0 { // if not omitted
+			// write "rabbit_hunt"
+			err = en.Append(0xab, 0x72, 0x61, 0x62, 0x62, 0x69, 0x74, 0x5f, 0x68, 0x75, 0x6e, 0x74)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.RabbitHunt)))
+			if err != nil {
+				err = msgp.WrapError(err, "RabbitHunt")
+				return
+			}
+			for za0004 := range z.RabbitHunt {
+				err = en.WriteString(z.RabbitHunt[za0004])
+				if err != nil {
+					err = msgp.WrapError(err, "RabbitHunt", za0004)
+					return
+				}
+			}
+		}
 	}
 	return
 }
@@ -2288,13 +2459,17 @@ func (z *HandResult) EncodeMsg(en *msgp.Writer) (err error) {
 func (z *HandResult) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
 	// check for omitted fields
-	zb0001Len := uint32(5)
-	var zb0001Mask uint8 /* 5 bits */
+	zb0001Len := uint32(7)
+	var zb0001Mask uint8 /* 7 bits */
 	_ = zb0001Mask
 	if z.Showdown == nil {
 		zb0001Len--
 		zb0001Mask |= 0x10
 	}
+	if z.RabbitHunt == nil {
+		zb0001Len--
+		zb0001Mask |= 0x40
+	}
 	// variable map header, size zb0001Len
 	o = append(o, 0x80|uint8(zb0001Len))
 
@@ -2334,6 +2509,17 @@ func (z *HandResult) MarshalMsg(b []byte) (o []byte, err error) {
 				}
 			}
 		}
+		// string "end_reason"
+		o = append(o, 0xaa, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e)
+		o = msgp.AppendString(o, z.EndReason)
+		if (zb0001Mask & 0x40) == 0 { // if not omitted
+			// string "rabbit_hunt"
+			o = append(o, 0xab, 0x72, 0x61, 0x62, 0x62, 0x69, 0x74, 0x5f, 0x68, 0x75, 0x6e, 0x74)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.RabbitHunt)))
+			for za0004 := range z.RabbitHunt {
+				o = msgp.AppendString(o, z.RabbitHunt[za0004])
+			}
+		}
 	}
 	return
 }
@@ -2425,6 +2611,31 @@ func (z *HandResult) UnmarshalMsg(bts []byte) (o []byte, err error) {
 					return
 				}
 			}
+		case "end_reason":
+			z.EndReason, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "EndReason")
+				return
+			}
+		case "rabbit_hunt":
+			var zb0005 uint32
+			zb0005, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "RabbitHunt")
+				return
+			}
+			if cap(z.RabbitHunt) >= int(zb0005) {
+				z.RabbitHunt = (z.RabbitHunt)[:zb0005]
+			} else {
+				z.RabbitHunt = make([]string, zb0005)
+			}
+			for za0004 := range z.RabbitHunt {
+				z.RabbitHunt[za0004], bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "RabbitHunt", za0004)
+					return
+				}
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -2451,6 +2662,10 @@ func (z *HandResult) Msgsize() (s int) {
 	for za0003 := range z.Showdown {
 		s += z.Showdown[za0003].Msgsize()
 	}
+	s += 11 + msgp.StringPrefixSize + len(z.EndReason) + 12 + msgp.ArrayHeaderSize
+	for za0004 := range z.RabbitHunt {
+		s += msgp.StringPrefixSize + len(z.RabbitHunt[za0004])
+	}
 	return
 }
 
@@ -2546,6 +2761,42 @@ func (z *HandStart) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "BigBlind")
 				return
 			}
+		case "variant":
+			z.Variant, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Variant")
+				return
+			}
+		case "betting_limit":
+			z.BettingLimit, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "BettingLimit")
+				return
+			}
+		case "ante":
+			z.Ante, err = dc.ReadInt()
+			if err != nil {
+				err = msgp.WrapError(err, "Ante")
+				return
+			}
+		case "straddle":
+			z.Straddle, err = dc.ReadInt()
+			if err != nil {
+				err = msgp.WrapError(err, "Straddle")
+				return
+			}
+		case "straddle_seat":
+			z.StraddleSeat, err = dc.ReadInt()
+			if err != nil {
+				err = msgp.WrapError(err, "StraddleSeat")
+				return
+			}
+		case "rake":
+			z.Rake, err = dc.ReadFloat64()
+			if err != nil {
+				err = msgp.WrapError(err, "Rake")
+				return
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -2559,9 +2810,9 @@ func (z *HandStart) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *HandStart) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 8
+	// map header, size 14
 	// write "type"
-	err = en.Append(0x88, 0xa4, 0x74, 0x79, 0x70, 0x65)
+	err = en.Append(0x8e, 0xa4, 0x74, 0x79, 0x70, 0x65)
 	if err != nil {
 		return
 	}
@@ -2654,15 +2905,75 @@ func (z *HandStart) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "BigBlind")
 		return
 	}
+	// write "variant"
+	err = en.Append(0xa7, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Variant)
+	if err != nil {
+		err = msgp.WrapError(err, "Variant")
+		return
+	}
+	// write "betting_limit"
+	err = en.Append(0xad, 0x62, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.BettingLimit)
+	if err != nil {
+		err = msgp.WrapError(err, "BettingLimit")
+		return
+	}
+	// write "ante"
+	err = en.Append(0xa4, 0x61, 0x6e, 0x74, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(z.Ante)
+	if err != nil {
+		err = msgp.WrapError(err, "Ante")
+		return
+	}
+	// write "straddle"
+	err = en.Append(0xa8, 0x73, 0x74, 0x72, 0x61, 0x64, 0x64, 0x6c, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(z.Straddle)
+	if err != nil {
+		err = msgp.WrapError(err, "Straddle")
+		return
+	}
+	// write "straddle_seat"
+	err = en.Append(0xad, 0x73, 0x74, 0x72, 0x61, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x61, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(z.StraddleSeat)
+	if err != nil {
+		err = msgp.WrapError(err, "StraddleSeat")
+		return
+	}
+	// write "rake"
+	err = en.Append(0xa4, 0x72, 0x61, 0x6b, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteFloat64(z.Rake)
+	if err != nil {
+		err = msgp.WrapError(err, "Rake")
+		return
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *HandStart) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 8
+	// map header, size 14
 	// string "type"
-	o = append(o, 0x88, 0xa4, 0x74, 0x79, 0x70, 0x65)
+	o = append(o, 0x8e, 0xa4, 0x74, 0x79, 0x70, 0x65)
 	o = msgp.AppendString(o, z.Type)
 	// string "hand_id"
 	o = append(o, 0xa7, 0x68, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64)
@@ -2695,6 +3006,24 @@ func (z *HandStart) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "big_blind"
 	o = append(o, 0xa9, 0x62, 0x69, 0x67, 0x5f, 0x62, 0x6c, 0x69, 0x6e, 0x64)
 	o = msgp.AppendInt(o, z.BigBlind)
+	// string "variant"
+	o = append(o, 0xa7, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74)
+	o = msgp.AppendString(o, z.Variant)
+	// string "betting_limit"
+	o = append(o, 0xad, 0x62, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74)
+	o = msgp.AppendString(o, z.BettingLimit)
+	// string "ante"
+	o = append(o, 0xa4, 0x61, 0x6e, 0x74, 0x65)
+	o = msgp.AppendInt(o, z.Ante)
+	// string "straddle"
+	o = append(o, 0xa8, 0x73, 0x74, 0x72, 0x61, 0x64, 0x64, 0x6c, 0x65)
+	o = msgp.AppendInt(o, z.Straddle)
+	// string "straddle_seat"
+	o = append(o, 0xad, 0x73, 0x74, 0x72, 0x61, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x61, 0x74)
+	o = msgp.AppendInt(o, z.StraddleSeat)
+	// string "rake"
+	o = append(o, 0xa4, 0x72, 0x61, 0x6b, 0x65)
+	o = msgp.AppendFloat64(o, z.Rake)
 	return
 }
 
@@ -2790,6 +3119,42 @@ func (z *HandStart) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "BigBlind")
 				return
 			}
+		case "variant":
+			z.Variant, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Variant")
+				return
+			}
+		case "betting_limit":
+			z.BettingLimit, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "BettingLimit")
+				return
+			}
+		case "ante":
+			z.Ante, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Ante")
+				return
+			}
+		case "straddle":
+			z.Straddle, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Straddle")
+				return
+			}
+		case "straddle_seat":
+			z.StraddleSeat, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "StraddleSeat")
+				return
+			}
+		case "rake":
+			z.Rake, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Rake")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -2812,7 +3177,7 @@ func (z *HandStart) Msgsize() (s int) {
 	for za0002 := range z.Players {
 		s += z.Players[za0002].Msgsize()
 	}
-	s += 12 + msgp.IntSize + 10 + msgp.IntSize
+	s += 12 + msgp.IntSize + 10 + msgp.IntSize + 8 + msgp.StringPrefixSize + len(z.Variant) + 14 + msgp.StringPrefixSize + len(z.BettingLimit) + 5 + msgp.IntSize + 9 + msgp.IntSize + 14 + msgp.IntSize + 5 + msgp.Float64Size
 	return
 }
 
@@ -2922,6 +3287,12 @@ func (z *Player) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "AllIn")
 				return
 			}
+		case "dead_blind":
+			z.DeadBlind, err = dc.ReadInt()
+			if err != nil {
+				err = msgp.WrapError(err, "DeadBlind")
+				return
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -2936,8 +3307,8 @@ func (z *Player) DecodeMsg(dc *msgp.Reader) (err error) {
 // EncodeMsg implements msgp.Encodable
 func (z *Player) EncodeMsg(en *msgp.Writer) (err error) {
 	// check for omitted fields
-	zb0001Len := uint32(6)
-	var zb0001Mask uint8 /* 6 bits */
+	zb0001Len := uint32(7)
+	var zb0001Mask uint8 /* 7 bits */
 	_ = zb0001Mask
 	if z.Bet == 0 {
 		zb0001Len--
@@ -2951,6 +3322,10 @@ func (z *Player) EncodeMsg(en *msgp.Writer) (err error) {
 		zb0001Len--
 		zb0001Mask |= 0x20
 	}
+	if z.DeadBlind == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x40
+	}
 	// variable map header, size zb0001Len
 	err = en.Append(0x80 | uint8(zb0001Len))
 	if err != nil {
@@ -3025,6 +3400,18 @@ func (z *Player) EncodeMsg(en *msgp.Writer) (err error) {
 				return
 			}
 		}
+		if (zb0001Mask & 0x40) == 0 { // if not omitted
+			// write "dead_blind"
+			err = en.Append(0xaa, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x69, 0x6e, 0x64)
+			if err != nil {
+				return
+			}
+			err = en.WriteInt(z.DeadBlind)
+			if err != nil {
+				err = msgp.WrapError(err, "DeadBlind")
+				return
+			}
+		}
 	}
 	return
 }
@@ -3033,8 +3420,8 @@ func (z *Player) EncodeMsg(en *msgp.Writer) (err error) {
 func (z *Player) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
 	// check for omitted fields
-	zb0001Len := uint32(6)
-	var zb0001Mask uint8 /* 6 bits */
+	zb0001Len := uint32(7)
+	var zb0001Mask uint8 /* 7 bits */
 	_ = zb0001Mask
 	if z.Bet == 0 {
 		zb0001Len--
@@ -3048,6 +3435,10 @@ func (z *Player) MarshalMsg(b []byte) (o []byte, err error) {
 		zb0001Len--
 		zb0001Mask |= 0x20
 	}
+	if z.DeadBlind == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x40
+	}
 	// variable map header, size zb0001Len
 	o = append(o, 0x80|uint8(zb0001Len))
 
@@ -3077,6 +3468,11 @@ func (z *Player) MarshalMsg(b []byte) (o []byte, err error) {
 			o = append(o, 0xa6, 0x61, 0x6c, 0x6c, 0x5f, 0x69, 0x6e)
 			o = msgp.AppendBool(o, z.AllIn)
 		}
+		if (zb0001Mask & 0x40) == 0 { // if not omitted
+			// string "dead_blind"
+			o = append(o, 0xaa, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x69, 0x6e, 0x64)
+			o = msgp.AppendInt(o, z.DeadBlind)
+		}
 	}
 	return
 }
@@ -3135,6 +3531,12 @@ func (z *Player) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "AllIn")
 				return
 			}
+		case "dead_blind":
+			z.DeadBlind, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "DeadBlind")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -3149,7 +3551,7 @@ func (z *Player) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *Player) Msgsize() (s int) {
-	s = 1 + 5 + msgp.IntSize + 5 + msgp.StringPrefixSize + len(z.Name) + 6 + msgp.IntSize + 4 + msgp.IntSize + 7 + msgp.BoolSize + 7 + msgp.BoolSize
+	s = 1 + 5 + msgp.IntSize + 5 + msgp.StringPrefixSize + len(z.Name) + 6 + msgp.IntSize + 4 + msgp.IntSize + 7 + msgp.BoolSize + 7 + msgp.BoolSize + 11 + msgp.IntSize
 	return
 }
 
@@ -3231,6 +3633,12 @@ func (z *PlayerAction) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "Pot")
 				return
 			}
+		case "context":
+			z.Context, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Context")
+				return
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -3244,106 +3652,134 @@ func (z *PlayerAction) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *PlayerAction) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 10
-	// write "type"
-	err = en.Append(0x8a, 0xa4, 0x74, 0x79, 0x70, 0x65)
-	if err != nil {
-		return
-	}
-	err = en.WriteString(z.Type)
-	if err != nil {
-		err = msgp.WrapError(err, "Type")
-		return
-	}
-	// write "hand_id"
-	err = en.Append(0xa7, 0x68, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64)
-	if err != nil {
-		return
-	}
-	err = en.WriteString(z.HandID)
-	if err != nil {
-		err = msgp.WrapError(err, "HandID")
-		return
-	}
-	// write "street"
-	err = en.Append(0xa6, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74)
-	if err != nil {
-		return
-	}
-	err = en.WriteString(z.Street)
-	if err != nil {
-		err = msgp.WrapError(err, "Street")
-		return
-	}
-	// write "seat"
-	err = en.Append(0xa4, 0x73, 0x65, 0x61, 0x74)
-	if err != nil {
-		return
-	}
-	err = en.WriteInt(z.Seat)
-	if err != nil {
-		err = msgp.WrapError(err, "Seat")
-		return
-	}
-	// write "player_name"
-	err = en.Append(0xab, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65)
-	if err != nil {
-		return
+	// check for omitted fields
+	zb0001Len := uint32(11)
+	var zb0001Mask uint16 /* 11 bits */
+	_ = zb0001Mask
+	if z.Context == "" {
+		zb0001Len--
+		zb0001Mask |= 0x400
 	}
-	err = en.WriteString(z.PlayerName)
+	// variable map header, size zb0001Len
+	err = en.Append(0x80 | uint8(zb0001Len))
 	if err != nil {
-		err = msgp.WrapError(err, "PlayerName")
 		return
 	}
-	// write "action"
-	err = en.Append(0xa6, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e)
-	if err != nil {
-		return
-	}
-	err = en.WriteString(z.Action)
-	if err != nil {
-		err = msgp.WrapError(err, "Action")
-		return
-	}
-	// write "amount_paid"
-	err = en.Append(0xab, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x69, 0x64)
-	if err != nil {
-		return
-	}
-	err = en.WriteInt(z.AmountPaid)
-	if err != nil {
-		err = msgp.WrapError(err, "AmountPaid")
-		return
-	}
-	// write "player_bet"
-	err = en.Append(0xaa, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x62, 0x65, 0x74)
-	if err != nil {
-		return
-	}
-	err = en.WriteInt(z.PlayerBet)
-	if err != nil {
-		err = msgp.WrapError(err, "PlayerBet")
-		return
-	}
-	// write "player_chips"
-	err = en.Append(0xac, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x69, 0x70, 0x73)
-	if err != nil {
-		return
-	}
-	err = en.WriteInt(z.PlayerChips)
-	if err != nil {
-		err = msgp.WrapError(err, "PlayerChips")
-		return
-	}
-	// write "pot"
-	err = en.Append(0xa3, 0x70, 0x6f, 0x74)
-	if err != nil {
-		return
-	}
-	err = en.WriteInt(z.Pot)
-	if err != nil {
-		err = msgp.WrapError(err, "Pot")
-		return
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// write "type"
+		err = en.Append(0xa4, 0x74, 0x79, 0x70, 0x65)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Type)
+		if err != nil {
+			err = msgp.WrapError(err, "Type")
+			return
+		}
+		// write "hand_id"
+		err = en.Append(0xa7, 0x68, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.HandID)
+		if err != nil {
+			err = msgp.WrapError(err, "HandID")
+			return
+		}
+		// write "street"
+		err = en.Append(0xa6, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Street)
+		if err != nil {
+			err = msgp.WrapError(err, "Street")
+			return
+		}
+		// write "seat"
+		err = en.Append(0xa4, 0x73, 0x65, 0x61, 0x74)
+		if err != nil {
+			return
+		}
+		err = en.WriteInt(z.Seat)
+		if err != nil {
+			err = msgp.WrapError(err, "Seat")
+			return
+		}
+		// write "player_name"
+		err = en.Append(0xab, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.PlayerName)
+		if err != nil {
+			err = msgp.WrapError(err, "PlayerName")
+			return
+		}
+		// write "action"
+		err = en.Append(0xa6, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Action)
+		if err != nil {
+			err = msgp.WrapError(err, "Action")
+			return
+		}
+		// write "amount_paid"
+		err = en.Append(0xab, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x69, 0x64)
+		if err != nil {
+			return
+		}
+		err = en.WriteInt(z.AmountPaid)
+		if err != nil {
+			err = msgp.WrapError(err, "AmountPaid")
+			return
+		}
+		// write "player_bet"
+		err = en.Append(0xaa, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x62, 0x65, 0x74)
+		if err != nil {
+			return
+		}
+		err = en.WriteInt(z.PlayerBet)
+		if err != nil {
+			err = msgp.WrapError(err, "PlayerBet")
+			return
+		}
+		// write "player_chips"
+		err = en.Append(0xac, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x69, 0x70, 0x73)
+		if err != nil {
+			return
+		}
+		err = en.WriteInt(z.PlayerChips)
+		if err != nil {
+			err = msgp.WrapError(err, "PlayerChips")
+			return
+		}
+		// write "pot"
+		err = en.Append(0xa3, 0x70, 0x6f, 0x74)
+		if err != nil {
+			return
+		}
+		err = en.WriteInt(z.Pot)
+		if err != nil {
+			err = msgp.WrapError(err, "Pot")
+			return
+		}
+		if (zb0001Mask & 0x400) == 0 { // if not omitted
+			// write "context"
+			err = en.Append(0xa7, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.Context)
+			if err != nil {
+				err = msgp.WrapError(err, "Context")
+				return
+			}
+		}
 	}
 	return
 }
@@ -3351,37 +3787,55 @@ func (z *PlayerAction) EncodeMsg(en *msgp.Writer) (err error) {
 // MarshalMsg implements msgp.Marshaler
 func (z *PlayerAction) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 10
-	// string "type"
-	o = append(o, 0x8a, 0xa4, 0x74, 0x79, 0x70, 0x65)
-	o = msgp.AppendString(o, z.Type)
-	// string "hand_id"
-	o = append(o, 0xa7, 0x68, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64)
-	o = msgp.AppendString(o, z.HandID)
-	// string "street"
-	o = append(o, 0xa6, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74)
-	o = msgp.AppendString(o, z.Street)
-	// string "seat"
-	o = append(o, 0xa4, 0x73, 0x65, 0x61, 0x74)
-	o = msgp.AppendInt(o, z.Seat)
-	// string "player_name"
-	o = append(o, 0xab, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65)
-	o = msgp.AppendString(o, z.PlayerName)
-	// string "action"
-	o = append(o, 0xa6, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e)
-	o = msgp.AppendString(o, z.Action)
-	// string "amount_paid"
-	o = append(o, 0xab, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x69, 0x64)
-	o = msgp.AppendInt(o, z.AmountPaid)
-	// string "player_bet"
-	o = append(o, 0xaa, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x62, 0x65, 0x74)
-	o = msgp.AppendInt(o, z.PlayerBet)
-	// string "player_chips"
-	o = append(o, 0xac, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x69, 0x70, 0x73)
-	o = msgp.AppendInt(o, z.PlayerChips)
-	// string "pot"
-	o = append(o, 0xa3, 0x70, 0x6f, 0x74)
-	o = msgp.AppendInt(o, z.Pot)
+	// check for omitted fields
+	zb0001Len := uint32(11)
+	var zb0001Mask uint16 /* 11 bits */
+	_ = zb0001Mask
+	if z.Context == "" {
+		zb0001Len--
+		zb0001Mask |= 0x400
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// string "type"
+		o = append(o, 0xa4, 0x74, 0x79, 0x70, 0x65)
+		o = msgp.AppendString(o, z.Type)
+		// string "hand_id"
+		o = append(o, 0xa7, 0x68, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64)
+		o = msgp.AppendString(o, z.HandID)
+		// string "street"
+		o = append(o, 0xa6, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74)
+		o = msgp.AppendString(o, z.Street)
+		// string "seat"
+		o = append(o, 0xa4, 0x73, 0x65, 0x61, 0x74)
+		o = msgp.AppendInt(o, z.Seat)
+		// string "player_name"
+		o = append(o, 0xab, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65)
+		o = msgp.AppendString(o, z.PlayerName)
+		// string "action"
+		o = append(o, 0xa6, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e)
+		o = msgp.AppendString(o, z.Action)
+		// string "amount_paid"
+		o = append(o, 0xab, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x69, 0x64)
+		o = msgp.AppendInt(o, z.AmountPaid)
+		// string "player_bet"
+		o = append(o, 0xaa, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x62, 0x65, 0x74)
+		o = msgp.AppendInt(o, z.PlayerBet)
+		// string "player_chips"
+		o = append(o, 0xac, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x69, 0x70, 0x73)
+		o = msgp.AppendInt(o, z.PlayerChips)
+		// string "pot"
+		o = append(o, 0xa3, 0x70, 0x6f, 0x74)
+		o = msgp.AppendInt(o, z.Pot)
+		if (zb0001Mask & 0x400) == 0 { // if not omitted
+			// string "context"
+			o = append(o, 0xa7, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74)
+			o = msgp.AppendString(o, z.Context)
+		}
+	}
 	return
 }
 
@@ -3463,6 +3917,12 @@ func (z *PlayerAction) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "Pot")
 				return
 			}
+		case "context":
+			z.Context, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Context")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -3477,7 +3937,7 @@ func (z *PlayerAction) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *PlayerAction) Msgsize() (s int) {
-	s = 1 + 5 + msgp.StringPrefixSize + len(z.Type) + 8 + msgp.StringPrefixSize + len(z.HandID) + 7 + msgp.StringPrefixSize + len(z.Street) + 5 + msgp.IntSize + 12 + msgp.StringPrefixSize + len(z.PlayerName) + 7 + msgp.StringPrefixSize + len(z.Action) + 12 + msgp.IntSize + 11 + msgp.IntSize + 13 + msgp.IntSize + 4 + msgp.IntSize
+	s = 1 + 5 + msgp.StringPrefixSize + len(z.Type) + 8 + msgp.StringPrefixSize + len(z.HandID) + 7 + msgp.StringPrefixSize + len(z.Street) + 5 + msgp.IntSize + 12 + msgp.StringPrefixSize + len(z.PlayerName) + 7 + msgp.StringPrefixSize + len(z.Action) + 12 + msgp.IntSize + 11 + msgp.IntSize + 13 + msgp.IntSize + 4 + msgp.IntSize + 8 + msgp.StringPrefixSize + len(z.Context)
 	return
 }
 
@@ -3589,6 +4049,40 @@ func (z *PlayerDetailedStats) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "NonShowdownBB")
 				return
 			}
+		case "showdown_rate":
+			z.ShowdownRate, err = dc.ReadFloat64()
+			if err != nil {
+				err = msgp.WrapError(err, "ShowdownRate")
+				return
+			}
+		case "street_reached":
+			var zb0002 uint32
+			zb0002, err = dc.ReadMapHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "StreetReached")
+				return
+			}
+			if z.StreetReached == nil {
+				z.StreetReached = make(map[string]float64, zb0002)
+			} else if len(z.StreetReached) > 0 {
+				clear(z.StreetReached)
+			}
+			for zb0002 > 0 {
+				zb0002--
+				var za0001 string
+				za0001, err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "StreetReached")
+					return
+				}
+				var za0002 float64
+				za0002, err = dc.ReadFloat64()
+				if err != nil {
+					err = msgp.WrapError(err, "StreetReached", za0001)
+					return
+				}
+				z.StreetReached[za0001] = za0002
+			}
 		case "max_pot_bb":
 			z.MaxPotBB, err = dc.ReadFloat64()
 			if err != nil {
@@ -3674,193 +4168,221 @@ func (z *PlayerDetailedStats) DecodeMsg(dc *msgp.Reader) (err error) {
 				return
 			}
 		case "position_stats":
-			var zb0002 uint32
-			zb0002, err = dc.ReadMapHeader()
+			var zb0003 uint32
+			zb0003, err = dc.ReadMapHeader()
 			if err != nil {
 				err = msgp.WrapError(err, "PositionStats")
 				return
 			}
 			if z.PositionStats == nil {
-				z.PositionStats = make(map[string]PositionStatSummary, zb0002)
+				z.PositionStats = make(map[string]PositionStatSummary, zb0003)
 			} else if len(z.PositionStats) > 0 {
 				clear(z.PositionStats)
 			}
-			for zb0002 > 0 {
-				zb0002--
-				var za0001 string
-				za0001, err = dc.ReadString()
+			for zb0003 > 0 {
+				zb0003--
+				var za0003 string
+				za0003, err = dc.ReadString()
 				if err != nil {
 					err = msgp.WrapError(err, "PositionStats")
 					return
 				}
-				var za0002 PositionStatSummary
-				var zb0003 uint32
-				zb0003, err = dc.ReadMapHeader()
+				var za0004 PositionStatSummary
+				var zb0004 uint32
+				zb0004, err = dc.ReadMapHeader()
 				if err != nil {
-					err = msgp.WrapError(err, "PositionStats", za0001)
+					err = msgp.WrapError(err, "PositionStats", za0003)
 					return
 				}
-				for zb0003 > 0 {
-					zb0003--
+				for zb0004 > 0 {
+					zb0004--
 					field, err = dc.ReadMapKeyPtr()
 					if err != nil {
-						err = msgp.WrapError(err, "PositionStats", za0001)
+						err = msgp.WrapError(err, "PositionStats", za0003)
 						return
 					}
 					switch msgp.UnsafeString(field) {
 					case "hands":
-						za0002.Hands, err = dc.ReadInt()
+						za0004.Hands, err = dc.ReadInt()
 						if err != nil {
-							err = msgp.WrapError(err, "PositionStats", za0001, "Hands")
+							err = msgp.WrapError(err, "PositionStats", za0003, "Hands")
 							return
 						}
 					case "net_bb":
-						za0002.NetBB, err = dc.ReadFloat64()
+						za0004.NetBB, err = dc.ReadFloat64()
 						if err != nil {
-							err = msgp.WrapError(err, "PositionStats", za0001, "NetBB")
+							err = msgp.WrapError(err, "PositionStats", za0003, "NetBB")
 							return
 						}
 					case "bb_per_hand":
-						za0002.BBPerHand, err = dc.ReadFloat64()
+						za0004.BBPerHand, err = dc.ReadFloat64()
 						if err != nil {
-							err = msgp.WrapError(err, "PositionStats", za0001, "BBPerHand")
+							err = msgp.WrapError(err, "PositionStats", za0003, "BBPerHand")
 							return
 						}
 					default:
 						err = dc.Skip()
 						if err != nil {
-							err = msgp.WrapError(err, "PositionStats", za0001)
+							err = msgp.WrapError(err, "PositionStats", za0003)
 							return
 						}
 					}
 				}
-				z.PositionStats[za0001] = za0002
+				z.PositionStats[za0003] = za0004
 			}
 		case "street_stats":
-			var zb0004 uint32
-			zb0004, err = dc.ReadMapHeader()
+			var zb0005 uint32
+			zb0005, err = dc.ReadMapHeader()
 			if err != nil {
 				err = msgp.WrapError(err, "StreetStats")
 				return
 			}
 			if z.StreetStats == nil {
-				z.StreetStats = make(map[string]StreetStatSummary, zb0004)
+				z.StreetStats = make(map[string]StreetStatSummary, zb0005)
 			} else if len(z.StreetStats) > 0 {
 				clear(z.StreetStats)
 			}
-			for zb0004 > 0 {
-				zb0004--
-				var za0003 string
-				za0003, err = dc.ReadString()
+			for zb0005 > 0 {
+				zb0005--
+				var za0005 string
+				za0005, err = dc.ReadString()
 				if err != nil {
 					err = msgp.WrapError(err, "StreetStats")
 					return
 				}
-				var za0004 StreetStatSummary
-				var zb0005 uint32
-				zb0005, err = dc.ReadMapHeader()
+				var za0006 StreetStatSummary
+				var zb0006 uint32
+				zb0006, err = dc.ReadMapHeader()
 				if err != nil {
-					err = msgp.WrapError(err, "StreetStats", za0003)
+					err = msgp.WrapError(err, "StreetStats", za0005)
 					return
 				}
-				for zb0005 > 0 {
-					zb0005--
+				for zb0006 > 0 {
+					zb0006--
 					field, err = dc.ReadMapKeyPtr()
 					if err != nil {
-						err = msgp.WrapError(err, "StreetStats", za0003)
+						err = msgp.WrapError(err, "StreetStats", za0005)
 						return
 					}
 					switch msgp.UnsafeString(field) {
 					case "hands_ended":
-						za0004.HandsEnded, err = dc.ReadInt()
+						za0006.HandsEnded, err = dc.ReadInt()
 						if err != nil {
-							err = msgp.WrapError(err, "StreetStats", za0003, "HandsEnded")
+							err = msgp.WrapError(err, "StreetStats", za0005, "HandsEnded")
 							return
 						}
 					case "net_bb":
-						za0004.NetBB, err = dc.ReadFloat64()
+						za0006.NetBB, err = dc.ReadFloat64()
 						if err != nil {
-							err = msgp.WrapError(err, "StreetStats", za0003, "NetBB")
+							err = msgp.WrapError(err, "StreetStats", za0005, "NetBB")
 							return
 						}
 					case "bb_per_hand":
-						za0004.BBPerHand, err = dc.ReadFloat64()
+						za0006.BBPerHand, err = dc.ReadFloat64()
 						if err != nil {
-							err = msgp.WrapError(err, "StreetStats", za0003, "BBPerHand")
+							err = msgp.WrapError(err, "StreetStats", za0005, "BBPerHand")
 							return
 						}
 					default:
 						err = dc.Skip()
 						if err != nil {
-							err = msgp.WrapError(err, "StreetStats", za0003)
+							err = msgp.WrapError(err, "StreetStats", za0005)
 							return
 						}
 					}
 				}
-				z.StreetStats[za0003] = za0004
+				z.StreetStats[za0005] = za0006
 			}
 		case "hand_category_stats":
-			var zb0006 uint32
-			zb0006, err = dc.ReadMapHeader()
+			var zb0007 uint32
+			zb0007, err = dc.ReadMapHeader()
 			if err != nil {
 				err = msgp.WrapError(err, "HandCategoryStats")
 				return
 			}
 			if z.HandCategoryStats == nil {
-				z.HandCategoryStats = make(map[string]CategoryStatSummary, zb0006)
+				z.HandCategoryStats = make(map[string]CategoryStatSummary, zb0007)
 			} else if len(z.HandCategoryStats) > 0 {
 				clear(z.HandCategoryStats)
 			}
-			for zb0006 > 0 {
-				zb0006--
-				var za0005 string
-				za0005, err = dc.ReadString()
+			for zb0007 > 0 {
+				zb0007--
+				var za0007 string
+				za0007, err = dc.ReadString()
 				if err != nil {
 					err = msgp.WrapError(err, "HandCategoryStats")
 					return
 				}
-				var za0006 CategoryStatSummary
-				var zb0007 uint32
-				zb0007, err = dc.ReadMapHeader()
+				var za0008 CategoryStatSummary
+				var zb0008 uint32
+				zb0008, err = dc.ReadMapHeader()
 				if err != nil {
-					err = msgp.WrapError(err, "HandCategoryStats", za0005)
+					err = msgp.WrapError(err, "HandCategoryStats", za0007)
 					return
 				}
-				for zb0007 > 0 {
-					zb0007--
+				for zb0008 > 0 {
+					zb0008--
 					field, err = dc.ReadMapKeyPtr()
 					if err != nil {
-						err = msgp.WrapError(err, "HandCategoryStats", za0005)
+						err = msgp.WrapError(err, "HandCategoryStats", za0007)
 						return
 					}
 					switch msgp.UnsafeString(field) {
 					case "hands":
-						za0006.Hands, err = dc.ReadInt()
+						za0008.Hands, err = dc.ReadInt()
 						if err != nil {
-							err = msgp.WrapError(err, "HandCategoryStats", za0005, "Hands")
+							err = msgp.WrapError(err, "HandCategoryStats", za0007, "Hands")
 							return
 						}
 					case "net_bb":
-						za0006.NetBB, err = dc.ReadFloat64()
+						za0008.NetBB, err = dc.ReadFloat64()
 						if err != nil {
-							err = msgp.WrapError(err, "HandCategoryStats", za0005, "NetBB")
+							err = msgp.WrapError(err, "HandCategoryStats", za0007, "NetBB")
 							return
 						}
 					case "bb_per_hand":
-						za0006.BBPerHand, err = dc.ReadFloat64()
+						za0008.BBPerHand, err = dc.ReadFloat64()
 						if err != nil {
-							err = msgp.WrapError(err, "HandCategoryStats", za0005, "BBPerHand")
+							err = msgp.WrapError(err, "HandCategoryStats", za0007, "BBPerHand")
 							return
 						}
 					default:
 						err = dc.Skip()
 						if err != nil {
-							err = msgp.WrapError(err, "HandCategoryStats", za0005)
+							err = msgp.WrapError(err, "HandCategoryStats", za0007)
 							return
 						}
 					}
 				}
-				z.HandCategoryStats[za0005] = za0006
+				z.HandCategoryStats[za0007] = za0008
+			}
+		case "showdown_wins_by_hand":
+			var zb0009 uint32
+			zb0009, err = dc.ReadMapHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "ShowdownWinsByHand")
+				return
+			}
+			if z.ShowdownWinsByHand == nil {
+				z.ShowdownWinsByHand = make(map[string]int, zb0009)
+			} else if len(z.ShowdownWinsByHand) > 0 {
+				clear(z.ShowdownWinsByHand)
+			}
+			for zb0009 > 0 {
+				zb0009--
+				var za0009 string
+				za0009, err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "ShowdownWinsByHand")
+					return
+				}
+				var za0010 int
+				za0010, err = dc.ReadInt()
+				if err != nil {
+					err = msgp.WrapError(err, "ShowdownWinsByHand", za0009)
+					return
+				}
+				z.ShowdownWinsByHand[za0009] = za0010
 			}
 		default:
 			err = dc.Skip()
@@ -3876,20 +4398,28 @@ func (z *PlayerDetailedStats) DecodeMsg(dc *msgp.Reader) (err error) {
 // EncodeMsg implements msgp.Encodable
 func (z *PlayerDetailedStats) EncodeMsg(en *msgp.Writer) (err error) {
 	// check for omitted fields
-	zb0001Len := uint32(32)
-	var zb0001Mask uint32 /* 32 bits */
+	zb0001Len := uint32(35)
+	var zb0001Mask uint64 /* 35 bits */
 	_ = zb0001Mask
+	if z.StreetReached == nil {
+		zb0001Len--
+		zb0001Mask |= 0x10000
+	}
 	if z.PositionStats == nil {
 		zb0001Len--
-		zb0001Mask |= 0x20000000
+		zb0001Mask |= 0x80000000
 	}
 	if z.StreetStats == nil {
 		zb0001Len--
-		zb0001Mask |= 0x40000000
+		zb0001Mask |= 0x100000000
 	}
 	if z.HandCategoryStats == nil {
 		zb0001Len--
-		zb0001Mask |= 0x80000000
+		zb0001Mask |= 0x200000000
+	}
+	if z.ShowdownWinsByHand == nil {
+		zb0001Len--
+		zb0001Mask |= 0x400000000
 	}
 	// variable map header, size zb0001Len
 	err = en.WriteMapHeader(zb0001Len)
@@ -4049,6 +4579,40 @@ func (z *PlayerDetailedStats) EncodeMsg(en *msgp.Writer) (err error) {
 			err = msgp.WrapError(err, "NonShowdownBB")
 			return
 		}
+		// write "showdown_rate"
+		err = en.Append(0xad, 0x73, 0x68, 0x6f, 0x77, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65)
+		if err != nil {
+			return
+		}
+		err = en.WriteFloat64(z.ShowdownRate)
+		if err != nil {
+			err = msgp.WrapError(err, "ShowdownRate")
+			return
+		}
+		if (zb0001Mask & 0x10000) == 0 { // if not omitted
+			// write "street_reached"
+			err = en.Append(0xae, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64)
+			if err != nil {
+				return
+			}
+			err = en.WriteMapHeader(uint32(len(z.StreetReached)))
+			if err != nil {
+				err = msgp.WrapError(err, "StreetReached")
+				return
+			}
+			for za0001, za0002 := range z.StreetReached {
+				err = en.WriteString(za0001)
+				if err != nil {
+					err = msgp.WrapError(err, "StreetReached")
+					return
+				}
+				err = en.WriteFloat64(za0002)
+				if err != nil {
+					err = msgp.WrapError(err, "StreetReached", za0001)
+					return
+				}
+			}
+		}
 		// write "max_pot_bb"
 		err = en.Append(0xaa, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6f, 0x74, 0x5f, 0x62, 0x62)
 		if err != nil {
@@ -4189,7 +4753,7 @@ func (z *PlayerDetailedStats) EncodeMsg(en *msgp.Writer) (err error) {
 			err = msgp.WrapError(err, "ResponseDisconnects")
 			return
 		}
-		if (zb0001Mask & 0x20000000) == 0 { // if not omitted
+		if (zb0001Mask & 0x80000000) == 0 { // if not omitted
 			// write "position_stats"
 			err = en.Append(0xae, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73)
 			if err != nil {
@@ -4200,8 +4764,8 @@ func (z *PlayerDetailedStats) EncodeMsg(en *msgp.Writer) (err error) {
 				err = msgp.WrapError(err, "PositionStats")
 				return
 			}
-			for za0001, za0002 := range z.PositionStats {
-				err = en.WriteString(za0001)
+			for za0003, za0004 := range z.PositionStats {
+				err = en.WriteString(za0003)
 				if err != nil {
 					err = msgp.WrapError(err, "PositionStats")
 					return
@@ -4212,9 +4776,9 @@ func (z *PlayerDetailedStats) EncodeMsg(en *msgp.Writer) (err error) {
 				if err != nil {
 					return
 				}
-				err = en.WriteInt(za0002.Hands)
+				err = en.WriteInt(za0004.Hands)
 				if err != nil {
-					err = msgp.WrapError(err, "PositionStats", za0001, "Hands")
+					err = msgp.WrapError(err, "PositionStats", za0003, "Hands")
 					return
 				}
 				// write "net_bb"
@@ -4222,9 +4786,9 @@ func (z *PlayerDetailedStats) EncodeMsg(en *msgp.Writer) (err error) {
 				if err != nil {
 					return
 				}
-				err = en.WriteFloat64(za0002.NetBB)
+				err = en.WriteFloat64(za0004.NetBB)
 				if err != nil {
-					err = msgp.WrapError(err, "PositionStats", za0001, "NetBB")
+					err = msgp.WrapError(err, "PositionStats", za0003, "NetBB")
 					return
 				}
 				// write "bb_per_hand"
@@ -4232,14 +4796,14 @@ func (z *PlayerDetailedStats) EncodeMsg(en *msgp.Writer) (err error) {
 				if err != nil {
 					return
 				}
-				err = en.WriteFloat64(za0002.BBPerHand)
+				err = en.WriteFloat64(za0004.BBPerHand)
 				if err != nil {
-					err = msgp.WrapError(err, "PositionStats", za0001, "BBPerHand")
+					err = msgp.WrapError(err, "PositionStats", za0003, "BBPerHand")
 					return
 				}
 			}
 		}
-		if (zb0001Mask & 0x40000000) == 0 { // if not omitted
+		if (zb0001Mask & 0x100000000) == 0 { // if not omitted
 			// write "street_stats"
 			err = en.Append(0xac, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73)
 			if err != nil {
@@ -4250,8 +4814,8 @@ func (z *PlayerDetailedStats) EncodeMsg(en *msgp.Writer) (err error) {
 				err = msgp.WrapError(err, "StreetStats")
 				return
 			}
-			for za0003, za0004 := range z.StreetStats {
-				err = en.WriteString(za0003)
+			for za0005, za0006 := range z.StreetStats {
+				err = en.WriteString(za0005)
 				if err != nil {
 					err = msgp.WrapError(err, "StreetStats")
 					return
@@ -4262,9 +4826,9 @@ func (z *PlayerDetailedStats) EncodeMsg(en *msgp.Writer) (err error) {
 				if err != nil {
 					return
 				}
-				err = en.WriteInt(za0004.HandsEnded)
+				err = en.WriteInt(za0006.HandsEnded)
 				if err != nil {
-					err = msgp.WrapError(err, "StreetStats", za0003, "HandsEnded")
+					err = msgp.WrapError(err, "StreetStats", za0005, "HandsEnded")
 					return
 				}
 				// write "net_bb"
@@ -4272,9 +4836,9 @@ func (z *PlayerDetailedStats) EncodeMsg(en *msgp.Writer) (err error) {
 				if err != nil {
 					return
 				}
-				err = en.WriteFloat64(za0004.NetBB)
+				err = en.WriteFloat64(za0006.NetBB)
 				if err != nil {
-					err = msgp.WrapError(err, "StreetStats", za0003, "NetBB")
+					err = msgp.WrapError(err, "StreetStats", za0005, "NetBB")
 					return
 				}
 				// write "bb_per_hand"
@@ -4282,14 +4846,14 @@ func (z *PlayerDetailedStats) EncodeMsg(en *msgp.Writer) (err error) {
 				if err != nil {
 					return
 				}
-				err = en.WriteFloat64(za0004.BBPerHand)
+				err = en.WriteFloat64(za0006.BBPerHand)
 				if err != nil {
-					err = msgp.WrapError(err, "StreetStats", za0003, "BBPerHand")
+					err = msgp.WrapError(err, "StreetStats", za0005, "BBPerHand")
 					return
 				}
 			}
 		}
-		if (zb0001Mask & 0x80000000) == 0 { // if not omitted
+		if (zb0001Mask & 0x200000000) == 0 { // if not omitted
 			// write "hand_category_stats"
 			err = en.Append(0xb3, 0x68, 0x61, 0x6e, 0x64, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73)
 			if err != nil {
@@ -4300,8 +4864,8 @@ func (z *PlayerDetailedStats) EncodeMsg(en *msgp.Writer) (err error) {
 				err = msgp.WrapError(err, "HandCategoryStats")
 				return
 			}
-			for za0005, za0006 := range z.HandCategoryStats {
-				err = en.WriteString(za0005)
+			for za0007, za0008 := range z.HandCategoryStats {
+				err = en.WriteString(za0007)
 				if err != nil {
 					err = msgp.WrapError(err, "HandCategoryStats")
 					return
@@ -4312,9 +4876,9 @@ func (z *PlayerDetailedStats) EncodeMsg(en *msgp.Writer) (err error) {
 				if err != nil {
 					return
 				}
-				err = en.WriteInt(za0006.Hands)
+				err = en.WriteInt(za0008.Hands)
 				if err != nil {
-					err = msgp.WrapError(err, "HandCategoryStats", za0005, "Hands")
+					err = msgp.WrapError(err, "HandCategoryStats", za0007, "Hands")
 					return
 				}
 				// write "net_bb"
@@ -4322,9 +4886,9 @@ func (z *PlayerDetailedStats) EncodeMsg(en *msgp.Writer) (err error) {
 				if err != nil {
 					return
 				}
-				err = en.WriteFloat64(za0006.NetBB)
+				err = en.WriteFloat64(za0008.NetBB)
 				if err != nil {
-					err = msgp.WrapError(err, "HandCategoryStats", za0005, "NetBB")
+					err = msgp.WrapError(err, "HandCategoryStats", za0007, "NetBB")
 					return
 				}
 				// write "bb_per_hand"
@@ -4332,9 +4896,33 @@ func (z *PlayerDetailedStats) EncodeMsg(en *msgp.Writer) (err error) {
 				if err != nil {
 					return
 				}
-				err = en.WriteFloat64(za0006.BBPerHand)
+				err = en.WriteFloat64(za0008.BBPerHand)
 				if err != nil {
-					err = msgp.WrapError(err, "HandCategoryStats", za0005, "BBPerHand")
+					err = msgp.WrapError(err, "HandCategoryStats", za0007, "BBPerHand")
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x400000000) == 0 { // if not omitted
+			// write "showdown_wins_by_hand"
+			err = en.Append(0xb5, 0x73, 0x68, 0x6f, 0x77, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x68, 0x61, 0x6e, 0x64)
+			if err != nil {
+				return
+			}
+			err = en.WriteMapHeader(uint32(len(z.ShowdownWinsByHand)))
+			if err != nil {
+				err = msgp.WrapError(err, "ShowdownWinsByHand")
+				return
+			}
+			for za0009, za0010 := range z.ShowdownWinsByHand {
+				err = en.WriteString(za0009)
+				if err != nil {
+					err = msgp.WrapError(err, "ShowdownWinsByHand")
+					return
+				}
+				err = en.WriteInt(za0010)
+				if err != nil {
+					err = msgp.WrapError(err, "ShowdownWinsByHand", za0009)
 					return
 				}
 			}
@@ -4347,20 +4935,28 @@ func (z *PlayerDetailedStats) EncodeMsg(en *msgp.Writer) (err error) {
 func (z *PlayerDetailedStats) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
 	// check for omitted fields
-	zb0001Len := uint32(32)
-	var zb0001Mask uint32 /* 32 bits */
+	zb0001Len := uint32(35)
+	var zb0001Mask uint64 /* 35 bits */
 	_ = zb0001Mask
+	if z.StreetReached == nil {
+		zb0001Len--
+		zb0001Mask |= 0x10000
+	}
 	if z.PositionStats == nil {
 		zb0001Len--
-		zb0001Mask |= 0x20000000
+		zb0001Mask |= 0x80000000
 	}
 	if z.StreetStats == nil {
 		zb0001Len--
-		zb0001Mask |= 0x40000000
+		zb0001Mask |= 0x100000000
 	}
 	if z.HandCategoryStats == nil {
 		zb0001Len--
-		zb0001Mask |= 0x80000000
+		zb0001Mask |= 0x200000000
+	}
+	if z.ShowdownWinsByHand == nil {
+		zb0001Len--
+		zb0001Mask |= 0x400000000
 	}
 	// variable map header, size zb0001Len
 	o = msgp.AppendMapHeader(o, zb0001Len)
@@ -4412,6 +5008,18 @@ func (z *PlayerDetailedStats) MarshalMsg(b []byte) (o []byte, err error) {
 		// string "non_showdown_bb"
 		o = append(o, 0xaf, 0x6e, 0x6f, 0x6e, 0x5f, 0x73, 0x68, 0x6f, 0x77, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x62, 0x62)
 		o = msgp.AppendFloat64(o, z.NonShowdownBB)
+		// string "showdown_rate"
+		o = append(o, 0xad, 0x73, 0x68, 0x6f, 0x77, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65)
+		o = msgp.AppendFloat64(o, z.ShowdownRate)
+		if (zb0001Mask & 0x10000) == 0 { // if not omitted
+			// string "street_reached"
+			o = append(o, 0xae, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64)
+			o = msgp.AppendMapHeader(o, uint32(len(z.StreetReached)))
+			for za0001, za0002 := range z.StreetReached {
+				o = msgp.AppendString(o, za0001)
+				o = msgp.AppendFloat64(o, za0002)
+			}
+		}
 		// string "max_pot_bb"
 		o = append(o, 0xaa, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6f, 0x74, 0x5f, 0x62, 0x62)
 		o = msgp.AppendFloat64(o, z.MaxPotBB)
@@ -4454,58 +5062,67 @@ func (z *PlayerDetailedStats) MarshalMsg(b []byte) (o []byte, err error) {
 		// string "response_disconnects"
 		o = append(o, 0xb4, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73)
 		o = msgp.AppendInt(o, z.ResponseDisconnects)
-		if (zb0001Mask & 0x20000000) == 0 { // if not omitted
+		if (zb0001Mask & 0x80000000) == 0 { // if not omitted
 			// string "position_stats"
 			o = append(o, 0xae, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73)
 			o = msgp.AppendMapHeader(o, uint32(len(z.PositionStats)))
-			for za0001, za0002 := range z.PositionStats {
-				o = msgp.AppendString(o, za0001)
+			for za0003, za0004 := range z.PositionStats {
+				o = msgp.AppendString(o, za0003)
 				// map header, size 3
 				// string "hands"
 				o = append(o, 0x83, 0xa5, 0x68, 0x61, 0x6e, 0x64, 0x73)
-				o = msgp.AppendInt(o, za0002.Hands)
+				o = msgp.AppendInt(o, za0004.Hands)
 				// string "net_bb"
 				o = append(o, 0xa6, 0x6e, 0x65, 0x74, 0x5f, 0x62, 0x62)
-				o = msgp.AppendFloat64(o, za0002.NetBB)
+				o = msgp.AppendFloat64(o, za0004.NetBB)
 				// string "bb_per_hand"
 				o = append(o, 0xab, 0x62, 0x62, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x6e, 0x64)
-				o = msgp.AppendFloat64(o, za0002.BBPerHand)
+				o = msgp.AppendFloat64(o, za0004.BBPerHand)
 			}
 		}
-		if (zb0001Mask & 0x40000000) == 0 { // if not omitted
+		if (zb0001Mask & 0x100000000) == 0 { // if not omitted
 			// string "street_stats"
 			o = append(o, 0xac, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73)
 			o = msgp.AppendMapHeader(o, uint32(len(z.StreetStats)))
-			for za0003, za0004 := range z.StreetStats {
-				o = msgp.AppendString(o, za0003)
+			for za0005, za0006 := range z.StreetStats {
+				o = msgp.AppendString(o, za0005)
 				// map header, size 3
 				// string "hands_ended"
 				o = append(o, 0x83, 0xab, 0x68, 0x61, 0x6e, 0x64, 0x73, 0x5f, 0x65, 0x6e, 0x64, 0x65, 0x64)
-				o = msgp.AppendInt(o, za0004.HandsEnded)
+				o = msgp.AppendInt(o, za0006.HandsEnded)
 				// string "net_bb"
 				o = append(o, 0xa6, 0x6e, 0x65, 0x74, 0x5f, 0x62, 0x62)
-				o = msgp.AppendFloat64(o, za0004.NetBB)
+				o = msgp.AppendFloat64(o, za0006.NetBB)
 				// string "bb_per_hand"
 				o = append(o, 0xab, 0x62, 0x62, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x6e, 0x64)
-				o = msgp.AppendFloat64(o, za0004.BBPerHand)
+				o = msgp.AppendFloat64(o, za0006.BBPerHand)
 			}
 		}
-		if (zb0001Mask & 0x80000000) == 0 { // if not omitted
+		if (zb0001Mask & 0x200000000) == 0 { // if not omitted
 			// string "hand_category_stats"
 			o = append(o, 0xb3, 0x68, 0x61, 0x6e, 0x64, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73)
 			o = msgp.AppendMapHeader(o, uint32(len(z.HandCategoryStats)))
-			for za0005, za0006 := range z.HandCategoryStats {
-				o = msgp.AppendString(o, za0005)
+			for za0007, za0008 := range z.HandCategoryStats {
+				o = msgp.AppendString(o, za0007)
 				// map header, size 3
 				// string "hands"
 				o = append(o, 0x83, 0xa5, 0x68, 0x61, 0x6e, 0x64, 0x73)
-				o = msgp.AppendInt(o, za0006.Hands)
+				o = msgp.AppendInt(o, za0008.Hands)
 				// string "net_bb"
 				o = append(o, 0xa6, 0x6e, 0x65, 0x74, 0x5f, 0x62, 0x62)
-				o = msgp.AppendFloat64(o, za0006.NetBB)
+				o = msgp.AppendFloat64(o, za0008.NetBB)
 				// string "bb_per_hand"
 				o = append(o, 0xab, 0x62, 0x62, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x6e, 0x64)
-				o = msgp.AppendFloat64(o, za0006.BBPerHand)
+				o = msgp.AppendFloat64(o, za0008.BBPerHand)
+			}
+		}
+		if (zb0001Mask & 0x400000000) == 0 { // if not omitted
+			// string "showdown_wins_by_hand"
+			o = append(o, 0xb5, 0x73, 0x68, 0x6f, 0x77, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x68, 0x61, 0x6e, 0x64)
+			o = msgp.AppendMapHeader(o, uint32(len(z.ShowdownWinsByHand)))
+			for za0009, za0010 := range z.ShowdownWinsByHand {
+				o = msgp.AppendString(o, za0009)
+				o = msgp.AppendInt(o, za0010)
 			}
 		}
 	}
@@ -4620,6 +5237,40 @@ func (z *PlayerDetailedStats) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "NonShowdownBB")
 				return
 			}
+		case "showdown_rate":
+			z.ShowdownRate, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ShowdownRate")
+				return
+			}
+		case "street_reached":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "StreetReached")
+				return
+			}
+			if z.StreetReached == nil {
+				z.StreetReached = make(map[string]float64, zb0002)
+			} else if len(z.StreetReached) > 0 {
+				clear(z.StreetReached)
+			}
+			for zb0002 > 0 {
+				var za0002 float64
+				zb0002--
+				var za0001 string
+				za0001, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "StreetReached")
+					return
+				}
+				za0002, bts, err = msgp.ReadFloat64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "StreetReached", za0001)
+					return
+				}
+				z.StreetReached[za0001] = za0002
+			}
 		case "max_pot_bb":
 			z.MaxPotBB, bts, err = msgp.ReadFloat64Bytes(bts)
 			if err != nil {
@@ -4705,193 +5356,221 @@ func (z *PlayerDetailedStats) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				return
 			}
 		case "position_stats":
-			var zb0002 uint32
-			zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+			var zb0003 uint32
+			zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "PositionStats")
 				return
 			}
 			if z.PositionStats == nil {
-				z.PositionStats = make(map[string]PositionStatSummary, zb0002)
+				z.PositionStats = make(map[string]PositionStatSummary, zb0003)
 			} else if len(z.PositionStats) > 0 {
 				clear(z.PositionStats)
 			}
-			for zb0002 > 0 {
-				var za0002 PositionStatSummary
-				zb0002--
-				var za0001 string
-				za0001, bts, err = msgp.ReadStringBytes(bts)
+			for zb0003 > 0 {
+				var za0004 PositionStatSummary
+				zb0003--
+				var za0003 string
+				za0003, bts, err = msgp.ReadStringBytes(bts)
 				if err != nil {
 					err = msgp.WrapError(err, "PositionStats")
 					return
 				}
-				var zb0003 uint32
-				zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+				var zb0004 uint32
+				zb0004, bts, err = msgp.ReadMapHeaderBytes(bts)
 				if err != nil {
-					err = msgp.WrapError(err, "PositionStats", za0001)
+					err = msgp.WrapError(err, "PositionStats", za0003)
 					return
 				}
-				for zb0003 > 0 {
-					zb0003--
+				for zb0004 > 0 {
+					zb0004--
 					field, bts, err = msgp.ReadMapKeyZC(bts)
 					if err != nil {
-						err = msgp.WrapError(err, "PositionStats", za0001)
+						err = msgp.WrapError(err, "PositionStats", za0003)
 						return
 					}
 					switch msgp.UnsafeString(field) {
 					case "hands":
-						za0002.Hands, bts, err = msgp.ReadIntBytes(bts)
+						za0004.Hands, bts, err = msgp.ReadIntBytes(bts)
 						if err != nil {
-							err = msgp.WrapError(err, "PositionStats", za0001, "Hands")
+							err = msgp.WrapError(err, "PositionStats", za0003, "Hands")
 							return
 						}
 					case "net_bb":
-						za0002.NetBB, bts, err = msgp.ReadFloat64Bytes(bts)
+						za0004.NetBB, bts, err = msgp.ReadFloat64Bytes(bts)
 						if err != nil {
-							err = msgp.WrapError(err, "PositionStats", za0001, "NetBB")
+							err = msgp.WrapError(err, "PositionStats", za0003, "NetBB")
 							return
 						}
 					case "bb_per_hand":
-						za0002.BBPerHand, bts, err = msgp.ReadFloat64Bytes(bts)
+						za0004.BBPerHand, bts, err = msgp.ReadFloat64Bytes(bts)
 						if err != nil {
-							err = msgp.WrapError(err, "PositionStats", za0001, "BBPerHand")
+							err = msgp.WrapError(err, "PositionStats", za0003, "BBPerHand")
 							return
 						}
 					default:
 						bts, err = msgp.Skip(bts)
 						if err != nil {
-							err = msgp.WrapError(err, "PositionStats", za0001)
+							err = msgp.WrapError(err, "PositionStats", za0003)
 							return
 						}
 					}
 				}
-				z.PositionStats[za0001] = za0002
+				z.PositionStats[za0003] = za0004
 			}
 		case "street_stats":
-			var zb0004 uint32
-			zb0004, bts, err = msgp.ReadMapHeaderBytes(bts)
+			var zb0005 uint32
+			zb0005, bts, err = msgp.ReadMapHeaderBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "StreetStats")
 				return
 			}
 			if z.StreetStats == nil {
-				z.StreetStats = make(map[string]StreetStatSummary, zb0004)
+				z.StreetStats = make(map[string]StreetStatSummary, zb0005)
 			} else if len(z.StreetStats) > 0 {
 				clear(z.StreetStats)
 			}
-			for zb0004 > 0 {
-				var za0004 StreetStatSummary
-				zb0004--
-				var za0003 string
-				za0003, bts, err = msgp.ReadStringBytes(bts)
+			for zb0005 > 0 {
+				var za0006 StreetStatSummary
+				zb0005--
+				var za0005 string
+				za0005, bts, err = msgp.ReadStringBytes(bts)
 				if err != nil {
 					err = msgp.WrapError(err, "StreetStats")
 					return
 				}
-				var zb0005 uint32
-				zb0005, bts, err = msgp.ReadMapHeaderBytes(bts)
+				var zb0006 uint32
+				zb0006, bts, err = msgp.ReadMapHeaderBytes(bts)
 				if err != nil {
-					err = msgp.WrapError(err, "StreetStats", za0003)
+					err = msgp.WrapError(err, "StreetStats", za0005)
 					return
 				}
-				for zb0005 > 0 {
-					zb0005--
+				for zb0006 > 0 {
+					zb0006--
 					field, bts, err = msgp.ReadMapKeyZC(bts)
 					if err != nil {
-						err = msgp.WrapError(err, "StreetStats", za0003)
+						err = msgp.WrapError(err, "StreetStats", za0005)
 						return
 					}
 					switch msgp.UnsafeString(field) {
 					case "hands_ended":
-						za0004.HandsEnded, bts, err = msgp.ReadIntBytes(bts)
+						za0006.HandsEnded, bts, err = msgp.ReadIntBytes(bts)
 						if err != nil {
-							err = msgp.WrapError(err, "StreetStats", za0003, "HandsEnded")
+							err = msgp.WrapError(err, "StreetStats", za0005, "HandsEnded")
 							return
 						}
 					case "net_bb":
-						za0004.NetBB, bts, err = msgp.ReadFloat64Bytes(bts)
+						za0006.NetBB, bts, err = msgp.ReadFloat64Bytes(bts)
 						if err != nil {
-							err = msgp.WrapError(err, "StreetStats", za0003, "NetBB")
+							err = msgp.WrapError(err, "StreetStats", za0005, "NetBB")
 							return
 						}
 					case "bb_per_hand":
-						za0004.BBPerHand, bts, err = msgp.ReadFloat64Bytes(bts)
+						za0006.BBPerHand, bts, err = msgp.ReadFloat64Bytes(bts)
 						if err != nil {
-							err = msgp.WrapError(err, "StreetStats", za0003, "BBPerHand")
+							err = msgp.WrapError(err, "StreetStats", za0005, "BBPerHand")
 							return
 						}
 					default:
 						bts, err = msgp.Skip(bts)
 						if err != nil {
-							err = msgp.WrapError(err, "StreetStats", za0003)
+							err = msgp.WrapError(err, "StreetStats", za0005)
 							return
 						}
 					}
 				}
-				z.StreetStats[za0003] = za0004
+				z.StreetStats[za0005] = za0006
 			}
 		case "hand_category_stats":
-			var zb0006 uint32
-			zb0006, bts, err = msgp.ReadMapHeaderBytes(bts)
+			var zb0007 uint32
+			zb0007, bts, err = msgp.ReadMapHeaderBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "HandCategoryStats")
 				return
 			}
 			if z.HandCategoryStats == nil {
-				z.HandCategoryStats = make(map[string]CategoryStatSummary, zb0006)
+				z.HandCategoryStats = make(map[string]CategoryStatSummary, zb0007)
 			} else if len(z.HandCategoryStats) > 0 {
 				clear(z.HandCategoryStats)
 			}
-			for zb0006 > 0 {
-				var za0006 CategoryStatSummary
-				zb0006--
-				var za0005 string
-				za0005, bts, err = msgp.ReadStringBytes(bts)
+			for zb0007 > 0 {
+				var za0008 CategoryStatSummary
+				zb0007--
+				var za0007 string
+				za0007, bts, err = msgp.ReadStringBytes(bts)
 				if err != nil {
 					err = msgp.WrapError(err, "HandCategoryStats")
 					return
 				}
-				var zb0007 uint32
-				zb0007, bts, err = msgp.ReadMapHeaderBytes(bts)
+				var zb0008 uint32
+				zb0008, bts, err = msgp.ReadMapHeaderBytes(bts)
 				if err != nil {
-					err = msgp.WrapError(err, "HandCategoryStats", za0005)
+					err = msgp.WrapError(err, "HandCategoryStats", za0007)
 					return
 				}
-				for zb0007 > 0 {
-					zb0007--
+				for zb0008 > 0 {
+					zb0008--
 					field, bts, err = msgp.ReadMapKeyZC(bts)
 					if err != nil {
-						err = msgp.WrapError(err, "HandCategoryStats", za0005)
+						err = msgp.WrapError(err, "HandCategoryStats", za0007)
 						return
 					}
 					switch msgp.UnsafeString(field) {
 					case "hands":
-						za0006.Hands, bts, err = msgp.ReadIntBytes(bts)
+						za0008.Hands, bts, err = msgp.ReadIntBytes(bts)
 						if err != nil {
-							err = msgp.WrapError(err, "HandCategoryStats", za0005, "Hands")
+							err = msgp.WrapError(err, "HandCategoryStats", za0007, "Hands")
 							return
 						}
 					case "net_bb":
-						za0006.NetBB, bts, err = msgp.ReadFloat64Bytes(bts)
+						za0008.NetBB, bts, err = msgp.ReadFloat64Bytes(bts)
 						if err != nil {
-							err = msgp.WrapError(err, "HandCategoryStats", za0005, "NetBB")
+							err = msgp.WrapError(err, "HandCategoryStats", za0007, "NetBB")
 							return
 						}
 					case "bb_per_hand":
-						za0006.BBPerHand, bts, err = msgp.ReadFloat64Bytes(bts)
+						za0008.BBPerHand, bts, err = msgp.ReadFloat64Bytes(bts)
 						if err != nil {
-							err = msgp.WrapError(err, "HandCategoryStats", za0005, "BBPerHand")
+							err = msgp.WrapError(err, "HandCategoryStats", za0007, "BBPerHand")
 							return
 						}
 					default:
 						bts, err = msgp.Skip(bts)
 						if err != nil {
-							err = msgp.WrapError(err, "HandCategoryStats", za0005)
+							err = msgp.WrapError(err, "HandCategoryStats", za0007)
 							return
 						}
 					}
 				}
-				z.HandCategoryStats[za0005] = za0006
+				z.HandCategoryStats[za0007] = za0008
+			}
+		case "showdown_wins_by_hand":
+			var zb0009 uint32
+			zb0009, bts, err = msgp.ReadMapHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ShowdownWinsByHand")
+				return
+			}
+			if z.ShowdownWinsByHand == nil {
+				z.ShowdownWinsByHand = make(map[string]int, zb0009)
+			} else if len(z.ShowdownWinsByHand) > 0 {
+				clear(z.ShowdownWinsByHand)
+			}
+			for zb0009 > 0 {
+				var za0010 int
+				zb0009--
+				var za0009 string
+				za0009, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "ShowdownWinsByHand")
+					return
+				}
+				za0010, bts, err = msgp.ReadIntBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "ShowdownWinsByHand", za0009)
+					return
+				}
+				z.ShowdownWinsByHand[za0009] = za0010
 			}
 		default:
 			bts, err = msgp.Skip(bts)
@@ -4907,25 +5586,39 @@ func (z *PlayerDetailedStats) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *PlayerDetailedStats) Msgsize() (s int) {
-	s = 3 + 6 + msgp.IntSize + 7 + msgp.Float64Size + 11 + msgp.Float64Size + 5 + msgp.Float64Size + 7 + msgp.Float64Size + 8 + msgp.Float64Size + 10 + msgp.Float64Size + 11 + msgp.Float64Size + 14 + msgp.IntSize + 9 + msgp.Float64Size + 14 + msgp.IntSize + 18 + msgp.IntSize + 18 + msgp.Float64Size + 12 + msgp.Float64Size + 16 + msgp.Float64Size + 11 + msgp.Float64Size + 9 + msgp.IntSize + 5 + msgp.Float64Size + 4 + msgp.Float64Size + 9 + msgp.IntSize + 6 + msgp.IntSize + 18 + msgp.IntSize + 16 + msgp.Float64Size + 16 + msgp.Float64Size + 16 + msgp.Float64Size + 16 + msgp.Float64Size + 16 + msgp.Float64Size + 18 + msgp.IntSize + 21 + msgp.IntSize + 15 + msgp.MapHeaderSize
-	if z.PositionStats != nil {
-		for za0001, za0002 := range z.PositionStats {
+	s = 3 + 6 + msgp.IntSize + 7 + msgp.Float64Size + 11 + msgp.Float64Size + 5 + msgp.Float64Size + 7 + msgp.Float64Size + 8 + msgp.Float64Size + 10 + msgp.Float64Size + 11 + msgp.Float64Size + 14 + msgp.IntSize + 9 + msgp.Float64Size + 14 + msgp.IntSize + 18 + msgp.IntSize + 18 + msgp.Float64Size + 12 + msgp.Float64Size + 16 + msgp.Float64Size + 14 + msgp.Float64Size + 15 + msgp.MapHeaderSize
+	if z.StreetReached != nil {
+		for za0001, za0002 := range z.StreetReached {
 			_ = za0002
-			s += msgp.StringPrefixSize + len(za0001) + 1 + 6 + msgp.IntSize + 7 + msgp.Float64Size + 12 + msgp.Float64Size
+			s += msgp.StringPrefixSize + len(za0001) + msgp.Float64Size
+		}
+	}
+	s += 11 + msgp.Float64Size + 9 + msgp.IntSize + 5 + msgp.Float64Size + 4 + msgp.Float64Size + 9 + msgp.IntSize + 6 + msgp.IntSize + 18 + msgp.IntSize + 16 + msgp.Float64Size + 16 + msgp.Float64Size + 16 + msgp.Float64Size + 16 + msgp.Float64Size + 16 + msgp.Float64Size + 18 + msgp.IntSize + 21 + msgp.IntSize + 15 + msgp.MapHeaderSize
+	if z.PositionStats != nil {
+		for za0003, za0004 := range z.PositionStats {
+			_ = za0004
+			s += msgp.StringPrefixSize + len(za0003) + 1 + 6 + msgp.IntSize + 7 + msgp.Float64Size + 12 + msgp.Float64Size
 		}
 	}
 	s += 13 + msgp.MapHeaderSize
 	if z.StreetStats != nil {
-		for za0003, za0004 := range z.StreetStats {
-			_ = za0004
-			s += msgp.StringPrefixSize + len(za0003) + 1 + 12 + msgp.IntSize + 7 + msgp.Float64Size + 12 + msgp.Float64Size
+		for za0005, za0006 := range z.StreetStats {
+			_ = za0006
+			s += msgp.StringPrefixSize + len(za0005) + 1 + 12 + msgp.IntSize + 7 + msgp.Float64Size + 12 + msgp.Float64Size
 		}
 	}
 	s += 20 + msgp.MapHeaderSize
 	if z.HandCategoryStats != nil {
-		for za0005, za0006 := range z.HandCategoryStats {
-			_ = za0006
-			s += msgp.StringPrefixSize + len(za0005) + 1 + 6 + msgp.IntSize + 7 + msgp.Float64Size + 12 + msgp.Float64Size
+		for za0007, za0008 := range z.HandCategoryStats {
+			_ = za0008
+			s += msgp.StringPrefixSize + len(za0007) + 1 + 6 + msgp.IntSize + 7 + msgp.Float64Size + 12 + msgp.Float64Size
+		}
+	}
+	s += 22 + msgp.MapHeaderSize
+	if z.ShowdownWinsByHand != nil {
+		for za0009, za0010 := range z.ShowdownWinsByHand {
+			_ = za0010
+			s += msgp.StringPrefixSize + len(za0009) + msgp.IntSize
 		}
 	}
 	return
@@ -5084,6 +5777,134 @@ func (z PositionStatSummary) Msgsize() (s int) {
 	return
 }
 
+// DecodeMsg implements msgp.Decodable
+func (z *ServerShutdown) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "type":
+			z.Type, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Type")
+				return
+			}
+		case "reason":
+			z.Reason, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Reason")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z ServerShutdown) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 2
+	// write "type"
+	err = en.Append(0x82, 0xa4, 0x74, 0x79, 0x70, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Type)
+	if err != nil {
+		err = msgp.WrapError(err, "Type")
+		return
+	}
+	// write "reason"
+	err = en.Append(0xa6, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Reason)
+	if err != nil {
+		err = msgp.WrapError(err, "Reason")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z ServerShutdown) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 2
+	// string "type"
+	o = append(o, 0x82, 0xa4, 0x74, 0x79, 0x70, 0x65)
+	o = msgp.AppendString(o, z.Type)
+	// string "reason"
+	o = append(o, 0xa6, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e)
+	o = msgp.AppendString(o, z.Reason)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *ServerShutdown) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "type":
+			z.Type, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Type")
+				return
+			}
+		case "reason":
+			z.Reason, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Reason")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z ServerShutdown) Msgsize() (s int) {
+	s = 1 + 5 + msgp.StringPrefixSize + len(z.Type) + 7 + msgp.StringPrefixSize + len(z.Reason)
+	return
+}
+
 // DecodeMsg implements msgp.Decodable
 func (z *ShowdownHand) DecodeMsg(dc *msgp.Reader) (err error) {
 	var field []byte
@@ -1,9 +1,13 @@
 package protocol
 
 import (
+	"bytes"
 	"fmt"
+	"reflect"
 	"sync"
 	"testing"
+
+	"github.com/tinylib/msgp/msgp"
 )
 
 func TestConnectMessage(t *testing.T) {
@@ -107,6 +111,54 @@ func TestHandStartMessage(t *testing.T) {
 	}
 }
 
+func TestHandStartForcedBetsRoundTrip(t *testing.T) {
+	t.Parallel()
+	original := HandStart{
+		Type:         TypeHandStart,
+		HandID:       "hand-ante",
+		HoleCards:    []string{"As", "Kh"},
+		YourSeat:     1,
+		Players:      []Player{{Seat: 0, Name: "Bot1", Chips: 975}, {Seat: 1, Name: "Bot2", Chips: 994}},
+		SmallBlind:   5,
+		BigBlind:     10,
+		Variant:      "holdem",
+		BettingLimit: NoLimit,
+		Ante:         1,
+		Straddle:     20,
+		StraddleSeat: 0,
+		Rake:         0.05,
+	}
+
+	data, err := original.MarshalMsg(nil)
+	if err != nil {
+		t.Fatalf("Failed to marshal: %v", err)
+	}
+	var decoded HandStart
+	if _, err := decoded.UnmarshalMsg(data); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, original) {
+		t.Errorf("UnmarshalMsg = %+v, want %+v", decoded, original)
+	}
+
+	// The streaming encoder writes the same fields
+	var buf bytes.Buffer
+	w := msgp.NewWriter(&buf)
+	if err := original.EncodeMsg(w); err != nil {
+		t.Fatalf("Failed to encode: %v", err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Failed to flush: %v", err)
+	}
+	var streamed HandStart
+	if err := streamed.DecodeMsg(msgp.NewReader(&buf)); err != nil {
+		t.Fatalf("Failed to decode: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, original) {
+		t.Errorf("DecodeMsg = %+v, want %+v", streamed, original)
+	}
+}
+
 func TestActionRequestMessage(t *testing.T) {
 	t.Parallel()
 	original := ActionRequest{
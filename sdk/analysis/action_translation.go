@@ -0,0 +1,51 @@
+package analysis
+
+import (
+	rand "math/rand/v2"
+	"slices"
+)
+
+// TranslateAction maps an observed bet onto a discrete set of abstract bet sizes,
+// so a strategy that only knows a few sizings can respond to arbitrary bets.
+//
+// Bets between two abstract sizes are mapped randomly to one of them using the
+// pseudo-harmonic mapping (Ganzfried & Sandholm), which is computed on bet sizes
+// as a fraction of pot and is hard for an opponent to exploit by betting in the
+// gaps. Bets outside the abstract range map to the nearest size. Sizes and
+// observedBet are in chips; pot is the pot before the bet. The returned weight is
+// the probability that chosenAbstract was selected. Returns 0, 0 if no abstract
+// sizes are given.
+func TranslateAction(observedBet, pot int, abstractSizes []int, rng *rand.Rand) (chosenAbstract int, weight float64) {
+	if len(abstractSizes) == 0 {
+		return 0, 0
+	}
+	sizes := slices.Clone(abstractSizes)
+	slices.Sort(sizes)
+
+	idx, found := slices.BinarySearch(sizes, observedBet)
+	switch {
+	case found:
+		return sizes[idx], 1
+	case idx == 0:
+		return sizes[0], 1
+	case idx == len(sizes):
+		return sizes[len(sizes)-1], 1
+	}
+
+	lower, upper := sizes[idx-1], sizes[idx]
+	normalize := float64(max(pot, 1))
+	pLower := pseudoHarmonicMapping(float64(lower)/normalize, float64(upper)/normalize, float64(observedBet)/normalize)
+	if rng.Float64() < pLower {
+		return lower, pLower
+	}
+	return upper, 1 - pLower
+}
+
+// pseudoHarmonicMapping returns the probability of mapping a pot-relative bet x
+// to the smaller size a rather than the larger size b, where a <= x <= b.
+func pseudoHarmonicMapping(a, b, x float64) float64 {
+	if b <= a {
+		return 1
+	}
+	return (b - x) * (1 + a) / ((b - a) * (1 + x))
+}
@@ -0,0 +1,90 @@
+package analysis
+
+import (
+	"math"
+	"testing"
+
+	"github.com/lox/pokerforbots/v2/internal/randutil"
+)
+
+func TestTranslateActionExactAndOutOfRange(t *testing.T) {
+	t.Parallel()
+
+	rng := randutil.New(2141)
+	sizes := []int{100, 50, 200} // Unsorted on purpose
+
+	tests := []struct {
+		name string
+		bet  int
+		want int
+	}{
+		{"exact match", 100, 100},
+		{"below smallest", 20, 50},
+		{"above largest", 500, 200},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chosen, weight := TranslateAction(tt.bet, 100, sizes, rng)
+			if chosen != tt.want || weight != 1 {
+				t.Errorf("TranslateAction(%d) = (%d, %v), want (%d, 1)", tt.bet, chosen, weight, tt.want)
+			}
+		})
+	}
+
+	if chosen, weight := TranslateAction(75, 100, nil, rng); chosen != 0 || weight != 0 {
+		t.Errorf("expected (0, 0) with no abstract sizes, got (%d, %v)", chosen, weight)
+	}
+}
+
+func TestTranslateActionBetweenSizesIsRandomized(t *testing.T) {
+	t.Parallel()
+
+	// A 3/4 pot bet between half pot and pot maps to half pot with probability
+	// (1-0.75)(1+0.5) / ((1-0.5)(1+0.75)) = 3/7
+	const pot, trials = 100, 20000
+	sizes := []int{50, 100}
+	wantLower := 3.0 / 7.0
+
+	rng := randutil.New(2141)
+	lowerCount := 0
+	for range trials {
+		chosen, weight := TranslateAction(75, pot, sizes, rng)
+		switch chosen {
+		case 50:
+			lowerCount++
+			if math.Abs(weight-wantLower) > 1e-9 {
+				t.Fatalf("expected weight %.4f for the smaller size, got %.4f", wantLower, weight)
+			}
+		case 100:
+			if math.Abs(weight-(1-wantLower)) > 1e-9 {
+				t.Fatalf("expected weight %.4f for the larger size, got %.4f", 1-wantLower, weight)
+			}
+		default:
+			t.Fatalf("bet mapped outside its neighbouring sizes: %d", chosen)
+		}
+	}
+
+	freq := float64(lowerCount) / trials
+	if math.Abs(freq-wantLower) > 0.02 {
+		t.Errorf("expected smaller size ~%.3f of the time, got %.3f", wantLower, freq)
+	}
+}
+
+func TestPseudoHarmonicMappingEndpoints(t *testing.T) {
+	t.Parallel()
+
+	if p := pseudoHarmonicMapping(0.5, 1, 0.5); p != 1 {
+		t.Errorf("expected bet at the smaller size to map there with certainty, got %v", p)
+	}
+	if p := pseudoHarmonicMapping(0.5, 1, 1); p != 0 {
+		t.Errorf("expected bet at the larger size never to map down, got %v", p)
+	}
+	prev := 1.0
+	for x := 0.55; x < 1; x += 0.05 {
+		p := pseudoHarmonicMapping(0.5, 1, x)
+		if p >= prev {
+			t.Errorf("expected mapping to decrease as the bet grows, got %v at %v after %v", p, x, prev)
+		}
+		prev = p
+	}
+}
@@ -0,0 +1,15 @@
+package analysis
+
+// CallAllInEV returns the chip EV of calling an all-in, relative to folding.
+//
+// pot is the pot before calling, including the all-in bet; toCall is the amount
+// needed to call and effectiveStack the caller's remaining chips. When the caller
+// is covered (effectiveStack < toCall) they call all-in for less and the part of
+// the bet they can't match is returned to the bettor, so it is neither risked nor
+// won. equity is the caller's share of the pot at showdown, between 0 and 1.
+func CallAllInEV(equity float64, pot, toCall, effectiveStack int) float64 {
+	called := max(0, min(toCall, effectiveStack))
+	uncalled := toCall - called
+	contested := pot - uncalled + called
+	return equity*float64(contested) - float64(called)
+}
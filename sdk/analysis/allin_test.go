@@ -0,0 +1,39 @@
+package analysis
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCallAllInEV(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		equity         float64
+		pot            int
+		toCall         int
+		effectiveStack int
+		want           float64
+	}{
+		// 100 in blinds plus a 200 shove: calling 200 to win 500
+		{"profitable call", 0.5, 300, 200, 1000, 0.5*500 - 200},
+		{"losing call", 0.3, 300, 200, 1000, 0.3*500 - 200},
+		// A 1000 shove against a 300 stack: only 300 of it is contested, so the
+		// call wins 700 instead of 2100 and becomes profitable at 45% equity
+		{"capped call", 0.45, 1100, 1000, 300, 0.45*700 - 300},
+		{"nothing to call", 0.2, 300, 0, 1000, 0.2 * 300},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CallAllInEV(tt.equity, tt.pot, tt.toCall, tt.effectiveStack)
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("CallAllInEV(%v, %d, %d, %d) = %v, want %v", tt.equity, tt.pot, tt.toCall, tt.effectiveStack, got, tt.want)
+			}
+		})
+	}
+
+	if ev := CallAllInEV(0.45, 1100, 1000, 1000); ev >= 0 {
+		t.Errorf("expected uncapped call at 45%% equity to lose chips, got %v", ev)
+	}
+}
@@ -0,0 +1,176 @@
+package analysis
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/lox/pokerforbots/v2/poker"
+)
+
+// StreetResult is what a player won or lost through the betting on one street.
+type StreetResult struct {
+	Street   string
+	Invested int     // Chips the player put in on the street, after uncalled bets are returned
+	Net      float64 // Chips won (positive) or lost (negative) on the street
+}
+
+// AttributeResult replays history and splits seat's (a 0-based PHH player index)
+// result for the hand across the streets it was won or lost on, so the Nets sum to
+// the player's result. winners lists the seats that split the main pot.
+//
+// Chips a player puts in on a street are lost on that street; a winner also wins
+// the chips everyone else put in on it. Antes and blinds count as preflop, and
+// uncalled bets are returned before the next street. The pot is split into a main
+// pot and side pots by what each player put in. A side pot goes to the winners
+// in it, or when there are none to the best hand shown among the players in it.
+// Streets the hand never reached are omitted.
+func AttributeResult(history HandHistory, seat int, winners []int) ([]StreetResult, error) {
+	players := len(history.StartingStacks)
+	if seat < 0 || seat >= players {
+		return nil, fmt.Errorf("seat %d out of range", seat)
+	}
+	if len(winners) == 0 {
+		return nil, errors.New("at least one winner is required")
+	}
+	for _, winner := range winners {
+		if winner < 0 || winner >= players {
+			return nil, fmt.Errorf("winner %d out of range", winner)
+		}
+	}
+
+	replay := newPHHReplay(history)
+	for _, raw := range history.Actions {
+		action, ok := parsePHHAction(raw)
+		if !ok {
+			continue
+		}
+		if err := replay.apply(action); err != nil {
+			return nil, err
+		}
+	}
+	replay.returnUncalled()
+
+	won, err := replay.potWinnings(seat, winners)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]StreetResult, len(replay.invested))
+	for street, amounts := range replay.invested {
+		results[street] = StreetResult{
+			Street:   phhStreets[street],
+			Invested: amounts[seat],
+			Net:      won[street] - float64(amounts[seat]),
+		}
+	}
+	return results, nil
+}
+
+// potWinnings returns the chips seat wins from each street's betting. Each
+// player's chips fill the main pot and then each side pot in the order they
+// went in, so a pot's chips are credited to the streets they were put in on.
+func (r *phhReplay) potWinnings(seat int, winners []int) ([]float64, error) {
+	totals := r.contributions()
+
+	// Pots are layered by the distinct amounts players still in put in; chips
+	// folded players put in beyond that all go to the last pot
+	var levels []int
+	for player, total := range totals {
+		if !r.folded[player] && total > 0 {
+			levels = append(levels, total)
+		}
+	}
+	slices.Sort(levels)
+	levels = slices.Compact(levels)
+
+	won := make([]float64, len(r.invested))
+	low := 0
+	for i, high := range levels {
+		var eligible []int
+		for player, total := range totals {
+			if !r.folded[player] && total >= high {
+				eligible = append(eligible, player)
+			}
+		}
+		share, err := r.potShare(seat, eligible, winners)
+		if err != nil {
+			return nil, err
+		}
+		if share > 0 {
+			top := high
+			if i == len(levels)-1 {
+				top = math.MaxInt
+			}
+			for street, chips := range r.layerChips(low, top) {
+				won[street] += share * float64(chips)
+			}
+		}
+		low = high
+	}
+	return won, nil
+}
+
+// potShare returns seat's share of a pot contested by eligible players.
+func (r *phhReplay) potShare(seat int, eligible, winners []int) (float64, error) {
+	if !slices.Contains(eligible, seat) {
+		return 0, nil
+	}
+	var potWinners []int
+	for _, player := range eligible {
+		if slices.Contains(winners, player) {
+			potWinners = append(potWinners, player)
+		}
+	}
+	if len(potWinners) == 0 {
+		var err error
+		if potWinners, err = r.bestShown(eligible); err != nil {
+			return 0, err
+		}
+	}
+	if !slices.Contains(potWinners, seat) {
+		return 0, nil
+	}
+	return 1 / float64(len(potWinners)), nil
+}
+
+// bestShown returns the players with the best hand among players, all of whose
+// hole cards must be known on a complete board.
+func (r *phhReplay) bestShown(players []int) ([]int, error) {
+	if len(players) == 1 {
+		return players, nil
+	}
+	if r.board.CountCards() != 5 {
+		return nil, errors.New("no winner is in a side pot and the board is incomplete")
+	}
+	var best poker.HandRank
+	var bestPlayers []int
+	for _, player := range players {
+		if r.holes[player].CountCards() != 2 {
+			return nil, fmt.Errorf("no winner is in a side pot and the hole cards of seat %d are unknown", player)
+		}
+		rank := poker.Evaluate7Cards(r.holes[player] | r.board)
+		switch poker.CompareHands(rank, best) {
+		case 1:
+			best, bestPlayers = rank, []int{player}
+		case 0:
+			bestPlayers = append(bestPlayers, player)
+		}
+	}
+	return bestPlayers, nil
+}
+
+// layerChips returns, per street, the chips players put in between their low
+// and high cumulative totals for the hand.
+func (r *phhReplay) layerChips(low, high int) []int {
+	chips := make([]int, len(r.invested))
+	for player := range r.stacks {
+		before := 0
+		for street, amounts := range r.invested {
+			after := before + amounts[player]
+			chips[street] += max(0, min(after, high)-max(before, low))
+			before = after
+		}
+	}
+	return chips
+}
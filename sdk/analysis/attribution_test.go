@@ -0,0 +1,185 @@
+package analysis
+
+import "testing"
+
+// riverCallLosesHistory is a heads-up hand checked to the river, where p1 calls a
+// 50 chip bet and loses to p2.
+var riverCallLosesHistory = HandHistory{
+	BlindsOrStraddles: []int{5, 10},
+	StartingStacks:    []int{1000, 1000},
+	Actions: []string{
+		"d dh p1 8c8d",
+		"d dh p2 AsKs",
+		"p1 cc",
+		"p2 cc",
+		"d db QsJsTs",
+		"p2 cc",
+		"p1 cc",
+		"d db 2c",
+		"p2 cc",
+		"p1 cc",
+		"d db 3d",
+		"p2 cbr 50",
+		"p1 cc",
+	},
+}
+
+func TestAttributeResult(t *testing.T) {
+	t.Run("river call loses on the river", func(t *testing.T) {
+		results, err := AttributeResult(riverCallLosesHistory, 0, []int{1})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := []StreetResult{
+			{Street: "preflop", Invested: 10, Net: -10},
+			{Street: "flop", Invested: 0, Net: 0},
+			{Street: "turn", Invested: 0, Net: 0},
+			{Street: "river", Invested: 50, Net: -50},
+		}
+		assertStreetResults(t, results, want)
+	})
+
+	t.Run("winner wins each street's chips where they went in", func(t *testing.T) {
+		results, err := AttributeResult(riverCallLosesHistory, 1, []int{1})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := []StreetResult{
+			{Street: "preflop", Invested: 10, Net: 10},
+			{Street: "flop", Invested: 0, Net: 0},
+			{Street: "turn", Invested: 0, Net: 0},
+			{Street: "river", Invested: 50, Net: 50},
+		}
+		assertStreetResults(t, results, want)
+	})
+
+	t.Run("uncalled bet is returned", func(t *testing.T) {
+		history := HandHistory{
+			BlindsOrStraddles: []int{5, 10},
+			StartingStacks:    []int{1000, 1000},
+			Actions: []string{
+				"p1 cc",
+				"p2 cc",
+				"d db QsJsTs",
+				"p2 cbr 40",
+				"p1 cbr 120",
+				"p2 f",
+			},
+		}
+		results, err := AttributeResult(history, 0, []int{0})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// Only 40 of the raise to 120 was called
+		want := []StreetResult{
+			{Street: "preflop", Invested: 10, Net: 10},
+			{Street: "flop", Invested: 40, Net: 40},
+		}
+		assertStreetResults(t, results, want)
+	})
+
+	t.Run("split pot with antes", func(t *testing.T) {
+		history := HandHistory{
+			Antes:             []int{1, 1, 1},
+			BlindsOrStraddles: []int{5, 10, 0},
+			StartingStacks:    []int{1000, 1000, 1000},
+			Actions: []string{
+				"p3 f",
+				"p1 cc",
+				"p2 cc",
+			},
+		}
+		results, err := AttributeResult(history, 0, []int{0, 1})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// Half of the 23 chip pot, less the 11 put in
+		want := []StreetResult{{Street: "preflop", Invested: 11, Net: 0.5}}
+		assertStreetResults(t, results, want)
+	})
+
+	t.Run("side pot goes to the best hand among the players in it", func(t *testing.T) {
+		history := HandHistory{
+			BlindsOrStraddles: []int{5, 10, 0},
+			StartingStacks:    []int{100, 1000, 1000},
+			Actions: []string{
+				"d dh p1 AsAd",
+				"d dh p2 KhKs",
+				"d dh p3 QcQd",
+				"p3 cc",
+				"p1 cbr 100",
+				"p2 cc",
+				"p3 cc",
+				"d db AhKd7c",
+				"p2 cbr 200",
+				"p3 cc",
+				"d db 2s",
+				"p2 cc",
+				"p3 cc",
+				"d db 3h",
+				"p2 cc",
+				"p3 cc",
+			},
+		}
+
+		// The short stack wins the 300 chip main pot, the kings the 400 chip side pot
+		tests := []struct {
+			seat int
+			want []StreetResult
+		}{
+			{0, []StreetResult{
+				{Street: "preflop", Invested: 100, Net: 200},
+				{Street: "flop"}, {Street: "turn"}, {Street: "river"},
+			}},
+			{1, []StreetResult{
+				{Street: "preflop", Invested: 100, Net: -100},
+				{Street: "flop", Invested: 200, Net: 200},
+				{Street: "turn"}, {Street: "river"},
+			}},
+			{2, []StreetResult{
+				{Street: "preflop", Invested: 100, Net: -100},
+				{Street: "flop", Invested: 200, Net: -200},
+				{Street: "turn"}, {Street: "river"},
+			}},
+		}
+		for _, tt := range tests {
+			results, err := AttributeResult(history, tt.seat, []int{0})
+			if err != nil {
+				t.Fatalf("seat %d: unexpected error: %v", tt.seat, err)
+			}
+			assertStreetResults(t, results, tt.want)
+		}
+
+		// Without the side pot's hands shown there's no telling who won it
+		hidden := history
+		hidden.Actions = append([]string(nil), history.Actions...)
+		hidden.Actions[1] = "d dh p2 ????"
+		if _, err := AttributeResult(hidden, 1, []int{0}); err == nil {
+			t.Error("expected an error when the side pot's hands are unknown")
+		}
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		if _, err := AttributeResult(riverCallLosesHistory, 2, []int{1}); err == nil {
+			t.Error("expected an error for an out of range seat")
+		}
+		if _, err := AttributeResult(riverCallLosesHistory, 0, nil); err == nil {
+			t.Error("expected an error without winners")
+		}
+		if _, err := AttributeResult(riverCallLosesHistory, 0, []int{5}); err == nil {
+			t.Error("expected an error for an out of range winner")
+		}
+	})
+}
+
+func assertStreetResults(t *testing.T, got, want []StreetResult) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %d streets, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("street %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
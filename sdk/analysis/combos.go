@@ -0,0 +1,55 @@
+package analysis
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+
+	"github.com/lox/pokerforbots/v2/poker"
+)
+
+// startingCombo is one of the 1326 two-card starting hands with its heads-up
+// preflop equity from the table.
+type startingCombo struct {
+	hand   poker.Hand
+	equity float64
+}
+
+// startingCombos lists all 1326 starting combos in deck order.
+var startingCombos = sync.OnceValue(func() []startingCombo {
+	combos := make([]startingCombo, 0, 1326)
+	for first := range 52 {
+		for second := first + 1; second < 52; second++ {
+			c1 := poker.NewCard(uint8(first%13), uint8(first/13))
+			c2 := poker.NewCard(uint8(second%13), uint8(second/13))
+			combos = append(combos, startingCombo{
+				hand:   poker.NewHand(c1, c2),
+				equity: GetPreflopEquity(GetHandCategory(c1.String(), c2.String()), 1),
+			})
+		}
+	}
+	return combos
+})
+
+// comboEquity maps each starting combo to its heads-up preflop equity.
+var comboEquity = sync.OnceValue(func() map[poker.Hand]float64 {
+	combos := startingCombos()
+	equity := make(map[poker.Hand]float64, len(combos))
+	for _, c := range combos {
+		equity[c.hand] = c.equity
+	}
+	return equity
+})
+
+// combosByStrength lists all 1326 starting combos, strongest heads-up first.
+var combosByStrength = sync.OnceValue(func() []poker.Hand {
+	combos := slices.Clone(startingCombos())
+	slices.SortStableFunc(combos, func(a, b startingCombo) int {
+		return cmp.Compare(b.equity, a.equity)
+	})
+	hands := make([]poker.Hand, len(combos))
+	for i, c := range combos {
+		hands[i] = c.hand
+	}
+	return hands
+})
@@ -0,0 +1,57 @@
+package analysis
+
+import "math"
+
+// Position is a seat's position relative to the button, numbered like the
+// complex bot's: 0 is the button and higher numbers act earlier.
+type Position int
+
+const (
+	PositionButton Position = iota
+	PositionCutoff
+	PositionMiddle
+	PositionEarly
+)
+
+// positionRealization is the share of its equity a hand realizes after calling
+// from each position at a deep stack. Hands out of position get pushed off more
+// often, so they need more raw equity to continue.
+var positionRealization = map[Position]float64{
+	PositionButton: 1,
+	PositionCutoff: 0.95,
+	PositionMiddle: 0.9,
+	PositionEarly:  0.85,
+}
+
+// continueConfidenceMargin is the equity margin over or under the requirement
+// at which ShouldContinue is fully confident.
+const continueConfidenceMargin = 0.15
+
+// ShouldContinue decides whether a hand with the given showdown equity should
+// continue rather than fold, and how clear the decision is from 0 (a coin
+// flip) to 1.
+//
+// potOdds is the share of the final pot the call costs, toCall/(pot+toCall),
+// and is the equity needed to break even at showdown. Equity realization
+// scales that need up out of position, by positionRealization, and the
+// scaling grows with spr: at an SPR of 0 the call is all-in and equity is
+// realized in full, while from an SPR of 4 the full penalty applies, rising
+// to 1.5 times it from 6. Positions earlier than PositionEarly count as early.
+// Facing no bet it always continues.
+func ShouldContinue(equity, potOdds, spr float64, position Position) (bool, float64) {
+	if potOdds <= 0 {
+		return true, 1
+	}
+
+	realization, ok := positionRealization[position]
+	if !ok {
+		realization = positionRealization[PositionEarly]
+	}
+	depth := math.Min(1.5, math.Max(0, spr)/4)
+	realization = math.Max(0.1, 1-(1-realization)*depth)
+
+	required := math.Min(1, potOdds/realization)
+	margin := equity - required
+	confidence := math.Min(1, math.Abs(margin)/continueConfidenceMargin)
+	return margin >= 0, confidence
+}
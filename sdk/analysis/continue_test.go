@@ -0,0 +1,75 @@
+package analysis
+
+import (
+	"math"
+	"testing"
+)
+
+func TestShouldContinue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		equity         float64
+		potOdds        float64
+		spr            float64
+		position       Position
+		wantContinue   bool
+		wantConfidence float64
+	}{
+		{"no bet to call", 0.05, 0, 5, PositionEarly, true, 1},
+		{"clear call", 0.5, 0.25, 4, PositionButton, true, 1},
+		{"clear fold", 0.2, 0.4, 4, PositionButton, false, 1},
+		{"thin call on the button", 0.35, 0.33, 4, PositionButton, true, 0.02 / 0.15},
+		{"same call out of position", 0.35, 0.33, 4, PositionEarly, false, (0.33/0.85 - 0.35) / 0.15},
+		{"out of position all-in", 0.35, 0.33, 0, PositionEarly, true, 0.02 / 0.15},
+		{"earlier seats count as early", 0.35, 0.33, 4, Position(7), false, (0.33/0.85 - 0.35) / 0.15},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ok, confidence := ShouldContinue(tt.equity, tt.potOdds, tt.spr, tt.position)
+			if ok != tt.wantContinue || math.Abs(confidence-tt.wantConfidence) > 1e-9 {
+				t.Errorf("ShouldContinue(%.2f, %.2f, %.1f, %d) = %v, %.3f, want %v, %.3f",
+					tt.equity, tt.potOdds, tt.spr, tt.position, ok, confidence, tt.wantContinue, tt.wantConfidence)
+			}
+		})
+	}
+}
+
+func TestShouldContinueGrid(t *testing.T) {
+	t.Parallel()
+
+	positions := []Position{PositionButton, PositionCutoff, PositionMiddle, PositionEarly}
+	for _, spr := range []float64{0.5, 3, 8} {
+		for equity := 0.0; equity <= 1; equity += 0.05 {
+			for potOdds := 0.05; potOdds < 0.5; potOdds += 0.05 {
+				for i, position := range positions {
+					ok, confidence := ShouldContinue(equity, potOdds, spr, position)
+					if confidence < 0 || confidence > 1 {
+						t.Fatalf("confidence %.3f out of range at equity %.2f, pot odds %.2f", confidence, equity, potOdds)
+					}
+					if equity < potOdds && ok {
+						t.Errorf("continued with equity %.2f below pot odds %.2f", equity, potOdds)
+					}
+					// Anything worth continuing is also worth it with more equity,
+					// a cheaper call or a later position
+					if !ok {
+						continue
+					}
+					if more, _ := ShouldContinue(equity+0.05, potOdds, spr, position); !more {
+						t.Errorf("folded with more equity than %.2f at pot odds %.2f", equity, potOdds)
+					}
+					if cheaper, _ := ShouldContinue(equity, potOdds-0.05, spr, position); !cheaper {
+						t.Errorf("folded to a cheaper call than %.2f with equity %.2f", potOdds, equity)
+					}
+					if i > 0 {
+						if later, _ := ShouldContinue(equity, potOdds, spr, positions[i-1]); !later {
+							t.Errorf("folded in position %d where position %d continues (equity %.2f, pot odds %.2f)", positions[i-1], position, equity, potOdds)
+						}
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,252 @@
+package analysis
+
+import (
+	"errors"
+	"fmt"
+	rand "math/rand/v2"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/lox/pokerforbots/v2/poker"
+)
+
+// CounterfactualResult compares the action taken at a decision point with an
+// alternative. EVs are in chips from the decision point onward, so chips already
+// in the pot are sunk and folding is worth 0.
+type CounterfactualResult struct {
+	ActualAction string  // PHH action taken at the decision point, e.g. "cc"
+	ActualEV     float64 // Estimated EV of the action taken
+	AltEV        float64 // Estimated EV of the alternative action
+	Diff         float64 // AltEV - ActualEV; negative means the alternative was worse
+	Simulations  int
+}
+
+// decisionPoint is the table state when the player first acts on the chosen street.
+type decisionPoint struct {
+	hole      poker.Hand
+	board     poker.Hand
+	pot       int   // All chips committed so far, including this street's bets
+	maxBet    int   // Highest bet on the current street
+	bets      []int // Current street bets per player
+	stacks    []int // Remaining stacks per player
+	opponents []int // Players still in the hand other than the hero
+	seat      int
+	action    string
+}
+
+// CounterfactualEV replays history to the first decision seat (a 0-based PHH
+// player index) faced on street, then estimates the EV of the action taken there
+// and of altAction (PHH notation: "f", "cc" or "cbr <amount>").
+//
+// Opponents still in the hand are modeled with simple ranges: their holdings are
+// drawn from opponentRange (any two cards if nil), they call any bet, and the hand
+// is checked down to showdown. Both actions are evaluated on the same sampled
+// holdings and runouts, so the difference converges faster than either EV. Side
+// pots are ignored.
+func CounterfactualEV(history HandHistory, seat int, street string, altAction string, opponentRange *Range, simulations int, rng *rand.Rand) (CounterfactualResult, error) {
+	if simulations <= 0 {
+		return CounterfactualResult{}, errors.New("simulations must be positive")
+	}
+	target, ok := phhStreetIndex(street)
+	if !ok {
+		return CounterfactualResult{}, fmt.Errorf("unknown street %q", street)
+	}
+	if seat < 0 || seat >= len(history.StartingStacks) {
+		return CounterfactualResult{}, fmt.Errorf("seat %d out of range", seat)
+	}
+
+	spot, err := findDecisionPoint(history, seat, target)
+	if err != nil {
+		return CounterfactualResult{}, err
+	}
+	if _, err := spot.invest(altAction); err != nil {
+		return CounterfactualResult{}, fmt.Errorf("invalid alternative action: %w", err)
+	}
+
+	var opponentHands []poker.Hand
+	if opponentRange != nil {
+		opponentHands = opponentRange.Hands()
+		if len(opponentHands) == 0 {
+			return CounterfactualResult{}, errors.New("opponent range is empty")
+		}
+	}
+
+	deck := poker.NewDeck(rng)
+	var actualTotal, altTotal float64
+	completed := 0
+	for range simulations {
+		share, ok := spot.sampleShowdownShare(deck, opponentHands, rng)
+		if !ok {
+			continue
+		}
+		actualEV, _ := spot.evaluate(spot.action, share)
+		altEV, _ := spot.evaluate(altAction, share)
+		actualTotal += actualEV
+		altTotal += altEV
+		completed++
+	}
+	if completed == 0 {
+		return CounterfactualResult{}, errors.New("could not deal any runouts for the spot")
+	}
+
+	result := CounterfactualResult{
+		ActualAction: spot.action,
+		ActualEV:     actualTotal / float64(completed),
+		AltEV:        altTotal / float64(completed),
+		Simulations:  completed,
+	}
+	result.Diff = result.AltEV - result.ActualEV
+	return result, nil
+}
+
+// findDecisionPoint replays PHH actions until seat first acts on the target
+// street, given as the number of board deals before it.
+func findDecisionPoint(history HandHistory, seat int, target int) (*decisionPoint, error) {
+	replay := newPHHReplay(history)
+	for _, raw := range history.Actions {
+		action, ok := parsePHHAction(raw)
+		if !ok {
+			continue
+		}
+		if action.player != seat || replay.street() != target {
+			if err := replay.apply(action); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		spot := &decisionPoint{
+			hole:   replay.holes[seat],
+			board:  replay.board,
+			pot:    replay.pot(),
+			maxBet: replay.maxBet(),
+			bets:   slices.Clone(replay.bets),
+			stacks: slices.Clone(replay.stacks),
+			seat:   seat,
+		}
+		if spot.hole.CountCards() != 2 {
+			return nil, fmt.Errorf("hole cards for seat %d are unknown", seat)
+		}
+		for opp, folded := range replay.folded {
+			if opp != seat && !folded {
+				spot.opponents = append(spot.opponents, opp)
+			}
+		}
+		if len(spot.opponents) == 0 {
+			return nil, errors.New("no opponents left at the decision point")
+		}
+		notation := action.notation()
+		if _, err := spot.invest(notation); err != nil {
+			return nil, fmt.Errorf("unsupported action %q at the decision point: %w", notation, err)
+		}
+		spot.action = notation
+		return spot, nil
+	}
+
+	return nil, fmt.Errorf("seat %d never acts on the %s", seat, phhStreets[target])
+}
+
+// invest returns the chips the hero adds to the pot by taking action.
+func (d *decisionPoint) invest(action string) (int, error) {
+	fields := strings.Fields(action)
+	if len(fields) == 0 {
+		return 0, errors.New("empty action")
+	}
+	switch fields[0] {
+	case "f":
+		return 0, nil
+	case "cc":
+		return min(d.maxBet-d.bets[d.seat], d.stacks[d.seat]), nil
+	case "cbr":
+		if len(fields) < 2 {
+			return 0, errors.New("raise is missing an amount")
+		}
+		to, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return 0, err
+		}
+		if to <= d.maxBet {
+			return 0, fmt.Errorf("raise to %d does not exceed the current bet of %d", to, d.maxBet)
+		}
+		return min(to-d.bets[d.seat], d.stacks[d.seat]), nil
+	default:
+		return 0, fmt.Errorf("unknown action %q", fields[0])
+	}
+}
+
+// evaluate returns the hero's EV for action given their share of the final pot.
+func (d *decisionPoint) evaluate(action string, share float64) (float64, error) {
+	invested, err := d.invest(action)
+	if err != nil || strings.HasPrefix(action, "f") {
+		return 0, err
+	}
+
+	// Calling-station opponents match the hero's bet as far as their stacks allow
+	heroBet := d.bets[d.seat] + invested
+	pot := d.pot + invested
+	for _, opp := range d.opponents {
+		pot += max(0, min(heroBet-d.bets[opp], d.stacks[opp]))
+	}
+	return share*float64(pot) - float64(invested), nil
+}
+
+// sampleShowdownShare deals opponent holdings and a runout, returning the hero's
+// share of the pot at showdown. Returns false if the cards couldn't be dealt.
+func (d *decisionPoint) sampleShowdownShare(deck *poker.Deck, opponentHands []poker.Hand, rng *rand.Rand) (float64, bool) {
+	deck.Shuffle()
+	used := d.hole | d.board
+
+	opponents := make([]poker.Hand, len(d.opponents))
+	for i := range opponents {
+		if opponentHands != nil {
+			hand, ok := sampleRangeHand(opponentHands, used, rng)
+			if !ok {
+				return 0, false
+			}
+			used |= hand
+			opponents[i] = hand
+			continue
+		}
+		for range 2 {
+			card, ok := dealUnused(deck, &used)
+			if !ok {
+				return 0, false
+			}
+			opponents[i].AddCard(card)
+		}
+	}
+
+	board := d.board
+	for range 5 - d.board.CountCards() {
+		card, ok := dealUnused(deck, &used)
+		if !ok {
+			return 0, false
+		}
+		board.AddCard(card)
+	}
+
+	heroRank := poker.Evaluate7Cards(d.hole | board)
+	ties := 1
+	for _, opp := range opponents {
+		switch poker.CompareHands(heroRank, poker.Evaluate7Cards(opp|board)) {
+		case -1:
+			return 0, true
+		case 0:
+			ties++
+		}
+	}
+	return 1 / float64(ties), true
+}
+
+// sampleRangeHand draws a range hand that doesn't conflict with used cards.
+func sampleRangeHand(hands []poker.Hand, used poker.Hand, rng *rand.Rand) (poker.Hand, bool) {
+	const maxAttempts = 100
+	for range maxAttempts {
+		hand := hands[rng.IntN(len(hands))]
+		if hand&used == 0 {
+			return hand, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,116 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/lox/pokerforbots/v2/internal/randutil"
+)
+
+// nutsOnRiverHistory is a heads-up hand where p1 flops a royal flush and calls a
+// 50 chip river bet into a 20 chip pot.
+var nutsOnRiverHistory = HandHistory{
+	BlindsOrStraddles: []int{5, 10},
+	StartingStacks:    []int{1000, 1000},
+	Actions: []string{
+		"d dh p1 AsKs",
+		"d dh p2 ????",
+		"p1 cc",
+		"p2 cc",
+		"d db QsJsTs",
+		"p2 cc",
+		"p1 cc",
+		"d db 2c",
+		"p2 cc",
+		"p1 cc",
+		"d db 3d",
+		"p2 cbr 50",
+		"p1 cc",
+	},
+}
+
+func TestCounterfactualEV(t *testing.T) {
+	t.Run("folding the nuts is worse than calling", func(t *testing.T) {
+		result, err := CounterfactualEV(nutsOnRiverHistory, 0, "river", "f", nil, 500, randutil.New(42))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.ActualAction != "cc" {
+			t.Errorf("ActualAction = %q, want cc", result.ActualAction)
+		}
+		// Calling wins the 20 chip pot plus the 50 chip bet every time
+		if result.ActualEV != 70 || result.AltEV != 0 || result.Diff != -70 {
+			t.Errorf("expected call EV 70, fold EV 0, diff -70, got %+v", result)
+		}
+		if result.Simulations != 500 {
+			t.Errorf("Simulations = %d, want 500", result.Simulations)
+		}
+	})
+
+	t.Run("raising the nuts against a calling station gains value", func(t *testing.T) {
+		result, err := CounterfactualEV(nutsOnRiverHistory, 0, "river", "cbr 200", nil, 500, randutil.New(42))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// Raise to 200: pot 70 + 200 from hero + 150 more from the caller, minus 200 invested
+		if result.AltEV != 220 || result.Diff != 150 {
+			t.Errorf("expected raise EV 220 and diff 150, got %+v", result)
+		}
+	})
+
+	t.Run("opponent range shifts equity", func(t *testing.T) {
+		history := HandHistory{
+			BlindsOrStraddles: []int{5, 10},
+			StartingStacks:    []int{1000, 1000},
+			Actions: []string{
+				"d dh p1 QhQd",
+				"d dh p2 ????",
+				"p1 cbr 30",
+				"p2 cbr 90",
+				"p1 cc",
+			},
+		}
+
+		vsAces, err := ParseRange("AA")
+		if err != nil {
+			t.Fatalf("failed to parse range: %v", err)
+		}
+		vsRandom, err := CounterfactualEV(history, 0, "preflop", "f", nil, 5000, randutil.New(7))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		vsNuts, err := CounterfactualEV(history, 0, "preflop", "f", vsAces, 5000, randutil.New(7))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if vsRandom.ActualAction != "cbr 30" {
+			t.Errorf("expected the first preflop decision to be found, got %q", vsRandom.ActualAction)
+		}
+		if vsRandom.Diff >= 0 {
+			t.Errorf("folding queens against a random hand should lose EV, got diff %.2f", vsRandom.Diff)
+		}
+		if vsNuts.Diff <= vsRandom.Diff {
+			t.Errorf("folding should cost less against aces (%.2f) than a random hand (%.2f)", vsNuts.Diff, vsRandom.Diff)
+		}
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		rng := randutil.New(42)
+		cases := []struct {
+			name   string
+			seat   int
+			street string
+			alt    string
+		}{
+			{"unknown street", 0, "showdown", "f"},
+			{"seat out of range", 5, "river", "f"},
+			{"unknown action", 0, "river", "shove"},
+			{"raise below current bet", 0, "river", "cbr 40"},
+			{"hidden hole cards", 1, "flop", "f"},
+		}
+		for _, tc := range cases {
+			if _, err := CounterfactualEV(nutsOnRiverHistory, tc.seat, tc.street, tc.alt, nil, 100, rng); err == nil {
+				t.Errorf("%s: expected an error", tc.name)
+			}
+		}
+	})
+}
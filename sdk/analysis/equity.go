@@ -40,8 +40,27 @@ func (e EquityResult) LossRate() float64 {
 	if e.TotalSimulations == 0 {
 		return 0.0
 	}
-	losses := e.TotalSimulations - e.Wins - e.Ties
-	return float64(losses) / float64(e.TotalSimulations)
+	return float64(e.Losses()) / float64(e.TotalSimulations)
+}
+
+// Losses returns the number of simulations hero lost
+func (e EquityResult) Losses() uint32 {
+	return e.TotalSimulations - e.Wins - e.Ties
+}
+
+// Iterations returns the number of simulations counted in the result
+func (e EquityResult) Iterations() uint32 {
+	return e.TotalSimulations
+}
+
+// Add combines the counts of two results, so independent runs can be pooled
+// into a single estimate
+func (e EquityResult) Add(other EquityResult) EquityResult {
+	return EquityResult{
+		Wins:             e.Wins + other.Wins,
+		Ties:             e.Ties + other.Ties,
+		TotalSimulations: e.TotalSimulations + other.TotalSimulations,
+	}
 }
 
 // Equity returns the overall equity (0.0 to 1.0)
@@ -55,6 +74,15 @@ func (e EquityResult) Equity() float64 {
 	return (winEquity + tieEquity) / float64(e.TotalSimulations)
 }
 
+// StdErr returns the standard error of the equity estimate (binomial proportion)
+func (e EquityResult) StdErr() float64 {
+	if e.TotalSimulations == 0 {
+		return math.Inf(1)
+	}
+	equity := e.Equity()
+	return math.Sqrt((equity * (1.0 - equity)) / float64(e.TotalSimulations))
+}
+
 // ConfidenceInterval returns the 95% confidence interval for equity
 func (e EquityResult) ConfidenceInterval() (lower, upper float64) {
 	equity := e.Equity()
@@ -64,11 +92,8 @@ func (e EquityResult) ConfidenceInterval() (lower, upper float64) {
 		return 0.0, 0.0
 	}
 
-	// Standard error for binomial proportion
-	se := math.Sqrt((equity * (1.0 - equity)) / n)
-
 	// 95% confidence interval (±1.96 * SE)
-	margin := 1.96 * se
+	margin := 1.96 * e.StdErr()
 
 	lower = math.Max(0.0, equity-margin)
 	upper = math.Min(1.0, equity+margin)
@@ -82,121 +107,288 @@ func (e EquityResult) ConfidenceInterval() (lower, upper float64) {
 // opponents: Number of opponents (each gets 2 random hole cards)
 // simulations: Number of simulations to run
 func CalculateEquity(heroHand poker.Hand, board poker.Hand, opponents int, simulations int, rng *rand.Rand) EquityResult {
-	// Validate hero hand has exactly 2 cards
-	if heroHand.CountCards() != 2 {
+	// Validate simulations is positive
+	if simulations <= 0 {
 		return EquityResult{} // Invalid input
 	}
 
-	// Validate board has at most 5 cards
-	if board.CountCards() > 5 {
-		return EquityResult{} // Invalid input
+	sim, ok := newEquitySimulator(heroHand, board, opponents, rng)
+	if !ok {
+		return EquityResult{}
 	}
 
-	// Validate simulations is positive
-	if simulations <= 0 {
-		return EquityResult{} // Invalid input
+	var wins, ties uint32
+	for range simulations {
+		win, tie := sim.run()
+		if win {
+			wins++
+		} else if tie {
+			ties++
+		}
 	}
 
-	// Validate we have enough cards in deck
-	cardsNeeded := 2 + board.CountCards() + (5 - board.CountCards()) + (opponents * 2)
-	if cardsNeeded > 52 {
-		return EquityResult{} // Not enough cards in deck
+	return EquityResult{
+		Wins:             wins,
+		Ties:             ties,
+		TotalSimulations: uint32(simulations),
 	}
+}
 
-	// Validate hero hand and board don't overlap
-	if (heroHand & board) != 0 {
-		return EquityResult{} // Overlapping cards
+// CalculateMultiwayEquity estimates each player's share of the pot when every
+// player's hole cards are known, running out the remaining board. Ties split the
+// pot evenly. A complete board is evaluated exactly. Returns nil if fewer than two
+// hands are given, a hand doesn't have exactly 2 cards, or any cards overlap.
+func CalculateMultiwayEquity(hands []poker.Hand, board poker.Hand, simulations int, rng *rand.Rand) []float64 {
+	if len(hands) < 2 || simulations <= 0 || board.CountCards() > 5 {
+		return nil
 	}
 
-	if opponents < 1 {
-		opponents = 1 // At least one opponent
+	known := board
+	for _, hand := range hands {
+		if hand.CountCards() != 2 || known&hand != 0 {
+			return nil
+		}
+		known |= hand
 	}
 
-	var wins, ties uint32
+	missing := 5 - board.CountCards()
+	if missing == 0 {
+		simulations = 1
+	}
 
-	// Pre-allocate deck for reuse
 	deck := poker.NewDeck(rng)
-
+	shares := make([]float64, len(hands))
+	ranks := make([]poker.HandRank, len(hands))
+	completed := 0
 	for range simulations {
 		deck.Shuffle()
+		used := known
+		finalBoard := board
+		dealt := true
+		for range missing {
+			card, ok := dealUnused(deck, &used)
+			if !ok {
+				dealt = false
+				break
+			}
+			finalBoard.AddCard(card)
+		}
+		if !dealt {
+			continue
+		}
 
-		// Declare variables at top to avoid goto issues
-		var heroWins = true
-		var heroTies = false
-		var heroFullHand poker.Hand
-		var heroRank poker.HandRank
+		var best poker.HandRank
+		winners := 0
+		for i, hand := range hands {
+			ranks[i] = poker.Evaluate7Cards(hand | finalBoard)
+			switch poker.CompareHands(ranks[i], best) {
+			case 1:
+				best = ranks[i]
+				winners = 1
+			case 0:
+				winners++
+			}
+		}
+		for i := range hands {
+			if ranks[i] == best {
+				shares[i] += 1 / float64(winners)
+			}
+		}
+		completed++
+	}
 
-		// Create used cards mask to avoid dealing duplicates
-		usedCards := heroHand | board
+	if completed == 0 {
+		return nil
+	}
+	for i := range shares {
+		shares[i] /= float64(completed)
+	}
+	return shares
+}
 
-		// Deal remaining board cards if needed
-		finalBoard := board
-		cardsNeeded := 5 - board.CountCards()
-		for range cardsNeeded {
-			for {
-				card := deck.DealOne()
-				if card == 0 {
-					// Deck exhausted - abort this simulation
-					goto nextSimulation
-				}
-				if !usedCards.HasCard(card) {
-					finalBoard.AddCard(card)
-					usedCards.AddCard(card)
-					break
-				}
-			}
+// HeadsUpEquityExact returns each hand's share of the pot between holeA and
+// holeB by enumerating every runout of the remaining board, with ties split
+// evenly. Preflop that is all 1,712,304 five-card boards, so it is an oracle for
+// the Monte Carlo functions rather than something to call while acting. Returns
+// 0, 0 if a hand doesn't have exactly 2 cards, the board has more than 5, or any
+// cards overlap.
+func HeadsUpEquityExact(holeA, holeB, board poker.Hand) (eqA, eqB float64) {
+	if holeA.CountCards() != 2 || holeB.CountCards() != 2 || board.CountCards() > 5 ||
+		holeA&holeB != 0 || (holeA|holeB)&board != 0 {
+		return 0, 0
+	}
+
+	known := holeA | holeB | board
+	remaining := make([]poker.Card, 0, 52-known.CountCards())
+	for i := range uint8(52) {
+		if card := poker.Card(1) << i; !known.HasCard(card) {
+			remaining = append(remaining, card)
 		}
+	}
 
-		// Deal opponent hole cards and evaluate
-		heroFullHand = heroHand | finalBoard
-		heroRank = poker.Evaluate7Cards(heroFullHand)
-
-		// Compare against all opponents
-
-		for opp := 0; opp < opponents; opp++ {
-			// Deal 2 hole cards for this opponent
-			var oppHand poker.Hand
-			for range 2 {
-				for {
-					card := deck.DealOne()
-					if card == 0 {
-						// Deck exhausted - abort this simulation
-						goto nextSimulation
-					}
-					if !usedCards.HasCard(card) {
-						oppHand.AddCard(card)
-						usedCards.AddCard(card)
-						break
-					}
-				}
+	var winsA, winsB, ties, runouts int
+	var deal func(start, missing int, runout poker.Hand)
+	deal = func(start, missing int, runout poker.Hand) {
+		if missing == 0 {
+			switch poker.CompareHands(poker.Evaluate7Cards(holeA|runout), poker.Evaluate7Cards(holeB|runout)) {
+			case 1:
+				winsA++
+			case -1:
+				winsB++
+			default:
+				ties++
 			}
+			runouts++
+			return
+		}
+		for i := start; i <= len(remaining)-missing; i++ {
+			deal(i+1, missing-1, runout|poker.Hand(remaining[i]))
+		}
+	}
+	deal(0, 5-board.CountCards(), board)
+
+	half := float64(ties) / 2
+	return (float64(winsA) + half) / float64(runouts), (float64(winsB) + half) / float64(runouts)
+}
 
-			// Evaluate opponent's hand
-			oppFullHand := oppHand | finalBoard
-			oppRank := poker.Evaluate7Cards(oppFullHand)
-			comparison := poker.CompareHands(heroRank, oppRank)
+// adaptiveBatchSize is how many simulations CalculateEquityAdaptive runs between
+// convergence checks, and the minimum it always runs.
+const adaptiveBatchSize = 100
 
-			if comparison < 0 {
-				heroWins = false
-				break
-			} else if comparison == 0 {
-				heroTies = true
+// CalculateEquityAdaptive runs Monte Carlo simulations until the standard error of the
+// equity estimate falls below targetStdErr or maxIters simulations have run. Lopsided
+// spots converge after a few batches while close ones keep sampling for precision.
+// The result's TotalSimulations reports the number of iterations used.
+func CalculateEquityAdaptive(heroHand poker.Hand, board poker.Hand, opponents int, targetStdErr float64, maxIters int, rng *rand.Rand) EquityResult {
+	if maxIters <= 0 || targetStdErr <= 0 {
+		return EquityResult{} // Invalid input
+	}
+
+	sim, ok := newEquitySimulator(heroHand, board, opponents, rng)
+	if !ok {
+		return EquityResult{}
+	}
+
+	var result EquityResult
+	for int(result.TotalSimulations) < maxIters {
+		batch := min(adaptiveBatchSize, maxIters-int(result.TotalSimulations))
+		for range batch {
+			win, tie := sim.run()
+			if win {
+				result.Wins++
+			} else if tie {
+				result.Ties++
 			}
 		}
+		result.TotalSimulations += uint32(batch)
 
-		if heroWins {
-			if heroTies {
-				ties++
-			} else {
-				wins++
+		if result.StdErr() < targetStdErr {
+			break
+		}
+	}
+
+	return result
+}
+
+// equitySimulator deals and evaluates individual Monte Carlo runouts for one spot.
+type equitySimulator struct {
+	heroHand  poker.Hand
+	board     poker.Hand
+	opponents int
+	deck      *poker.Deck
+}
+
+// newEquitySimulator validates the spot, returning false if it can't be simulated.
+func newEquitySimulator(heroHand poker.Hand, board poker.Hand, opponents int, rng *rand.Rand) (*equitySimulator, bool) {
+	// Validate hero hand has exactly 2 cards
+	if heroHand.CountCards() != 2 {
+		return nil, false
+	}
+
+	// Validate board has at most 5 cards
+	if board.CountCards() > 5 {
+		return nil, false
+	}
+
+	// Validate we have enough cards in deck
+	cardsNeeded := 2 + board.CountCards() + (5 - board.CountCards()) + (opponents * 2)
+	if cardsNeeded > 52 {
+		return nil, false // Not enough cards in deck
+	}
+
+	// Validate hero hand and board don't overlap
+	if (heroHand & board) != 0 {
+		return nil, false
+	}
+
+	if opponents < 1 {
+		opponents = 1 // At least one opponent
+	}
+
+	return &equitySimulator{
+		heroHand:  heroHand,
+		board:     board,
+		opponents: opponents,
+		deck:      poker.NewDeck(rng), // Pre-allocate deck for reuse
+	}, true
+}
+
+// run simulates a single runout, reporting whether hero won outright or tied for the best hand.
+func (s *equitySimulator) run() (win, tie bool) {
+	s.deck.Shuffle()
+
+	// Create used cards mask to avoid dealing duplicates
+	usedCards := s.heroHand | s.board
+
+	// Deal remaining board cards if needed
+	finalBoard := s.board
+	for range 5 - s.board.CountCards() {
+		card, ok := dealUnused(s.deck, &usedCards)
+		if !ok {
+			return false, false // Deck exhausted - abort this simulation
+		}
+		finalBoard.AddCard(card)
+	}
+
+	heroRank := poker.Evaluate7Cards(s.heroHand | finalBoard)
+
+	// Compare against all opponents
+	heroTies := false
+	for range s.opponents {
+		// Deal 2 hole cards for this opponent
+		var oppHand poker.Hand
+		for range 2 {
+			card, ok := dealUnused(s.deck, &usedCards)
+			if !ok {
+				return false, false // Deck exhausted - abort this simulation
 			}
+			oppHand.AddCard(card)
+		}
+
+		// Evaluate opponent's hand
+		oppRank := poker.Evaluate7Cards(oppHand | finalBoard)
+		comparison := poker.CompareHands(heroRank, oppRank)
+
+		if comparison < 0 {
+			return false, false
+		} else if comparison == 0 {
+			heroTies = true
 		}
-	nextSimulation:
 	}
 
-	return EquityResult{
-		Wins:             wins,
-		Ties:             ties,
-		TotalSimulations: uint32(simulations),
+	return !heroTies, heroTies
+}
+
+// dealUnused deals the next card not already in used, adding it to used.
+func dealUnused(deck *poker.Deck, used *poker.Hand) (poker.Card, bool) {
+	for {
+		card := deck.DealOne()
+		if card == 0 {
+			return 0, false
+		}
+		if !used.HasCard(card) {
+			used.AddCard(card)
+			return card, true
+		}
 	}
 }
@@ -0,0 +1,153 @@
+package analysis
+
+import (
+	"cmp"
+	"container/list"
+	rand "math/rand/v2"
+	"slices"
+	"sync"
+
+	"github.com/lox/pokerforbots/v2/poker"
+)
+
+// EquityCache memoizes Monte Carlo equity results with least-recently-used eviction.
+// Spots are keyed by their suit-canonical form, so suit-isomorphic spots (e.g. AhKh
+// on a spade board and AdKd on a club board) share an entry. Safe for concurrent use.
+type EquityCache struct {
+	mu          sync.Mutex
+	capacity    int
+	simulations int
+	entries     map[equityKey]*list.Element
+	order       *list.List // Front is most recently used
+	hits        uint64
+	misses      uint64
+}
+
+type equityKey struct {
+	hole      poker.Hand
+	board     poker.Hand
+	opponents int
+}
+
+type equityEntry struct {
+	key    equityKey
+	result EquityResult
+}
+
+// NewEquityCache creates a cache holding up to capacity spots, computing misses
+// with the given number of Monte Carlo simulations.
+func NewEquityCache(capacity, simulations int) *EquityCache {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &EquityCache{
+		capacity:    capacity,
+		simulations: simulations,
+		entries:     make(map[equityKey]*list.Element, capacity),
+		order:       list.New(),
+	}
+}
+
+// Equity returns the cached equity for the spot, running CalculateEquity on a miss.
+// The rng is only used when the spot has to be simulated.
+func (c *EquityCache) Equity(hole, board poker.Hand, opponents int, rng *rand.Rand) EquityResult {
+	key := canonicalEquityKey(hole, board, opponents)
+
+	c.mu.Lock()
+	if elem, ok := c.entries[key]; ok {
+		c.order.MoveToFront(elem)
+		c.hits++
+		result := elem.Value.(*equityEntry).result
+		c.mu.Unlock()
+		return result
+	}
+	c.misses++
+	c.mu.Unlock()
+
+	result := CalculateEquity(key.hole, key.board, key.opponents, c.simulations, rng)
+	c.store(key, result)
+	return result
+}
+
+// Warmup pre-computes equity for all 169 starting hands preflop against each of
+// the given opponent counts.
+func (c *EquityCache) Warmup(rng *rand.Rand, opponents ...int) {
+	for _, opp := range opponents {
+		for high := int(poker.Ace); high >= int(poker.Two); high-- {
+			for low := high; low >= int(poker.Two); low-- {
+				c.Equity(startingHand(uint8(high), uint8(low), false), 0, opp, rng)
+				if low != high {
+					c.Equity(startingHand(uint8(high), uint8(low), true), 0, opp, rng)
+				}
+			}
+		}
+	}
+}
+
+// Stats returns the number of cache hits and misses so far.
+func (c *EquityCache) Stats() (hits, misses uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.hits, c.misses
+}
+
+// Len returns the number of cached spots.
+func (c *EquityCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+func (c *EquityCache) store(key equityKey, result EquityResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		// Another goroutine computed the same spot concurrently
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&equityEntry{key: key, result: result})
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*equityEntry).key)
+	}
+}
+
+// startingHand builds a two-card hand from ranks; pairs are always offsuit.
+func startingHand(high, low uint8, suited bool) poker.Hand {
+	lowSuit := poker.Diamonds
+	if suited {
+		lowSuit = poker.Clubs
+	}
+	return poker.NewHand(poker.NewCard(high, poker.Clubs), poker.NewCard(low, lowSuit))
+}
+
+// canonicalEquityKey relabels suits so that isomorphic spots produce the same key.
+// Suits are ordered by their hole and board rank masks; suits with identical masks
+// are interchangeable, so ties don't affect the result.
+func canonicalEquityKey(hole, board poker.Hand, opponents int) equityKey {
+	type suitMasks struct {
+		hole, board uint16
+	}
+	var suits [4]suitMasks
+	for suit := range uint8(4) {
+		suits[suit] = suitMasks{hole: hole.GetSuitMask(suit), board: board.GetSuitMask(suit)}
+	}
+	slices.SortFunc(suits[:], func(a, b suitMasks) int {
+		if c := cmp.Compare(b.hole, a.hole); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.board, a.board)
+	})
+
+	key := equityKey{opponents: max(opponents, 1)}
+	for i, masks := range suits {
+		offset := uint(i) * 13
+		key.hole |= poker.Hand(masks.hole) << offset
+		key.board |= poker.Hand(masks.board) << offset
+	}
+	return key
+}
@@ -0,0 +1,120 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/lox/pokerforbots/v2/internal/randutil"
+)
+
+func TestEquityCacheHitsRepeatedSpots(t *testing.T) {
+	t.Parallel()
+
+	cache := NewEquityCache(16, 500)
+	rng := randutil.New(42)
+
+	hole := mustParseHand("Ah", "Kh")
+	board := mustParseHand("Qh", "7d", "2c")
+
+	first := cache.Equity(hole, board, 2, rng)
+	for range 5 {
+		if got := cache.Equity(hole, board, 2, rng); got != first {
+			t.Fatalf("cached result changed: got %+v, want %+v", got, first)
+		}
+	}
+
+	// Relabelling the suits (hearts->spades, diamonds->clubs, clubs->diamonds) is the same spot
+	iso := cache.Equity(mustParseHand("As", "Ks"), mustParseHand("Qs", "7c", "2d"), 2, rng)
+	if iso != first {
+		t.Errorf("suit-isomorphic spot should share the cache entry: got %+v, want %+v", iso, first)
+	}
+
+	hits, misses := cache.Stats()
+	if hits != 6 || misses != 1 {
+		t.Errorf("expected 6 hits and 1 miss, got %d hits and %d misses", hits, misses)
+	}
+
+	// A different opponent count or a different board is a different spot
+	cache.Equity(hole, board, 3, rng)
+	cache.Equity(hole, mustParseHand("Qh", "7h", "2c"), 2, rng)
+	if _, misses := cache.Stats(); misses != 3 {
+		t.Errorf("expected 3 misses after distinct spots, got %d", misses)
+	}
+}
+
+func TestEquityCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	t.Parallel()
+
+	cache := NewEquityCache(2, 100)
+	rng := randutil.New(7)
+
+	aces := mustParseHand("Ac", "Ad")
+	kings := mustParseHand("Kc", "Kd")
+	queens := mustParseHand("Qc", "Qd")
+
+	cache.Equity(aces, 0, 1, rng)
+	cache.Equity(kings, 0, 1, rng)
+	cache.Equity(aces, 0, 1, rng)   // Aces become most recently used
+	cache.Equity(queens, 0, 1, rng) // Evicts kings
+
+	if cache.Len() != 2 {
+		t.Fatalf("expected 2 cached spots, got %d", cache.Len())
+	}
+
+	_, before := cache.Stats()
+	cache.Equity(aces, 0, 1, rng)
+	if _, after := cache.Stats(); after != before {
+		t.Error("expected aces to still be cached")
+	}
+	cache.Equity(kings, 0, 1, rng)
+	if _, after := cache.Stats(); after != before+1 {
+		t.Error("expected kings to have been evicted")
+	}
+}
+
+func TestEquityCacheWarmup(t *testing.T) {
+	t.Parallel()
+
+	cache := NewEquityCache(1000, 50)
+	rng := randutil.New(3)
+	cache.Warmup(rng, 1, 2)
+
+	if cache.Len() != 2*169 {
+		t.Fatalf("expected %d warmed spots, got %d", 2*169, cache.Len())
+	}
+
+	_, misses := cache.Stats()
+	cache.Equity(mustParseHand("7s", "2h"), 0, 2, rng)
+	cache.Equity(mustParseHand("Jd", "Td"), 0, 1, rng)
+	if _, after := cache.Stats(); after != misses {
+		t.Errorf("expected warmed preflop spots to hit, got %d new misses", after-misses)
+	}
+}
+
+// BenchmarkEquityCache_RepeatedSpots compares simulating every decision against
+// a cache when the same postflop spots recur, as they do over thousands of hands.
+func BenchmarkEquityCache_RepeatedSpots(b *testing.B) {
+	const simulations = 1000
+	scenarios := generateRandomEquityScenarios(50, 2125)
+
+	b.Run("uncached", func(b *testing.B) {
+		rng := randutil.New(42)
+		evaluations := 0
+		for i := 0; b.Loop(); i++ {
+			scenario := scenarios[i%len(scenarios)]
+			benchSinkEquity = CalculateEquity(scenario.heroHand, scenario.board, scenario.opponents, simulations, rng).Equity()
+			evaluations++
+		}
+		b.ReportMetric(float64(evaluations)/float64(b.N), "evals/op")
+	})
+
+	b.Run("cached", func(b *testing.B) {
+		rng := randutil.New(42)
+		cache := NewEquityCache(len(scenarios), simulations)
+		for i := 0; b.Loop(); i++ {
+			scenario := scenarios[i%len(scenarios)]
+			benchSinkEquity = cache.Equity(scenario.heroHand, scenario.board, scenario.opponents, rng).Equity()
+		}
+		_, misses := cache.Stats()
+		b.ReportMetric(float64(misses)/float64(b.N), "evals/op")
+	})
+}
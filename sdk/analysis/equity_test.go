@@ -81,6 +81,32 @@ func TestConfidenceInterval(t *testing.T) {
 	}
 }
 
+func TestEquityResultCounts(t *testing.T) {
+	// A weak kicker on a paired board ties often, so every count is exercised
+	heroHand, _ := poker.ParseHand("Ah", "2c")
+	board, _ := poker.ParseHand("Ks", "Kd", "Qs", "Qh")
+
+	first := CalculateEquity(heroHand, board, 1, 2000, randutil.New(7))
+	second := CalculateEquity(heroHand, board, 1, 3000, randutil.New(8))
+
+	for _, result := range []EquityResult{first, second, first.Add(second)} {
+		if result.Wins == 0 || result.Ties == 0 || result.Losses() == 0 {
+			t.Fatalf("expected wins, ties and losses, got %d/%d/%d", result.Wins, result.Ties, result.Losses())
+		}
+		if sum := result.Wins + result.Ties + result.Losses(); sum != result.Iterations() {
+			t.Errorf("wins + ties + losses = %d, want %d iterations", sum, result.Iterations())
+		}
+		derived := (float64(result.Wins) + float64(result.Ties)/2) / float64(result.Iterations())
+		if math.Abs(derived-result.Equity()) > 1e-12 {
+			t.Errorf("equity from counts = %v, Equity() = %v", derived, result.Equity())
+		}
+	}
+
+	if combined := first.Add(second); combined.Iterations() != 5000 || combined.Wins != first.Wins+second.Wins {
+		t.Errorf("combined result %+v doesn't pool %+v and %+v", combined, first, second)
+	}
+}
+
 func TestCalculateEquity(t *testing.T) {
 	t.Run("pocket aces vs random", func(t *testing.T) {
 		rng := randutil.New(42)
@@ -187,6 +213,176 @@ func TestCalculateEquity(t *testing.T) {
 	})
 }
 
+func TestCalculateEquityAdaptive(t *testing.T) {
+	const (
+		targetStdErr = 0.005
+		maxIters     = 20000
+	)
+
+	t.Run("lopsided spot converges quickly", func(t *testing.T) {
+		rng := randutil.New(42)
+
+		// Royal flush on the river can't lose
+		heroHand, _ := poker.ParseHand("As", "Ks")
+		board, _ := poker.ParseHand("Qs", "Js", "Ts", "2c", "3d")
+
+		result := CalculateEquityAdaptive(heroHand, board, 1, targetStdErr, maxIters, rng)
+		if result.Equity() != 1.0 {
+			t.Errorf("royal flush equity = %v, want 1.0", result.Equity())
+		}
+		if result.TotalSimulations != adaptiveBatchSize {
+			t.Errorf("expected to stop after one batch of %d, used %d", adaptiveBatchSize, result.TotalSimulations)
+		}
+	})
+
+	t.Run("close spot uses more iterations", func(t *testing.T) {
+		rng := randutil.New(42)
+
+		// Flush draw plus overcards on the flop is close to a coin flip
+		heroHand, _ := poker.ParseHand("Ah", "Kh")
+		board, _ := poker.ParseHand("7h", "4h", "2c")
+
+		lopsided := CalculateEquityAdaptive(mustParseHand("As", "Ad"), mustParseHand("Ac", "Ah", "2d"), 1, targetStdErr, maxIters, rng)
+		result := CalculateEquityAdaptive(heroHand, board, 2, targetStdErr, maxIters, rng)
+
+		if result.TotalSimulations <= lopsided.TotalSimulations {
+			t.Errorf("close spot used %d iterations, expected more than lopsided spot's %d",
+				result.TotalSimulations, lopsided.TotalSimulations)
+		}
+		if result.TotalSimulations > maxIters {
+			t.Errorf("used %d iterations, exceeding max of %d", result.TotalSimulations, maxIters)
+		}
+		if result.StdErr() >= targetStdErr && result.TotalSimulations != maxIters {
+			t.Errorf("stopped at %d iterations with std err %v above target", result.TotalSimulations, result.StdErr())
+		}
+	})
+
+	t.Run("max iterations caps sampling", func(t *testing.T) {
+		rng := randutil.New(42)
+		heroHand, _ := poker.ParseHand("7c", "2d")
+
+		result := CalculateEquityAdaptive(heroHand, 0, 1, 0.0001, 250, rng)
+		if result.TotalSimulations != 250 {
+			t.Errorf("TotalSimulations = %v, want 250", result.TotalSimulations)
+		}
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		rng := randutil.New(42)
+		heroHand, _ := poker.ParseHand("As", "Ad")
+
+		if result := CalculateEquityAdaptive(heroHand, 0, 1, 0, maxIters, rng); result.TotalSimulations != 0 {
+			t.Errorf("zero target std err should return empty result")
+		}
+		if result := CalculateEquityAdaptive(heroHand, 0, 1, targetStdErr, 0, rng); result.TotalSimulations != 0 {
+			t.Errorf("zero max iterations should return empty result")
+		}
+		if result := CalculateEquityAdaptive(mustParseHand("As"), 0, 1, targetStdErr, maxIters, rng); result.TotalSimulations != 0 {
+			t.Errorf("single hole card should return empty result")
+		}
+	})
+}
+
+func TestCalculateMultiwayEquity(t *testing.T) {
+	t.Run("aces against kings preflop", func(t *testing.T) {
+		rng := randutil.New(42)
+		hands := []poker.Hand{mustParseHand("As", "Ad"), mustParseHand("Ks", "Kd")}
+
+		shares := CalculateMultiwayEquity(hands, 0, 20000, rng)
+		if len(shares) != 2 {
+			t.Fatalf("expected 2 shares, got %v", shares)
+		}
+		if shares[0] < 0.79 || shares[0] > 0.85 {
+			t.Errorf("AA equity = %.3f, want ~0.82", shares[0])
+		}
+		if math.Abs(shares[0]+shares[1]-1) > 1e-9 {
+			t.Errorf("shares should sum to 1, got %v", shares)
+		}
+	})
+
+	t.Run("complete board is exact and splits ties", func(t *testing.T) {
+		rng := randutil.New(42)
+		board := mustParseHand("As", "Ks", "Qd", "Jc", "Th")
+		hands := []poker.Hand{
+			mustParseHand("2c", "3d"),
+			mustParseHand("4c", "5d"),
+			mustParseHand("Ah", "Ad"),
+		}
+
+		// Everyone plays the broadway straight on the board
+		shares := CalculateMultiwayEquity(hands, board, 1000, rng)
+		for i, share := range shares {
+			if math.Abs(share-1.0/3) > 1e-9 {
+				t.Errorf("hand %d share = %v, want 1/3", i, share)
+			}
+		}
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		rng := randutil.New(42)
+		aces := mustParseHand("As", "Ad")
+
+		if shares := CalculateMultiwayEquity([]poker.Hand{aces}, 0, 100, rng); shares != nil {
+			t.Errorf("single hand should return nil, got %v", shares)
+		}
+		if shares := CalculateMultiwayEquity([]poker.Hand{aces, mustParseHand("As", "Kd")}, 0, 100, rng); shares != nil {
+			t.Errorf("overlapping hands should return nil, got %v", shares)
+		}
+		if shares := CalculateMultiwayEquity([]poker.Hand{aces, mustParseHand("Kd")}, 0, 100, rng); shares != nil {
+			t.Errorf("single hole card should return nil, got %v", shares)
+		}
+	})
+}
+
+func TestHeadsUpEquityExact(t *testing.T) {
+	t.Run("aces against kings preflop", func(t *testing.T) {
+		// Sharing one suit; the exact figure moves with how the suits overlap
+		eqA, eqB := HeadsUpEquityExact(mustParseHand("As", "Ah"), mustParseHand("Ks", "Kd"), 0)
+		if math.Abs(eqA-0.819) > 0.001 {
+			t.Errorf("AA equity = %.4f, want ~0.819", eqA)
+		}
+		if math.Abs(eqA+eqB-1) > 1e-9 {
+			t.Errorf("equities should sum to 1, got %.4f + %.4f", eqA, eqB)
+		}
+	})
+
+	t.Run("coinflip", func(t *testing.T) {
+		eqA, _ := HeadsUpEquityExact(mustParseHand("Qs", "Qh"), mustParseHand("Ad", "Kc"), 0)
+		if eqA < 0.55 || eqA > 0.58 {
+			t.Errorf("QQ against AKo = %.4f, want ~0.57", eqA)
+		}
+	})
+
+	t.Run("counts outs on the turn", func(t *testing.T) {
+		board := mustParseHand("2c", "7d", "9s", "Jh")
+		eqA, eqB := HeadsUpEquityExact(mustParseHand("Ah", "Kh"), mustParseHand("Qs", "Qc"), board)
+		// Three aces and three kings of the 44 river cards
+		if math.Abs(eqA-6.0/44) > 1e-9 || math.Abs(eqB-38.0/44) > 1e-9 {
+			t.Errorf("equities = %.4f, %.4f, want 6/44 and 38/44", eqA, eqB)
+		}
+	})
+
+	t.Run("matches the Monte Carlo estimate on the flop", func(t *testing.T) {
+		holeA, holeB := mustParseHand("Ah", "Th"), mustParseHand("Kc", "Kd")
+		board := mustParseHand("Kh", "7h", "2s")
+		exact, _ := HeadsUpEquityExact(holeA, holeB, board)
+		shares := CalculateMultiwayEquity([]poker.Hand{holeA, holeB}, board, 20000, randutil.New(42))
+		if math.Abs(exact-shares[0]) > 0.015 {
+			t.Errorf("exact equity %.4f differs from Monte Carlo %.4f", exact, shares[0])
+		}
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		aces := mustParseHand("As", "Ad")
+		if eqA, eqB := HeadsUpEquityExact(aces, mustParseHand("As", "Kd"), 0); eqA != 0 || eqB != 0 {
+			t.Errorf("overlapping hands should return 0, 0, got %v, %v", eqA, eqB)
+		}
+		if eqA, eqB := HeadsUpEquityExact(aces, mustParseHand("Kd"), 0); eqA != 0 || eqB != 0 {
+			t.Errorf("single hole card should return 0, 0, got %v, %v", eqA, eqB)
+		}
+	})
+}
+
 func TestEquityCalculatorWithProperEvaluator(t *testing.T) {
 	t.Run("uses poker.Evaluate7Cards", func(t *testing.T) {
 		// This test verifies that we're using the proper hand evaluator
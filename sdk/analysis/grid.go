@@ -0,0 +1,78 @@
+package analysis
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lox/pokerforbots/v2/poker"
+)
+
+// gridRanks labels the grid's rows and columns, strongest first.
+const gridRanks = "AKQJT98765432"
+
+// gridShades marks cells that are only partly in the range, from least to most
+// covered.
+var gridShades = []byte{'.', ':', '+'}
+
+// GridString renders the range as the standard 13x13 starting hand matrix: pairs
+// on the diagonal, suited hands above it and offsuit hands below, with aces in
+// the top row and left column. Hands entirely in the range show their label,
+// hands not in it show "-", and hands partly in it (some combos, or weights
+// below 1) show their label followed by a shade for how much is covered:
+// '.' under a third, ':' under two thirds and '+' above that.
+func (r *Range) GridString() string {
+	var b strings.Builder
+	cells := make([]string, len(gridRanks))
+	for row := range len(gridRanks) {
+		for col := range len(gridRanks) {
+			label, coverage := r.gridCell(row, col)
+			switch {
+			case coverage <= 0:
+				cells[col] = "-"
+			case coverage >= 1:
+				cells[col] = label
+			default:
+				cells[col] = label + string(gridShades[min(int(coverage*3), 2)])
+			}
+			cells[col] = fmt.Sprintf("%-4s", cells[col])
+		}
+		b.WriteString(strings.TrimRight(strings.Join(cells, " "), " "))
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// gridCell returns the label of the hand at row and col of the grid and the
+// weighted share of its combos in the range.
+func (r *Range) gridCell(row, col int) (string, float64) {
+	high, low := min(row, col), max(row, col)
+	label := string(gridRanks[high]) + string(gridRanks[low])
+	highRank := uint8(len(gridRanks) - 1 - high)
+	lowRank := uint8(len(gridRanks) - 1 - low)
+
+	var total float64
+	combos := 0
+	for suit1 := range uint8(4) {
+		for suit2 := range uint8(4) {
+			switch {
+			case row == col && suit2 <= suit1:
+				continue
+			case col > row && suit1 != suit2:
+				continue
+			case col < row && suit1 == suit2:
+				continue
+			}
+			hand := poker.NewHand(poker.NewCard(highRank, suit1), poker.NewCard(lowRank, suit2))
+			total += r.hands[hand]
+			combos++
+		}
+	}
+
+	switch {
+	case col > row:
+		label += "s"
+	case col < row:
+		label += "o"
+	}
+	return label, total / float64(combos)
+}
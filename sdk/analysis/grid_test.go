@@ -0,0 +1,81 @@
+package analysis
+
+import (
+	"strings"
+	"testing"
+)
+
+// gridCells splits a rendered grid into its 13 rows of 13 cells.
+func gridCells(t *testing.T, grid string) [][]string {
+	t.Helper()
+	lines := strings.Split(strings.TrimSuffix(grid, "\n"), "\n")
+	if len(lines) != 13 {
+		t.Fatalf("expected 13 rows, got %d", len(lines))
+	}
+	cells := make([][]string, len(lines))
+	for i, line := range lines {
+		cells[i] = strings.Fields(line)
+		if len(cells[i]) != 13 {
+			t.Fatalf("expected 13 cells in row %d, got %d: %q", i, len(cells[i]), line)
+		}
+	}
+	return cells
+}
+
+func TestGridStringPairsOnDiagonal(t *testing.T) {
+	r, err := ParseRange("22+")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for row, cells := range gridCells(t, r.GridString()) {
+		for col, cell := range cells {
+			if row == col {
+				want := strings.Repeat(string(gridRanks[row]), 2)
+				if cell != want {
+					t.Errorf("expected %s on the diagonal at %d, got %q", want, row, cell)
+				}
+			} else if cell != "-" {
+				t.Errorf("expected (%d,%d) to be empty, got %q", row, col, cell)
+			}
+		}
+	}
+}
+
+func TestGridStringSuitedAndOffsuit(t *testing.T) {
+	r, err := ParseRange("AKs,KQo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cells := gridCells(t, r.GridString())
+	if cells[0][1] != "AKs" {
+		t.Errorf("expected AKs above the diagonal, got %q", cells[0][1])
+	}
+	if cells[1][0] != "-" {
+		t.Errorf("expected AKo to be empty, got %q", cells[1][0])
+	}
+	if cells[2][1] != "KQo" {
+		t.Errorf("expected KQo below the diagonal, got %q", cells[2][1])
+	}
+}
+
+func TestGridStringShadesPartialHands(t *testing.T) {
+	r := NewRange()
+	if err := r.addSingleHand("AA", 0.5); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.addSingleHand("KK", 0.25); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.addSingleHand("QQ", 0.9); err != nil {
+		t.Fatal(err)
+	}
+
+	cells := gridCells(t, r.GridString())
+	for i, want := range []string{"AA:", "KK.", "QQ+"} {
+		if cells[i][i] != want {
+			t.Errorf("expected %s, got %q", want, cells[i][i])
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package analysis
+
+// ICM returns each player's share of the prize pool by the Independent Chip
+// Model: a player finishes first with probability proportional to their stack,
+// and each later place is decided the same way among the players left.
+// payouts[0] is the prize for first place. Players with no chips have already
+// busted and get nothing, and prizes beyond the number of players with chips
+// aren't awarded.
+func ICM(stacks []int, payouts []float64) []float64 {
+	equity := make([]float64, len(stacks))
+	var remaining uint64
+	for i, stack := range stacks {
+		if stack > 0 {
+			remaining |= 1 << i
+		}
+	}
+	if remaining == 0 || len(stacks) > 64 {
+		return equity
+	}
+
+	memo := make(map[uint64][]float64)
+	var solve func(remaining uint64, place int) []float64
+	solve = func(remaining uint64, place int) []float64 {
+		if place >= len(payouts) || remaining == 0 {
+			return nil
+		}
+		if cached, ok := memo[remaining]; ok {
+			return cached
+		}
+
+		total := 0
+		for i, stack := range stacks {
+			if remaining&(1<<i) != 0 {
+				total += stack
+			}
+		}
+		result := make([]float64, len(stacks))
+		for i, stack := range stacks {
+			if remaining&(1<<i) == 0 {
+				continue
+			}
+			p := float64(stack) / float64(total)
+			result[i] += p * payouts[place]
+			for j, rest := range solve(remaining&^(1<<i), place+1) {
+				result[j] += p * rest
+			}
+		}
+		memo[remaining] = result
+		return result
+	}
+
+	copy(equity, solve(remaining, 0))
+	return equity
+}
+
+// BubbleFactor returns how much more hero stands to lose than to win, in prize
+// equity, from an even all-in against the biggest other stack: the one most able
+// to bust hero. A call risking chips needs equity of at least
+// factor/(factor+1) rather than the usual 50%, so a factor of 2 means calls need
+// 67%. Chip EV is a factor of 1; near a money bubble a medium stack facing a
+// bigger one has a factor well above 1, while a chip leader who can't be busted
+// stays close to 1. Returns 1 if hero has no chips or no opponent does.
+func BubbleFactor(stacks []int, payouts []float64, heroSeat int) float64 {
+	if heroSeat < 0 || heroSeat >= len(stacks) || stacks[heroSeat] <= 0 {
+		return 1
+	}
+	villain := -1
+	for seat, stack := range stacks {
+		if seat != heroSeat && stack > 0 && (villain < 0 || stack > stacks[villain]) {
+			villain = seat
+		}
+	}
+	if villain < 0 {
+		return 1
+	}
+
+	effective := min(stacks[heroSeat], stacks[villain])
+	before := ICM(stacks, payouts)[heroSeat]
+
+	won := append([]int(nil), stacks...)
+	won[heroSeat] += effective
+	won[villain] -= effective
+	lost := append([]int(nil), stacks...)
+	lost[heroSeat] -= effective
+	lost[villain] += effective
+
+	gain := ICM(won, payouts)[heroSeat] - before
+	loss := before - ICM(lost, payouts)[heroSeat]
+	if gain <= 0 {
+		return 1
+	}
+	return loss / gain
+}
+
+// MinCashProbability returns the chance that seat finishes in one of the top
+// paidPlaces places, at least min-cashing, under the same finishing model as ICM.
+func MinCashProbability(stacks []int, paidPlaces, seat int) float64 {
+	if seat < 0 || seat >= len(stacks) || paidPlaces <= 0 {
+		return 0
+	}
+	cashes := make([]float64, paidPlaces)
+	for i := range cashes {
+		cashes[i] = 1
+	}
+	return ICM(stacks, cashes)[seat]
+}
@@ -0,0 +1,84 @@
+package analysis
+
+import (
+	"math"
+	"testing"
+)
+
+func TestICM(t *testing.T) {
+	t.Run("winner take all is chip share", func(t *testing.T) {
+		equity := ICM([]int{6000, 3000, 1000}, []float64{100})
+		for i, want := range []float64{60, 30, 10} {
+			if math.Abs(equity[i]-want) > 1e-9 {
+				t.Errorf("seat %d: expected %.1f, got %.4f", i, want, equity[i])
+			}
+		}
+	})
+
+	t.Run("equal stacks share equally", func(t *testing.T) {
+		equity := ICM([]int{2000, 2000, 2000, 2000}, []float64{50, 30, 20})
+		for i, got := range equity {
+			if math.Abs(got-25) > 1e-9 {
+				t.Errorf("seat %d: expected 25, got %.4f", i, got)
+			}
+		}
+	})
+
+	t.Run("prize pool is fully distributed", func(t *testing.T) {
+		equity := ICM([]int{7000, 2000, 800, 200, 0}, []float64{50, 30, 20})
+		total := 0.0
+		for _, e := range equity {
+			total += e
+		}
+		if math.Abs(total-100) > 1e-9 {
+			t.Errorf("expected 100 distributed, got %.4f", total)
+		}
+		if equity[4] != 0 {
+			t.Errorf("expected a busted player to get nothing, got %.4f", equity[4])
+		}
+		// Short stacks are worth more than their chip share
+		if equity[3] <= 100*200.0/10000 {
+			t.Errorf("expected the short stack above its chip share, got %.4f", equity[3])
+		}
+	})
+}
+
+func TestBubbleFactor(t *testing.T) {
+	// Four left, three paid: the money bubble
+	stacks := []int{20000, 2500, 2500, 1000}
+	payouts := []float64{50, 30, 20}
+
+	if bf := BubbleFactor(stacks, payouts, 1); bf <= 1.5 {
+		t.Errorf("expected a medium stack facing the chip leader to have a factor well above 1, got %.3f", bf)
+	}
+	if bf := BubbleFactor(stacks, payouts, 0); bf < 1 || bf > 1.1 {
+		t.Errorf("expected the chip leader's factor near 1, got %.3f", bf)
+	}
+
+	// Winner take all is plain chip EV
+	if bf := BubbleFactor(stacks, []float64{100}, 1); math.Abs(bf-1) > 1e-9 {
+		t.Errorf("expected a factor of 1 in a winner-take-all, got %.3f", bf)
+	}
+
+	if bf := BubbleFactor([]int{0, 5000}, payouts, 0); bf != 1 {
+		t.Errorf("expected 1 for a busted hero, got %.3f", bf)
+	}
+}
+
+func TestMinCashProbability(t *testing.T) {
+	stacks := []int{20000, 2500, 2500, 1000}
+
+	short := MinCashProbability(stacks, 3, 3)
+	medium := MinCashProbability(stacks, 3, 1)
+	if short <= 0 || short >= medium || medium >= 1 {
+		t.Errorf("expected 0 < short %.3f < medium %.3f < 1", short, medium)
+	}
+
+	// Everyone left is paid
+	if p := MinCashProbability(stacks, 4, 3); math.Abs(p-1) > 1e-9 {
+		t.Errorf("expected a certain cash when every place pays, got %.3f", p)
+	}
+	if p := MinCashProbability(stacks, 3, 9); p != 0 {
+		t.Errorf("expected 0 for an unknown seat, got %.3f", p)
+	}
+}
@@ -0,0 +1,109 @@
+package analysis
+
+import (
+	"math"
+	rand "math/rand/v2"
+
+	"github.com/lox/pokerforbots/v2/poker"
+)
+
+const (
+	// multiwayTightening is the share of starting hands each opponent beyond the
+	// first is assumed to fold before a multiway pot forms
+	multiwayTightening = 0.10
+	// multiwayMinRange is the narrowest share of starting hands an opponent in a
+	// multiway pot is assumed to hold
+	multiwayMinRange = 0.50
+)
+
+// MultiwayRange returns the share of starting hands each opponent is assumed to
+// hold when hero faces opponents of them. Heads-up that's every hand; each extra
+// opponent drops the weakest 10% of hands, down to the top half, since weak hands
+// rarely get to see a flop four ways.
+func MultiwayRange(opponents int) float64 {
+	if opponents <= 1 {
+		return 1
+	}
+	return math.Max(multiwayMinRange, 1-multiwayTightening*float64(opponents-1))
+}
+
+// MultiwayEquity estimates hero's equity against opponents players with Monte
+// Carlo simulation, dealing each opponent a combo from the range MultiwayRange
+// assumes for that many opponents rather than any two cards. Heads-up it matches
+// CalculateEquity. See RangeEquity.
+func MultiwayEquity(hole, board poker.Hand, opponents, iters int, rng *rand.Rand) EquityResult {
+	return RangeEquity(hole, board, opponents, MultiwayRange(opponents), iters, rng)
+}
+
+// RangeEquity estimates hero's equity against opponents players with Monte
+// Carlo simulation, dealing each opponent a combo from the strongest share of
+// starting hands by preflop equity. A share of 1 or more is any two cards and
+// matches CalculateEquity. Runouts where an opponent can't be dealt a combo
+// from the range are skipped, so TotalSimulations may fall short of iters.
+func RangeEquity(hole, board poker.Hand, opponents int, share float64, iters int, rng *rand.Rand) EquityResult {
+	opponents = max(1, opponents)
+	if share >= 1 {
+		return CalculateEquity(hole, board, opponents, iters, rng)
+	}
+	if iters <= 0 || hole.CountCards() != 2 || board.CountCards() > 5 || hole&board != 0 {
+		return EquityResult{}
+	}
+	if 2+5+opponents*2 > 52 {
+		return EquityResult{}
+	}
+
+	combos := combosByStrength()
+	combos = combos[:max(1, int(float64(len(combos))*share))]
+	deck := poker.NewDeck(rng)
+
+	var result EquityResult
+	for range iters {
+		used := hole | board
+		opponentHands := make([]poker.Hand, 0, opponents)
+		for range opponents {
+			hand, ok := sampleRangeHand(combos, used, rng)
+			if !ok {
+				break
+			}
+			used |= hand
+			opponentHands = append(opponentHands, hand)
+		}
+		if len(opponentHands) < opponents {
+			continue
+		}
+
+		deck.Shuffle()
+		finalBoard := board
+		dealt := true
+		for range 5 - board.CountCards() {
+			card, ok := dealUnused(deck, &used)
+			if !ok {
+				dealt = false
+				break
+			}
+			finalBoard.AddCard(card)
+		}
+		if !dealt {
+			continue
+		}
+
+		heroRank := poker.Evaluate7Cards(hole | finalBoard)
+		win, tie := true, false
+		for _, opp := range opponentHands {
+			switch poker.CompareHands(heroRank, poker.Evaluate7Cards(opp|finalBoard)) {
+			case -1:
+				win = false
+			case 0:
+				tie = true
+			}
+		}
+		result.TotalSimulations++
+		switch {
+		case win && !tie:
+			result.Wins++
+		case win:
+			result.Ties++
+		}
+	}
+	return result
+}
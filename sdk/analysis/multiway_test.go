@@ -0,0 +1,95 @@
+package analysis
+
+import (
+	"math"
+	"testing"
+
+	"github.com/lox/pokerforbots/v2/internal/randutil"
+	"github.com/lox/pokerforbots/v2/poker"
+)
+
+func TestMultiwayEquityTwoWayVsFourWay(t *testing.T) {
+	tests := []struct {
+		name  string
+		hole  []string
+		board []string
+	}{
+		{name: "aces preflop", hole: []string{"As", "Ah"}},
+		{name: "top pair on the flop", hole: []string{"Ks", "Qd"}, board: []string{"Kd", "7c", "2h"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hole, err := poker.ParseHand(tt.hole...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var board poker.Hand
+			if len(tt.board) > 0 {
+				if board, err = poker.ParseHand(tt.board...); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			twoWay := MultiwayEquity(hole, board, 1, 5000, randutil.New(1)).Equity()
+			fourWay := MultiwayEquity(hole, board, 3, 5000, randutil.New(1))
+			if fourWay.TotalSimulations == 0 {
+				t.Fatal("expected four-way simulations to complete")
+			}
+			if fourWay.Equity() >= twoWay-0.1 {
+				t.Errorf("expected four-way equity well below two-way %.3f, got %.3f", twoWay, fourWay.Equity())
+			}
+		})
+	}
+}
+
+func TestMultiwayEquityTightensOpponentRanges(t *testing.T) {
+	hole, _ := poker.ParseHand("Kd", "5c")
+
+	// Opponents in a six-way pot hold stronger hands than any two cards
+	tightened := MultiwayEquity(hole, 0, 5, 5000, randutil.New(3)).Equity()
+	random := CalculateEquity(hole, 0, 5, 5000, randutil.New(3)).Equity()
+	if tightened >= random {
+		t.Errorf("expected equity below %.3f against random hands, got %.3f", random, tightened)
+	}
+}
+
+func TestRangeEquityNarrowerRangesAreStronger(t *testing.T) {
+	hole, _ := poker.ParseHand("Kh", "7d")
+	board, _ := poker.ParseHand("Ks", "8c", "2d")
+
+	wide := RangeEquity(hole, board, 1, 1, 5000, randutil.New(4)).Equity()
+	narrow := RangeEquity(hole, board, 1, 0.35, 5000, randutil.New(4)).Equity()
+	if narrow >= wide-0.05 {
+		t.Errorf("expected equity well below %.3f against the top 35%%, got %.3f", wide, narrow)
+	}
+}
+
+func TestMultiwayEquityHeadsUpMatchesCalculateEquity(t *testing.T) {
+	hole, _ := poker.ParseHand("Jh", "Tc")
+	board, _ := poker.ParseHand("9d", "8s", "2c")
+
+	got := MultiwayEquity(hole, board, 1, 2000, randutil.New(7))
+	want := CalculateEquity(hole, board, 1, 2000, randutil.New(7))
+	if got != want {
+		t.Errorf("expected heads-up result %+v, got %+v", want, got)
+	}
+}
+
+func TestMultiwayRange(t *testing.T) {
+	tests := []struct {
+		opponents int
+		want      float64
+	}{
+		{0, 1},
+		{1, 1},
+		{2, 0.9},
+		{4, 0.7},
+		{8, 0.5},
+	}
+	for _, tt := range tests {
+		if got := MultiwayRange(tt.opponents); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("MultiwayRange(%d) = %v, want %v", tt.opponents, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,274 @@
+package analysis
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/lox/pokerforbots/v2/poker"
+)
+
+// HandHistory is the subset of a PHH hand history needed to replay betting.
+// Fields mirror the PHH format: per-position antes, blinds and starting stacks,
+// and actions in PHH notation (e.g. "d dh p1 AsKs", "p2 cbr 30", "p1 cc",
+// "p3 f", "d db AhKd2c").
+type HandHistory struct {
+	Antes             []int
+	BlindsOrStraddles []int
+	StartingStacks    []int
+	Actions           []string
+}
+
+// phhStreets names the betting rounds in the order they are dealt.
+var phhStreets = []string{"preflop", "flop", "turn", "river"}
+
+// phhStreetIndex returns the number of board deals before street.
+func phhStreetIndex(street string) (int, bool) {
+	for i, name := range phhStreets {
+		if name == street {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// phhAction is one parsed line of a PHH actions list.
+type phhAction struct {
+	player int      // 0-based player index, or -1 for the dealer
+	op     string   // PHH operation, e.g. "dh", "db", "f", "cc" or "cbr"
+	args   []string // Remaining fields, e.g. the player and cards of a deal
+	raw    string
+}
+
+// parsePHHAction parses a PHH action line, returning false for comments and
+// lines that aren't an action by the dealer or a player.
+func parsePHHAction(raw string) (phhAction, bool) {
+	fields := strings.Fields(raw)
+	if len(fields) < 2 || strings.HasPrefix(fields[0], "#") {
+		return phhAction{}, false
+	}
+	action := phhAction{player: -1, op: fields[1], args: fields[2:], raw: raw}
+	if fields[0] != "d" {
+		action.player = phhPlayer(fields[0])
+		if action.player < 0 {
+			return phhAction{}, false
+		}
+	}
+	return action, true
+}
+
+// dealer reports whether the dealer took the action.
+func (a phhAction) dealer() bool {
+	return a.player < 0
+}
+
+// notation returns the action without its player, e.g. "cbr 30".
+func (a phhAction) notation() string {
+	return strings.Join(append([]string{a.op}, a.args...), " ")
+}
+
+// amount returns the chip amount of a "cbr" action.
+func (a phhAction) amount() (int, error) {
+	if len(a.args) == 0 {
+		return 0, fmt.Errorf("missing amount in %q", a.raw)
+	}
+	to, err := strconv.Atoi(a.args[0])
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount in %q: %w", a.raw, err)
+	}
+	return to, nil
+}
+
+// phhPlayer converts a PHH player token like "p3" to a 0-based index.
+func phhPlayer(token string) int {
+	if !strings.HasPrefix(token, "p") {
+		return -1
+	}
+	n, err := strconv.Atoi(token[1:])
+	if err != nil {
+		return -1
+	}
+	return n - 1
+}
+
+// parseCardRun parses concatenated cards like "AhKd2c".
+func parseCardRun(run string) (poker.Hand, error) {
+	if len(run)%2 != 0 {
+		return 0, fmt.Errorf("invalid card run %q", run)
+	}
+	var hand poker.Hand
+	for i := 0; i < len(run); i += 2 {
+		card, err := poker.ParseCard(run[i : i+2])
+		if err != nil {
+			return 0, err
+		}
+		hand.AddCard(card)
+	}
+	return hand, nil
+}
+
+// phhReplay tracks the chips and cards of a PHH hand history as its actions are
+// applied.
+type phhReplay struct {
+	stacks   []int        // Chips behind per player
+	bets     []int        // Chips put in on the current street, blinds included
+	invested [][]int      // Chips put in per street and player, antes included
+	folded   []bool       // Players who have folded
+	holes    []poker.Hand // Hole cards dealt or shown face up, 0 when unknown
+	board    poker.Hand
+}
+
+// newPHHReplay starts a replay of history with antes and blinds posted.
+func newPHHReplay(history HandHistory) *phhReplay {
+	players := len(history.StartingStacks)
+	r := &phhReplay{
+		stacks:   append([]int(nil), history.StartingStacks...),
+		bets:     make([]int, players),
+		invested: [][]int{make([]int, players)},
+		folded:   make([]bool, players),
+		holes:    make([]poker.Hand, players),
+	}
+	for player, ante := range history.Antes {
+		if player < players && ante > 0 {
+			r.put(player, ante)
+		}
+	}
+	for player, blind := range history.BlindsOrStraddles {
+		if player < players && blind > 0 {
+			r.bet(player, blind)
+		}
+	}
+	return r
+}
+
+// street returns the number of board deals so far.
+func (r *phhReplay) street() int {
+	return len(r.invested) - 1
+}
+
+// pot returns every chip put in so far.
+func (r *phhReplay) pot() int {
+	total := 0
+	for _, amounts := range r.invested {
+		for _, amount := range amounts {
+			total += amount
+		}
+	}
+	return total
+}
+
+// maxBet returns the highest bet on the current street.
+func (r *phhReplay) maxBet() int {
+	return slices.Max(r.bets)
+}
+
+// contributions returns the chips each player has put in over the hand.
+func (r *phhReplay) contributions() []int {
+	totals := make([]int, len(r.stacks))
+	for _, amounts := range r.invested {
+		for player, amount := range amounts {
+			totals[player] += amount
+		}
+	}
+	return totals
+}
+
+// put moves up to amount of player's stack into the pot, returning what moved.
+func (r *phhReplay) put(player, amount int) int {
+	amount = max(0, min(amount, r.stacks[player]))
+	r.stacks[player] -= amount
+	r.invested[r.street()][player] += amount
+	return amount
+}
+
+// bet puts chips in as a bet on the current street.
+func (r *phhReplay) bet(player, amount int) {
+	r.bets[player] += r.put(player, amount)
+}
+
+// returnUncalled gives back the part of the largest bet nobody matched.
+func (r *phhReplay) returnUncalled() {
+	top := 0
+	for player, amount := range r.bets {
+		if amount > r.bets[top] {
+			top = player
+		}
+	}
+	called := 0
+	for player, amount := range r.bets {
+		if player != top {
+			called = max(called, amount)
+		}
+	}
+	if excess := r.bets[top] - called; excess > 0 {
+		r.bets[top] -= excess
+		r.stacks[top] += excess
+		r.invested[r.street()][top] -= excess
+	}
+}
+
+// apply replays one action. Actions by players outside the history's stacks
+// and operations that don't move chips or cards are ignored.
+func (r *phhReplay) apply(action phhAction) error {
+	if action.dealer() {
+		switch action.op {
+		case "dh":
+			if len(action.args) >= 2 {
+				return r.reveal(phhPlayer(action.args[0]), action.args[1])
+			}
+		case "db":
+			if len(action.args) == 0 {
+				return nil
+			}
+			if r.street() == len(phhStreets)-1 {
+				return errors.New("board dealt after the river")
+			}
+			cards, err := parseCardRun(action.args[0])
+			if err != nil {
+				return fmt.Errorf("board: %w", err)
+			}
+			r.board |= cards
+			r.returnUncalled()
+			clear(r.bets)
+			r.invested = append(r.invested, make([]int, len(r.stacks)))
+		}
+		return nil
+	}
+
+	player := action.player
+	if player >= len(r.stacks) {
+		return nil
+	}
+	switch action.op {
+	case "f":
+		r.folded[player] = true
+	case "cc":
+		r.bet(player, r.maxBet()-r.bets[player])
+	case "cbr":
+		to, err := action.amount()
+		if err != nil {
+			return err
+		}
+		r.bet(player, to-r.bets[player])
+	case "sm":
+		if len(action.args) > 0 {
+			return r.reveal(player, action.args[0])
+		}
+	}
+	return nil
+}
+
+// reveal records a player's hole cards unless they are hidden ("????").
+func (r *phhReplay) reveal(player int, run string) error {
+	if player < 0 || player >= len(r.holes) || strings.Contains(run, "?") {
+		return nil
+	}
+	hole, err := parseCardRun(run)
+	if err != nil {
+		return fmt.Errorf("hole cards for seat %d: %w", player, err)
+	}
+	r.holes[player] = hole
+	return nil
+}
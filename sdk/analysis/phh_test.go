@@ -0,0 +1,36 @@
+package analysis
+
+import "testing"
+
+func TestParsePHHAction(t *testing.T) {
+	tests := []struct {
+		raw      string
+		ok       bool
+		player   int
+		notation string
+	}{
+		{raw: "p2 cbr 30", ok: true, player: 1, notation: "cbr 30"},
+		{raw: "p1 cc", ok: true, player: 0, notation: "cc"},
+		{raw: "d db AhKd2c", ok: true, player: -1, notation: "db AhKd2c"},
+		{raw: "# comment", ok: false},
+		{raw: "x f", ok: false},
+		{raw: "p1", ok: false},
+	}
+	for _, tt := range tests {
+		action, ok := parsePHHAction(tt.raw)
+		if ok != tt.ok {
+			t.Errorf("%q: ok = %v, want %v", tt.raw, ok, tt.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if action.player != tt.player || action.notation() != tt.notation {
+			t.Errorf("%q: got player %d %q, want %d %q", tt.raw, action.player, action.notation(), tt.player, tt.notation)
+		}
+	}
+
+	if _, err := (phhAction{op: "cbr", raw: "p1 cbr"}).amount(); err == nil {
+		t.Error("expected an error for a raise without an amount")
+	}
+}
@@ -0,0 +1,56 @@
+package analysis
+
+import (
+	"math"
+
+	"github.com/lox/pokerforbots/v2/poker"
+)
+
+// removalSlope approximates a hand's heads-up equity against one specific
+// holding as 0.5 plus removalSlope times the difference in their equities
+// against a random hand. It fits matchups like AA v 72o and AKo v QQ to within
+// a few percent, which is close enough to weigh which holdings are removed.
+const removalSlope = 0.75
+
+// GetPreflopEquityWithRemoval returns the preflop equity of hole against
+// opponents random hands when the dead cards (a board, cards an opponent
+// showed or folded) can't be in their hands. The table equity already allows
+// for hole's own blockers; dead cards shift it by how much stronger or weaker
+// the holdings they remove were than the ones left, using the removalSlope
+// model heads-up. Equity against more opponents scales by the same ratio.
+// Returns 0 if hole isn't two cards or opponents is out of the table's range.
+func GetPreflopEquityWithRemoval(hole, dead poker.Hand, opponents int) float64 {
+	if hole.CountCards() != 2 {
+		return 0
+	}
+	cards := hole.Cards()
+	base := GetPreflopEquity(GetHandCategory(cards[0], cards[1]), opponents)
+	dead &^= hole
+	if base == 0 || dead == 0 {
+		return base
+	}
+
+	// Average strength of the opponent's holdings with and without the dead
+	// cards removed
+	var all, live float64
+	var allCount, liveCount int
+	for _, c := range startingCombos() {
+		if c.hand&hole != 0 {
+			continue
+		}
+		all += c.equity
+		allCount++
+		if c.hand&dead == 0 {
+			live += c.equity
+			liveCount++
+		}
+	}
+	if liveCount == 0 {
+		return base
+	}
+
+	headsUp := GetPreflopEquity(GetHandCategory(cards[0], cards[1]), 1)
+	adjusted := headsUp + removalSlope*(all/float64(allCount)-live/float64(liveCount))
+	adjusted = math.Min(1, math.Max(0, adjusted))
+	return math.Min(1, base*adjusted/headsUp)
+}
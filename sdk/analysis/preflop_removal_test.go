@@ -0,0 +1,33 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/lox/pokerforbots/v2/poker"
+)
+
+func TestGetPreflopEquityWithRemoval(t *testing.T) {
+	t.Parallel()
+
+	hole, _ := poker.ParseHand("As", "Kd")
+	base := GetPreflopEquity("AKo", 1)
+	if got := GetPreflopEquityWithRemoval(hole, 0, 1); got != base {
+		t.Errorf("expected table equity %.4f without dead cards, got %.4f", base, got)
+	}
+
+	// An opponent showing an ace leaves fewer AA and AK combos to run into
+	ace, _ := poker.ParseHand("Ah")
+	withAce := GetPreflopEquityWithRemoval(hole, ace, 1)
+	if withAce <= base {
+		t.Errorf("expected AKo equity to rise above %.4f with an ace dead, got %.4f", base, withAce)
+	}
+	if multiway := GetPreflopEquityWithRemoval(hole, ace, 3); multiway <= GetPreflopEquity("AKo", 3) {
+		t.Errorf("expected the removal to carry over to three opponents, got %.4f", multiway)
+	}
+
+	// Dead low cards leave the opponent stronger holdings on average
+	rags, _ := poker.ParseHand("7c", "2h", "3s")
+	if got := GetPreflopEquityWithRemoval(hole, rags, 1); got >= base {
+		t.Errorf("expected AKo equity to fall below %.4f with low cards dead, got %.4f", base, got)
+	}
+}
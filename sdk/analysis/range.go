@@ -24,8 +24,20 @@ func NewRange() *Range {
 	}
 }
 
-// ParseRange creates a range from standard poker notation.
-// Examples: "AA,KK", "AKs,AKo", "TT+", "A5s-A2s", "KTs+", "22-66"
+// ParseRange creates a range from standard poker notation: a comma-separated
+// list of parts, each of which is one of
+//
+//	AA, AKs, AKo, AK      a pair, a suited or offsuit hand, or both
+//	AhKs                  a single explicit combo
+//	TT+                   pairs from TT up to AA
+//	ATs+, KJo+, A2+       the kicker from the given rank up to one below the top card
+//	T9s+, 76o+            connectors stepping both ranks up: T9s,JTs,...,AKs
+//	22-66, A5s-A2s        pairs, or hands sharing a top card, between two ends
+//	T9s-54s               hands sharing a gap between two ends
+//
+// Dash ranges may be written in either direction, and both ends must share a
+// suitedness modifier. Anything else, including dash ranges whose ends share
+// neither a top card nor a gap, is rejected.
 func ParseRange(notation string) (*Range, error) {
 	r := NewRange()
 
@@ -45,112 +57,125 @@ func ParseRange(notation string) (*Range, error) {
 	return r, nil
 }
 
-// addRangePart adds a single range notation part to the range.
-func (r *Range) addRangePart(part string) error {
-	// Check for range patterns like "TT+" or "A5s-A2s" or "22-66"
-	if strings.Contains(part, "+") {
-		return r.addPlusRange(part)
-	}
-	if strings.Contains(part, "-") {
-		return r.addDashRange(part)
-	}
-
-	// Single hand notation
-	return r.addSingleHand(part, 1.0)
+// handClass is a starting hand in rank notation, like "AKs", "AKo", "AK" or "TT".
+type handClass struct {
+	high, low int  // Ranks from 2 to 14, high >= low
+	modifier  byte // 's', 'o', or 0 for both
 }
 
-// addSingleHand adds all combinations of a single hand notation.
-func (r *Range) addSingleHand(notation string, weight float64) error {
+// parseHandClass parses rank notation like "AKs", ordering the ranks high first.
+func parseHandClass(notation string) (handClass, error) {
 	if len(notation) < 2 || len(notation) > 3 {
-		return fmt.Errorf("invalid notation length: %s", notation)
+		return handClass{}, fmt.Errorf("invalid notation length: %s", notation)
 	}
 
 	rank1 := parseRank(notation[0])
 	rank2 := parseRank(notation[1])
 	if rank1 == 0 || rank2 == 0 {
-		return fmt.Errorf("invalid rank in: %s", notation)
+		return handClass{}, fmt.Errorf("invalid rank in: %s", notation)
 	}
 
-	// Pocket pair
-	if rank1 == rank2 {
-		if len(notation) == 3 {
-			return fmt.Errorf("pocket pairs cannot have suited/offsuit modifier: %s", notation)
+	hc := handClass{high: max(rank1, rank2), low: min(rank1, rank2)}
+	if len(notation) == 3 {
+		hc.modifier = notation[2]
+		if hc.modifier != 's' && hc.modifier != 'o' {
+			return handClass{}, fmt.Errorf("invalid modifier: %c", hc.modifier)
+		}
+		if hc.pair() {
+			return handClass{}, fmt.Errorf("pocket pairs cannot have suited/offsuit modifier: %s", notation)
 		}
-		return r.addPocketPair(rank1, weight)
 	}
+	return hc, nil
+}
 
-	// Unpaired hand
-	if len(notation) == 2 {
-		// Add all combinations (suited + offsuit)
-		if err := r.addSuitedCombos(rank1, rank2, weight); err != nil {
+func (hc handClass) pair() bool {
+	return hc.high == hc.low
+}
+
+// add adds every combo of the hand class to the range.
+func (r *Range) add(hc handClass, weight float64) error {
+	if hc.pair() {
+		return r.addPocketPair(hc.high, weight)
+	}
+	if hc.modifier != 'o' {
+		if err := r.addSuitedCombos(hc.high, hc.low, weight); err != nil {
 			return err
 		}
-		return r.addOffsuitCombos(rank1, rank2, weight)
 	}
-
-	// Suited or offsuit specifically
-	switch notation[2] {
-	case 's':
-		return r.addSuitedCombos(rank1, rank2, weight)
-	case 'o':
-		return r.addOffsuitCombos(rank1, rank2, weight)
-	default:
-		return fmt.Errorf("invalid modifier: %c", notation[2])
+	if hc.modifier != 's' {
+		return r.addOffsuitCombos(hc.high, hc.low, weight)
 	}
+	return nil
 }
 
-// addPlusRange handles notations like "TT+" (all pairs TT and higher)
-func (r *Range) addPlusRange(notation string) error {
-	plusIdx := strings.Index(notation, "+")
-	if plusIdx == -1 {
-		return fmt.Errorf("no + found")
+// addRangePart adds a single range notation part to the range.
+func (r *Range) addRangePart(part string) error {
+	// Check for range patterns like "TT+" or "A5s-A2s" or "22-66"
+	if strings.Contains(part, "+") {
+		return r.addPlusRange(part)
 	}
-
-	base := notation[:plusIdx]
-	if len(base) < 2 || len(base) > 3 {
-		return fmt.Errorf("invalid base notation: %s", base)
+	if strings.Contains(part, "-") {
+		return r.addDashRange(part)
+	}
+	if len(part) == 4 {
+		return r.addCombo(part, 1.0)
 	}
 
-	rank1 := parseRank(base[0])
-	rank2 := parseRank(base[1])
-	if rank1 == 0 || rank2 == 0 {
-		return fmt.Errorf("invalid rank")
+	// Single hand notation
+	return r.addSingleHand(part, 1.0)
+}
+
+// addCombo adds one explicit combo like "AhKs".
+func (r *Range) addCombo(notation string, weight float64) error {
+	card1, err := poker.ParseCard(notation[:2])
+	if err != nil {
+		return fmt.Errorf("invalid combo %s: %w", notation, err)
+	}
+	card2, err := poker.ParseCard(notation[2:])
+	if err != nil {
+		return fmt.Errorf("invalid combo %s: %w", notation, err)
+	}
+	if card1 == card2 {
+		return fmt.Errorf("combo repeats a card: %s", notation)
 	}
 
-	// Handle pocket pairs like "TT+"
-	if rank1 == rank2 {
-		for rank := rank1; rank <= 14; rank++ {
-			if err := r.addPocketPair(rank, 1.0); err != nil {
-				return err
-			}
-		}
-		return nil
+	r.hands[poker.NewHand(card1, card2)] = weight
+	return nil
+}
+
+// addSingleHand adds all combinations of a single hand notation.
+func (r *Range) addSingleHand(notation string, weight float64) error {
+	hc, err := parseHandClass(notation)
+	if err != nil {
+		return err
 	}
+	return r.add(hc, weight)
+}
 
-	// Handle unpaired like "ATs+" or "KJo+"
-	suited := false
-	offsuit := false
-	switch {
-	case len(base) == 2:
-		suited = true
-		offsuit = true
-	case base[2] == 's':
-		suited = true
-	case base[2] == 'o':
-		offsuit = true
-	default:
-		return fmt.Errorf("invalid modifier")
+// addPlusRange handles notations like "TT+" (all pairs TT and higher), "ATs+"
+// (kickers up to AK) and "T9s+" (connectors up to AKs).
+func (r *Range) addPlusRange(notation string) error {
+	base, rest, _ := strings.Cut(notation, "+")
+	if rest != "" {
+		return fmt.Errorf("unexpected %q after +", rest)
+	}
+	hc, err := parseHandClass(base)
+	if err != nil {
+		return err
 	}
 
-	// For hands like "KTs+", increment the lower card up to one below the higher
-	for rank := rank2; rank < rank1; rank++ {
-		if suited {
-			if err := r.addSuitedCombos(rank1, rank, 1.0); err != nil {
+	switch {
+	case hc.pair(), hc.high == hc.low+1:
+		// Pairs and connectors step both ranks up, up to AA or AK
+		for ; hc.high <= 14; hc.high, hc.low = hc.high+1, hc.low+1 {
+			if err := r.add(hc, 1.0); err != nil {
 				return err
 			}
 		}
-		if offsuit {
-			if err := r.addOffsuitCombos(rank1, rank, 1.0); err != nil {
+	default:
+		// For hands like "KTs+", increment the lower card up to one below the higher
+		for ; hc.low < hc.high; hc.low++ {
+			if err := r.add(hc, 1.0); err != nil {
 				return err
 			}
 		}
@@ -159,70 +184,48 @@ func (r *Range) addPlusRange(notation string) error {
 	return nil
 }
 
-// addDashRange handles notations like "22-66" or "A5s-A2s"
+// addDashRange handles notations like "22-66", "A5s-A2s" or "T9s-54s"
 func (r *Range) addDashRange(notation string) error {
 	parts := strings.Split(notation, "-")
 	if len(parts) != 2 {
 		return fmt.Errorf("invalid dash range format")
 	}
 
-	start := strings.TrimSpace(parts[0])
-	end := strings.TrimSpace(parts[1])
-
-	if len(start) < 2 || len(end) < 2 {
-		return fmt.Errorf("invalid notation in range")
+	start, err := parseHandClass(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return err
 	}
-
-	// Parse start and end
-	startRank1 := parseRank(start[0])
-	startRank2 := parseRank(start[1])
-	endRank1 := parseRank(end[0])
-	endRank2 := parseRank(end[1])
-
-	if startRank1 == 0 || startRank2 == 0 || endRank1 == 0 || endRank2 == 0 {
-		return fmt.Errorf("invalid ranks in range")
+	end, err := parseHandClass(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return err
+	}
+	if start.modifier != end.modifier {
+		return fmt.Errorf("ends of %s have different suitedness", notation)
+	}
+	if start.high < end.high || (start.high == end.high && start.low < end.low) {
+		start, end = end, start
 	}
 
-	// Handle pocket pair ranges like "22-66"
-	if startRank1 == startRank2 && endRank1 == endRank2 {
-		lower := min(startRank1, endRank1)
-		upper := max(startRank1, endRank1)
-		for rank := lower; rank <= upper; rank++ {
-			if err := r.addPocketPair(rank, 1.0); err != nil {
+	switch {
+	case start.high == end.high && !start.pair():
+		// Same top card, stepping the kicker down, like "A5s-A2s"
+		for hc := end; hc.low <= start.low; hc.low++ {
+			if err := r.add(hc, 1.0); err != nil {
 				return err
 			}
 		}
-		return nil
-	}
-
-	// Handle suited/offsuit ranges like "A5s-A2s"
-	if startRank1 == endRank1 {
-		// Same high card, different kickers
-		suited := len(start) == 3 && start[2] == 's'
-		offsuit := len(start) == 3 && start[2] == 'o'
-		if len(start) == 2 {
-			suited = true
-			offsuit = true
-		}
-
-		lower := min(startRank2, endRank2)
-		upper := max(startRank2, endRank2)
-		for rank := lower; rank <= upper; rank++ {
-			if suited {
-				if err := r.addSuitedCombos(startRank1, rank, 1.0); err != nil {
-					return err
-				}
-			}
-			if offsuit {
-				if err := r.addOffsuitCombos(startRank1, rank, 1.0); err != nil {
-					return err
-				}
+	case start.high-start.low == end.high-end.low:
+		// Same gap, stepping both ranks down, like "22-66" or "T9s-54s"
+		for hc := end; hc.high <= start.high; hc.high, hc.low = hc.high+1, hc.low+1 {
+			if err := r.add(hc, 1.0); err != nil {
+				return err
 			}
 		}
-		return nil
+	default:
+		return fmt.Errorf("ambiguous range %s: ends share neither a top card nor a gap", notation)
 	}
 
-	return fmt.Errorf("unsupported range format: %s", notation)
+	return nil
 }
 
 // addPocketPair adds all 6 combinations of a pocket pair using optimized poker.Card types
@@ -0,0 +1,190 @@
+package analysis
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/lox/pokerforbots/v2/poker"
+)
+
+// LineAction is one action in an opponent's betting line, see EstimateRange.
+type LineAction struct {
+	Street string // preflop, flop, turn or river
+	Action string // fold, check, call, raise or allin; a bet is a raise
+	Raises int    // Bets and raises already made on the street, not counting the blinds
+}
+
+// rangeBand keeps the hands between two strength percentiles of the current
+// range, 0 being the strongest, scaling their weight by weight.
+type rangeBand struct {
+	top, bottom float64
+	weight      float64
+}
+
+// Preflop bands, applied to the range that reached the action. Calls are capped:
+// the strongest hands mostly raise, so only some of them are kept.
+var (
+	limpBands         = []rangeBand{{0, 0.1, 0.3}, {0.1, 0.7, 1}}
+	openBands         = []rangeBand{{0, 0.3, 1}}
+	coldCallBands     = []rangeBand{{0, 0.05, 0.3}, {0.05, 0.4, 1}}
+	threeBetBands     = []rangeBand{{0, 0.12, 1}}
+	callThreeBetBands = []rangeBand{{0, 0.25, 1}}
+	fourBetPlusBands  = []rangeBand{{0, 0.4, 1}}
+)
+
+// Postflop bands. Bets and raises keep the strong hands and some of the weakest
+// as bluffs; checks and calls are capped.
+var (
+	postflopCheckBands = []rangeBand{{0, 0.15, 0.5}, {0.15, 1, 1}}
+	postflopBetBands   = []rangeBand{{0, 0.45, 1}, {0.85, 1, 0.35}}
+	postflopRaiseBands = []rangeBand{{0, 0.2, 1}, {0.9, 1, 0.25}}
+	postflopCallBands  = []rangeBand{{0, 0.1, 0.5}, {0.1, 0.65, 1}}
+)
+
+// EstimateRange narrows any two cards to a weighted range for an opponent from
+// their betting line. Each action keeps a band of the hands that reached it,
+// ranked by preflop equity before the flop and by made hand strength on the
+// board dealt so far after it: raises keep the top of the range, calls a
+// capped middle, and so on, with later actions narrowing the result of earlier
+// ones. board is in deal order and may be shorter than the line needs only if
+// no postflop action is given beyond it. A fold leaves an empty range.
+func EstimateRange(line []LineAction, board []string) (*Range, error) {
+	boardCards, err := poker.ParseHand(board...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid board: %w", err)
+	}
+
+	r := NewRange()
+	for _, c := range startingCombos() {
+		if c.hand&boardCards == 0 {
+			r.hands[c.hand] = 1
+		}
+	}
+
+	for _, action := range line {
+		cards, ok := phhStreetIndex(action.Street)
+		if !ok {
+			return nil, fmt.Errorf("invalid street %q", action.Street)
+		}
+		if action.Action == "fold" {
+			return NewRange(), nil
+		}
+
+		if cards == 0 {
+			r.narrow(preflopBands(action), preflopStrength)
+			continue
+		}
+		// The flop is three cards, then one more each street
+		seen := cards + 2
+		if seen > len(board) {
+			return nil, fmt.Errorf("%s action needs %d board cards, got %d", action.Street, seen, len(board))
+		}
+		dealt, _ := poker.ParseHand(board[:seen]...)
+		r.narrow(postflopBands(action), func(hand poker.Hand) float64 {
+			return float64(poker.EvaluateCards(hand | dealt))
+		})
+	}
+	return r, nil
+}
+
+// preflopBands returns the bands for a preflop action, or nil to leave the
+// range alone.
+func preflopBands(action LineAction) []rangeBand {
+	switch action.Action {
+	case "call":
+		switch action.Raises {
+		case 0:
+			return limpBands
+		case 1:
+			return coldCallBands
+		default:
+			return callThreeBetBands
+		}
+	case "raise", "allin":
+		switch action.Raises {
+		case 0:
+			return openBands
+		case 1:
+			return threeBetBands
+		default:
+			return fourBetPlusBands
+		}
+	default:
+		return nil
+	}
+}
+
+// postflopBands returns the bands for a postflop action, or nil to leave the
+// range alone.
+func postflopBands(action LineAction) []rangeBand {
+	switch action.Action {
+	case "check":
+		return postflopCheckBands
+	case "call":
+		return postflopCallBands
+	case "raise", "allin":
+		if action.Raises == 0 {
+			return postflopBetBands
+		}
+		return postflopRaiseBands
+	default:
+		return nil
+	}
+}
+
+// preflopStrength ranks a starting hand by its heads-up equity.
+func preflopStrength(hand poker.Hand) float64 {
+	return comboEquity()[hand]
+}
+
+// narrow reweights the range to the given bands, dropping hands outside them.
+// Hands of equal strength share a percentile, that of the strongest of them.
+func (r *Range) narrow(bands []rangeBand, strength func(poker.Hand) float64) {
+	if bands == nil || len(r.hands) == 0 {
+		return
+	}
+
+	type ranked struct {
+		hand     poker.Hand
+		strength float64
+	}
+	hands := make([]ranked, 0, len(r.hands))
+	total := 0.0
+	for hand, weight := range r.hands {
+		hands = append(hands, ranked{hand, strength(hand)})
+		total += weight
+	}
+	slices.SortFunc(hands, func(a, b ranked) int {
+		return cmp.Or(cmp.Compare(b.strength, a.strength), cmp.Compare(a.hand, b.hand))
+	})
+
+	above := 0.0 // Weight of strictly stronger hands
+	for i := 0; i < len(hands); {
+		j := i
+		groupWeight := 0.0
+		for ; j < len(hands) && hands[j].strength == hands[i].strength; j++ {
+			groupWeight += r.hands[hands[j].hand]
+		}
+		percentile := above / total
+		for _, h := range hands[i:j] {
+			weight := r.hands[h.hand] * bandWeight(bands, percentile)
+			if weight > 0 {
+				r.hands[h.hand] = weight
+			} else {
+				delete(r.hands, h.hand)
+			}
+		}
+		above += groupWeight
+		i = j
+	}
+}
+
+func bandWeight(bands []rangeBand, percentile float64) float64 {
+	for _, b := range bands {
+		if percentile >= b.top && percentile < b.bottom {
+			return b.weight
+		}
+	}
+	return 0
+}
@@ -0,0 +1,67 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/lox/pokerforbots/v2/poker"
+)
+
+func TestEstimateRangeThreeBetTighterThanLimp(t *testing.T) {
+	t.Parallel()
+
+	limp, err := EstimateRange([]LineAction{{Street: "preflop", Action: "call", Raises: 0}}, nil)
+	if err != nil {
+		t.Fatalf("EstimateRange(limp) error: %v", err)
+	}
+	threeBet, err := EstimateRange([]LineAction{{Street: "preflop", Action: "raise", Raises: 1}}, nil)
+	if err != nil {
+		t.Fatalf("EstimateRange(3-bet) error: %v", err)
+	}
+
+	if threeBet.Size() >= limp.Size() {
+		t.Errorf("expected 3-bet range (%d combos) to be tighter than limp range (%d combos)", threeBet.Size(), limp.Size())
+	}
+	aces, _ := poker.ParseHand("As", "Ah")
+	if threeBet.Weight(aces) != 1 {
+		t.Errorf("expected AA at full weight in the 3-bet range, got %.2f", threeBet.Weight(aces))
+	}
+	if w := limp.Weight(aces); w <= 0 || w >= 1 {
+		t.Errorf("expected limp range to be capped with AA at reduced weight, got %.2f", w)
+	}
+	trash, _ := poker.ParseHand("7d", "2c")
+	if threeBet.ContainsHand(trash) {
+		t.Error("expected 72o outside the 3-bet range")
+	}
+}
+
+func TestEstimateRangePostflop(t *testing.T) {
+	t.Parallel()
+
+	board := []string{"Ah", "Kd", "7c"}
+	line := []LineAction{
+		{Street: "preflop", Action: "raise", Raises: 0},
+		{Street: "flop", Action: "raise", Raises: 1}, // Check-raise
+	}
+	r, err := EstimateRange(line, board)
+	if err != nil {
+		t.Fatalf("EstimateRange error: %v", err)
+	}
+	set, _ := poker.ParseHand("Ks", "Kh")
+	if r.Weight(set) != 1 {
+		t.Errorf("expected top set at full weight, got %.2f", r.Weight(set))
+	}
+	dealt, _ := poker.ParseHand(board...)
+	for _, hand := range r.Hands() {
+		if hand&dealt != 0 {
+			t.Fatalf("range contains board card: %s", hand)
+		}
+	}
+
+	if _, err := EstimateRange([]LineAction{{Street: "turn", Action: "check"}}, board); err == nil {
+		t.Error("expected an error for a turn action without a turn card")
+	}
+	folded, err := EstimateRange(append(line, LineAction{Street: "turn", Action: "fold"}), append(board, "2s"))
+	if err != nil || folded.Size() != 0 {
+		t.Errorf("expected an empty range after a fold, got %d combos (err %v)", folded.Size(), err)
+	}
+}
@@ -1,6 +1,7 @@
 package analysis
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/lox/pokerforbots/v2/poker"
@@ -240,3 +241,82 @@ func TestRangeHands(t *testing.T) {
 		}
 	}
 }
+
+func TestParseRangeNotationForms(t *testing.T) {
+	tests := []struct {
+		notation string
+		want     string // Equivalent range in plain notation
+	}{
+		{"AhKs", ""},
+		{"T9s+", "T9s,JTs,QJs,KQs,AKs"},
+		{"76o+", "76o,87o,98o,T9o,JTo,QJo,KQo,AKo"},
+		{"KQ+", "KQ,AK"},
+		{"KTs+", "KTs,KJs,KQs"},
+		{"A2s-A5s", "A5s,A4s,A3s,A2s"},
+		{"K9o-K6o", "K9o,K8o,K7o,K6o"},
+		{"66-22", "22,33,44,55,66"},
+		{"T9s-54s", "T9s,98s,87s,76s,65s,54s"},
+		{"54s-T9s", "T9s,98s,87s,76s,65s,54s"},
+		{"J9o-86o", "J9o,T8o,97o,86o"},
+		{"KA", "AK"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.notation, func(t *testing.T) {
+			r, err := ParseRange(tt.notation)
+			if err != nil {
+				t.Fatalf("ParseRange(%q) error: %v", tt.notation, err)
+			}
+			if tt.want == "" {
+				return
+			}
+			want, err := ParseRange(tt.want)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(r.Hands(), want.Hands()) {
+				t.Errorf("ParseRange(%q) has %d combos, want the %d of %q", tt.notation, r.Size(), want.Size(), tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRangeExplicitCombo(t *testing.T) {
+	r, err := ParseRange("AhKs,QdQc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Size() != 2 {
+		t.Fatalf("expected 2 combos, got %d", r.Size())
+	}
+	if !r.Contains("Ah", "Ks") || !r.Contains("Qc", "Qd") {
+		t.Error("expected both explicit combos in the range")
+	}
+	if r.Contains("As", "Kh") {
+		t.Error("expected only the listed suits")
+	}
+}
+
+func TestParseRangeRejectsMalformed(t *testing.T) {
+	tests := []string{
+		"AhAh",    // Same card twice
+		"AxKs",    // Invalid suit
+		"AKso",    // Two modifiers
+		"TT+5",    // Trailing junk after +
+		"AKs-",    // Missing end
+		"A5s-A2o", // Mismatched suitedness
+		"A5s-K2s", // Different top card and gap
+		"22-AKs",  // Pair to unpaired
+		"A5s-A2s-A3s",
+		"AAo+",
+		"1K",
+	}
+
+	for _, notation := range tests {
+		t.Run(notation, func(t *testing.T) {
+			if _, err := ParseRange(notation); err == nil {
+				t.Errorf("expected ParseRange(%q) to fail", notation)
+			}
+		})
+	}
+}
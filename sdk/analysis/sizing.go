@@ -0,0 +1,56 @@
+package analysis
+
+import "math"
+
+// betSizeFractions are the pot fractions RecommendBetSize considers, along with
+// going all-in.
+var betSizeFractions = []float64{0.25, 0.33, 0.5, 0.66, 0.75, 1, 1.5, 2}
+
+// RecommendBetSize picks the bet size with the highest chip EV for an unopened
+// pot, returning it as a fraction of pot and in chips. It returns 0, 0 when
+// checking is worth more than every bet.
+//
+// equity is the bettor's showdown equity against the opponent's whole range and
+// foldEquity the chance the opponent folds to a pot-sized bet. Other sizes scale
+// the fold chance with the pot odds they lay, 2x/(1+x) for a bet of x times the
+// pot, so small bets get fewer folds and overbets more. Folds are assumed to come
+// from hands the bettor beats, which leaves less equity when called. Checking is
+// valued as realizing equity in the current pot. Bets are capped at stack.
+func RecommendBetSize(equity, foldEquity float64, pot, stack int) (fraction float64, amount int) {
+	if pot <= 0 || stack <= 0 {
+		return 0, 0
+	}
+
+	bestEV := equity * float64(pot)
+	try := func(bet int) {
+		if bet <= 0 || bet > stack {
+			return
+		}
+		if ev := betEV(equity, foldEquity, pot, bet); ev > bestEV {
+			bestEV = ev
+			amount = bet
+		}
+	}
+	for _, f := range betSizeFractions {
+		try(int(math.Round(f * float64(pot))))
+	}
+	try(stack)
+
+	if amount == 0 {
+		return 0, 0
+	}
+	return float64(amount) / float64(pot), amount
+}
+
+// betEV returns the chip EV of betting, on the same footing as equity*pot for a
+// check, under the fold model described on RecommendBetSize.
+func betEV(equity, foldEquity float64, pot, bet int) float64 {
+	x := float64(bet) / float64(pot)
+	fold := math.Min(1, math.Max(0, foldEquity*2*x/(1+x)))
+	if fold >= 1 {
+		return float64(pot)
+	}
+	// Equity against the hands that call once the folded hands are removed
+	called := math.Max(0, (equity-fold)/(1-fold))
+	return fold*float64(pot) + (1-fold)*(called*float64(pot+2*bet)-float64(bet))
+}
@@ -0,0 +1,30 @@
+package analysis
+
+import "testing"
+
+func TestRecommendBetSize(t *testing.T) {
+	t.Parallel()
+
+	const pot, stack = 100, 1000
+
+	valueFraction, valueAmount := RecommendBetSize(0.85, 0.1, pot, stack)
+	thinFraction, thinAmount := RecommendBetSize(0.55, 0.1, pot, stack)
+	if thinAmount == 0 {
+		t.Fatal("expected a thin value hand to bet")
+	}
+	if valueFraction <= thinFraction || valueAmount <= thinAmount {
+		t.Errorf("expected a strong hand to bet more than a thin one, got %.2f (%d) vs %.2f (%d)",
+			valueFraction, valueAmount, thinFraction, thinAmount)
+	}
+	if thinFraction > 1 {
+		t.Errorf("expected a thin value bet of at most pot, got %.2f", thinFraction)
+	}
+
+	if fraction, amount := RecommendBetSize(0.4, 0, pot, stack); fraction != 0 || amount != 0 {
+		t.Errorf("expected a check without fold equity or a value edge, got %.2f (%d)", fraction, amount)
+	}
+
+	if _, amount := RecommendBetSize(0.95, 0, pot, 60); amount != 60 {
+		t.Errorf("expected the bet to be capped at the 60 chip stack, got %d", amount)
+	}
+}
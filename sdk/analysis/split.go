@@ -0,0 +1,63 @@
+package analysis
+
+import (
+	"github.com/lox/pokerforbots/v2/poker"
+	"github.com/lox/pokerforbots/v2/sdk/classification"
+)
+
+// SplitRange buckets r into the combos to bet for value, the combos to bluff
+// with and the combos to give up on, for constructing a betting range on board.
+//
+// A combo's strength is the weighted share of the rest of r it beats on board
+// (ties count half), so strength is relative to the range being split rather
+// than to a random hand. Combos with strength of at least valueThreshold are
+// value. Of the rest, combos with outs from a draw or overcards are bluffs, since
+// they can still improve, and everything else is a give-up; on the river there
+// is nothing to draw to, so only value and give-ups remain. Combos that collide
+// with the board are dropped, and weights are kept.
+func SplitRange(r *Range, board poker.Hand, valueThreshold float64) (value, bluff, giveup *Range) {
+	value, bluff, giveup = NewRange(), NewRange(), NewRange()
+
+	hands := make([]poker.Hand, 0, r.Size())
+	ranks := make([]poker.HandRank, 0, r.Size())
+	for _, hand := range r.Hands() {
+		if hand&board != 0 {
+			continue
+		}
+		hands = append(hands, hand)
+		ranks = append(ranks, poker.EvaluateCards(hand|board))
+	}
+
+	for i, hand := range hands {
+		var beaten, total float64
+		for j, other := range hands {
+			if i == j || hand&other != 0 {
+				continue
+			}
+			weight := r.Weight(other)
+			total += weight
+			switch poker.CompareHands(ranks[i], ranks[j]) {
+			case 1:
+				beaten += weight
+			case 0:
+				beaten += weight / 2
+			}
+		}
+
+		bucket := giveup
+		switch {
+		case total == 0 || beaten/total >= valueThreshold:
+			bucket = value
+		case board.CountCards() < 5 && hasDraw(hand, board):
+			bucket = bluff
+		}
+		bucket.hands[hand] = r.Weight(hand)
+	}
+	return value, bluff, giveup
+}
+
+// hasDraw reports whether hole has outs to improve on board. Backdoor draws
+// carry no outs on their own, so they don't count.
+func hasDraw(hole, board poker.Hand) bool {
+	return classification.DetectDraws(hole, board).Outs > 0
+}
@@ -0,0 +1,76 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/lox/pokerforbots/v2/poker"
+)
+
+func TestSplitRangeDryBoard(t *testing.T) {
+	r, err := ParseRange("KK,77,22,AK,43o,A5s")
+	if err != nil {
+		t.Fatal(err)
+	}
+	board, err := poker.ParseHand("Kd", "7c", "2h")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	value, bluff, giveup := SplitRange(r, board, 0.7)
+
+	sets, _ := ParseRange("KK,77,22")
+	for _, hand := range sets.Hands() {
+		if hand&board != 0 {
+			continue
+		}
+		if !value.ContainsHand(hand) {
+			t.Errorf("expected set %s in value", hand)
+		}
+	}
+
+	air, _ := ParseRange("43o")
+	for _, hand := range air.Hands() {
+		if value.ContainsHand(hand) {
+			t.Errorf("expected air %s out of value", hand)
+		}
+		if !giveup.ContainsHand(hand) {
+			t.Errorf("expected %s with no outs to be a give-up", hand)
+		}
+	}
+
+	// A5s has overcard outs to top pair, so it bluffs rather than giving up
+	wheel, _ := poker.ParseHand("As", "5s")
+	if !bluff.ContainsHand(wheel) {
+		t.Errorf("expected %s to be a bluff", wheel)
+	}
+
+	// Every combo that doesn't collide with the board lands in exactly one bucket
+	kept := 0
+	for _, hand := range r.Hands() {
+		if hand&board == 0 {
+			kept++
+		}
+	}
+	if got := value.Size() + bluff.Size() + giveup.Size(); got != kept {
+		t.Errorf("expected %d combos across buckets, got %d", kept, got)
+	}
+}
+
+func TestSplitRangeRiverHasNoBluffs(t *testing.T) {
+	r, err := ParseRange("KK,77,43o,A5s")
+	if err != nil {
+		t.Fatal(err)
+	}
+	board, err := poker.ParseHand("Kd", "7c", "2h", "9s", "Jd")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	value, bluff, giveup := SplitRange(r, board, 0.7)
+	if bluff.Size() != 0 {
+		t.Errorf("expected no bluffs on the river, got %d", bluff.Size())
+	}
+	if value.Size() == 0 || giveup.Size() == 0 {
+		t.Errorf("expected both value and give-ups, got %d and %d", value.Size(), giveup.Size())
+	}
+}
@@ -117,5 +117,6 @@ func Run(ctx context.Context, handler client.Handler, serverURL, name, game stri
 	cfg.logger.Info().Str("prefix", cfg.prefix).Msg("bot connected")
 
 	// Run
-	return c.Run(ctx)
+	_, err := c.Run(ctx)
+	return err
 }
@@ -0,0 +1,41 @@
+package bot
+
+import (
+	"fmt"
+
+	"github.com/lox/pokerforbots/v2/sdk/config"
+)
+
+// Named playing styles for the styled built-in bot
+const (
+	PresetNit            = "nit"
+	PresetTAG            = "tag"
+	PresetLAG            = "lag"
+	PresetManiac         = "maniac"
+	PresetCallingStation = "calling-station"
+)
+
+// presets maps each style to its tightness and aggression factors.
+var presets = map[string]config.BotConfig{
+	PresetNit:            {Tightness: 0.90, Aggression: 0.5},
+	PresetTAG:            {Tightness: 0.80, Aggression: 0.7},
+	PresetLAG:            {Tightness: 0.60, Aggression: 0.7},
+	PresetManiac:         {Tightness: 0.20, Aggression: 0.9},
+	PresetCallingStation: {Tightness: 0.30, Aggression: 0.0},
+}
+
+// PresetConfig returns the style factors for a named preset, e.g. to run a
+// population of diverse opponents without setting factors by hand. Only
+// Tightness and Aggression are set.
+func PresetConfig(name string) (config.BotConfig, error) {
+	cfg, ok := presets[name]
+	if !ok {
+		return config.BotConfig{}, fmt.Errorf("unknown preset %q", name)
+	}
+	return cfg, nil
+}
+
+// Presets lists the preset names.
+func Presets() []string {
+	return []string{PresetNit, PresetTAG, PresetLAG, PresetManiac, PresetCallingStation}
+}
@@ -0,0 +1,79 @@
+package bot
+
+import (
+	"math"
+	"testing"
+
+	"github.com/lox/pokerforbots/v2/internal/randutil"
+	"github.com/lox/pokerforbots/v2/poker"
+	"github.com/lox/pokerforbots/v2/protocol"
+	"github.com/lox/pokerforbots/v2/sdk/bots/styled"
+	"github.com/lox/pokerforbots/v2/sdk/client"
+)
+
+func TestPresetsHaveDistinctTendencies(t *testing.T) {
+	t.Parallel()
+
+	const hands = 5000
+	type tendencies struct{ vpip, pfr float64 }
+	measured := make(map[string]tendencies)
+
+	for _, name := range Presets() {
+		cfg, err := PresetConfig(name)
+		if err != nil {
+			t.Fatalf("PresetConfig(%q): %v", name, err)
+		}
+		handler := styled.NewHandler(cfg, randutil.New(2163))
+		deck := poker.NewDeck(randutil.New(2163))
+
+		// Facing the big blind preflop
+		req := protocol.ActionRequest{ToCall: 10, Pot: 15, MinBet: 20, ValidActions: []string{"fold", "call", "raise", "allin"}}
+		voluntary, raises := 0, 0
+		for range hands {
+			deck.Shuffle()
+			state := &client.GameState{Street: "preflop", HoleCards: poker.NewHand(deck.Deal(2)...).Cards()}
+			action, _, err := handler.OnActionRequest(state, req)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			switch action {
+			case "call":
+				voluntary++
+			case "raise":
+				voluntary++
+				raises++
+			}
+		}
+
+		got := tendencies{float64(voluntary) / hands, float64(raises) / hands}
+		if want := 1 - cfg.Tightness; math.Abs(got.vpip-want) > 0.05 {
+			t.Errorf("%s: VPIP %.2f, want about %.2f", name, got.vpip, want)
+		}
+		if want := got.vpip * cfg.Aggression; math.Abs(got.pfr-want) > 0.05 {
+			t.Errorf("%s: PFR %.2f, want about %.2f", name, got.pfr, want)
+		}
+		measured[name] = got
+	}
+
+	// Looser presets play more hands and the calling station never raises
+	order := []string{PresetNit, PresetTAG, PresetLAG, PresetManiac}
+	for i := 1; i < len(order); i++ {
+		if measured[order[i]].vpip <= measured[order[i-1]].vpip {
+			t.Errorf("expected %s to play more hands than %s, got %+v", order[i], order[i-1], measured)
+		}
+	}
+	if measured[PresetCallingStation].pfr != 0 {
+		t.Errorf("expected the calling station never to raise, got PFR %.2f", measured[PresetCallingStation].pfr)
+	}
+	for _, a := range Presets() {
+		for _, b := range Presets() {
+			if a < b && math.Abs(measured[a].vpip-measured[b].vpip) < 0.05 && math.Abs(measured[a].pfr-measured[b].pfr) < 0.05 {
+				t.Errorf("%s and %s play alike: %+v vs %+v", a, b, measured[a], measured[b])
+			}
+		}
+	}
+
+	if _, err := PresetConfig("psychic"); err == nil {
+		t.Error("expected an error for an unknown preset")
+	}
+}
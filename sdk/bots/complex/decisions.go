@@ -0,0 +1,104 @@
+package complex
+
+import (
+	"encoding/json"
+	"io"
+	"slices"
+
+	"github.com/lox/pokerforbots/v2/protocol"
+	"github.com/lox/pokerforbots/v2/sdk/analysis"
+)
+
+// DecisionRecord is one decision the bot made, with the estimated EV of each
+// action it could have taken, written by SetDecisionLog as labeled training
+// data.
+type DecisionRecord struct {
+	HandID    string     `json:"hand_id"`
+	Street    string     `json:"street"`
+	HoleCards []string   `json:"hole_cards"`
+	Board     []string   `json:"board,omitempty"`
+	Pot       int        `json:"pot"`
+	ToCall    int        `json:"to_call"`
+	Equity    float64    `json:"equity"`
+	Actions   []ActionEV `json:"actions"`
+	Chosen    string     `json:"chosen"`
+	Amount    int        `json:"amount,omitempty"`
+}
+
+// ActionEV is the estimated chip EV of one action relative to folding. EVs
+// assume the bot's equity is realized at showdown against a single caller and
+// ignore fold equity, so they are labels for comparison rather than exact.
+type ActionEV struct {
+	Action string  `json:"action"`
+	Amount int     `json:"amount,omitempty"` // Raise-to amount for raises
+	EV     float64 `json:"ev"`
+}
+
+// SetDecisionLog writes a DecisionRecord as a line of JSON to w for every
+// decision the bot makes. A nil writer turns the log off.
+func (b *complexBot) SetDecisionLog(w io.Writer) {
+	if w == nil {
+		b.decisionLog = nil
+		return
+	}
+	b.decisionLog = json.NewEncoder(w)
+}
+
+// recordDecision writes the decision to the decision log, if there is one.
+func (b *complexBot) recordDecision(req protocol.ActionRequest, equity float64, action string, amount int) {
+	if b.decisionLog == nil {
+		return
+	}
+	record := DecisionRecord{
+		HandID:    b.state.HandID,
+		Street:    b.state.Street,
+		HoleCards: b.state.HoleCards.Cards(),
+		Board:     b.state.Board.Cards(),
+		Pot:       req.Pot,
+		ToCall:    req.ToCall,
+		Equity:    equity,
+		Actions:   b.actionEVs(req, equity, action, amount),
+		Chosen:    action,
+		Amount:    amount,
+	}
+	if err := b.decisionLog.Encode(record); err != nil {
+		b.logger.Warn().Err(err).Msg("failed to write decision record")
+	}
+}
+
+// actionEVs estimates the EV of each valid action. Raises are valued at the
+// chosen size when the bot raised and at the minimum otherwise.
+func (b *complexBot) actionEVs(req protocol.ActionRequest, equity float64, action string, amount int) []ActionEV {
+	ownBet := 0
+	if b.state.Seat >= 0 && b.state.Seat < len(b.state.Players) {
+		ownBet = b.state.Players[b.state.Seat].Bet
+	}
+	// Chips put in to raise to raiseTo, called by one opponent
+	raiseEV := func(raiseTo int) float64 {
+		invest := min(raiseTo-ownBet, b.state.Chips)
+		if invest <= req.ToCall {
+			return analysis.CallAllInEV(equity, req.Pot, req.ToCall, invest)
+		}
+		called := invest - req.ToCall
+		return equity*float64(req.Pot+invest+called) - float64(invest)
+	}
+
+	var evs []ActionEV
+	if req.ToCall > 0 && slices.Contains(req.ValidActions, "fold") {
+		evs = append(evs, ActionEV{Action: "fold"})
+	}
+	if slices.Contains(req.ValidActions, "call") {
+		evs = append(evs, ActionEV{Action: "call", EV: analysis.CallAllInEV(equity, req.Pot, req.ToCall, b.state.Chips)})
+	}
+	if slices.Contains(req.ValidActions, "raise") {
+		raiseTo := req.MinBet
+		if action == "raise" && amount > 0 {
+			raiseTo = amount
+		}
+		evs = append(evs, ActionEV{Action: "raise", Amount: raiseTo, EV: raiseEV(raiseTo)})
+	}
+	if slices.Contains(req.ValidActions, "allin") {
+		evs = append(evs, ActionEV{Action: "allin", EV: raiseEV(ownBet + b.state.Chips)})
+	}
+	return evs
+}
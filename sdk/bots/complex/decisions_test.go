@@ -0,0 +1,93 @@
+package complex
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/lox/pokerforbots/v2/internal/randutil"
+	"github.com/lox/pokerforbots/v2/poker"
+	"github.com/lox/pokerforbots/v2/protocol"
+	"github.com/lox/pokerforbots/v2/sdk/analysis"
+	"github.com/rs/zerolog"
+)
+
+func TestDecisionLogRecordsActionEVs(t *testing.T) {
+	t.Parallel()
+
+	// Top set on a dry flop facing a half-pot bet: calling and raising both
+	// beat folding, and raising builds the bigger pot
+	hole, err := poker.ParseHand("Ah", "Ad")
+	if err != nil {
+		t.Fatalf("parse hole cards: %v", err)
+	}
+	board, err := poker.ParseHand("As", "7c", "2d")
+	if err != nil {
+		t.Fatalf("parse board: %v", err)
+	}
+	bot := &complexBot{
+		logger:      zerolog.Nop(),
+		rng:         randutil.New(2203),
+		bigBlind:    10,
+		strategy:    defaultStrategy,
+		equityCache: analysis.NewEquityCache(16, 200),
+		state: tableState{
+			HandID:      "hand-1",
+			Seat:        heroSeat,
+			Chips:       1000,
+			HoleCards:   hole,
+			Board:       board,
+			Street:      StreetFlop,
+			ActiveCount: 2,
+			Players:     []protocol.Player{{Seat: heroSeat, Chips: 1000}, {Seat: villainSeat, Chips: 950, Bet: 50}},
+		},
+	}
+	var log bytes.Buffer
+	bot.SetDecisionLog(&log)
+
+	req := protocol.ActionRequest{
+		HandID:       "hand-1",
+		ValidActions: []string{"fold", "call", "raise", "allin"},
+		ToCall:       50,
+		MinBet:       100,
+		Pot:          150,
+	}
+	action, amount, err := bot.OnActionRequest(nil, req)
+	if err != nil {
+		t.Fatalf("OnActionRequest: %v", err)
+	}
+
+	var record DecisionRecord
+	if err := json.Unmarshal(log.Bytes(), &record); err != nil {
+		t.Fatalf("decode decision record %q: %v", log.String(), err)
+	}
+	if record.HandID != "hand-1" || record.Street != StreetFlop || len(record.Board) != 3 {
+		t.Errorf("unexpected spot in record: %+v", record)
+	}
+	if record.Chosen != action || record.Amount != amount {
+		t.Errorf("record chose %s %d, bot chose %s %d", record.Chosen, record.Amount, action, amount)
+	}
+	if record.Equity < 0.8 {
+		t.Errorf("expected top set to have at least 80%% equity, got %.2f", record.Equity)
+	}
+
+	evs := make(map[string]ActionEV)
+	for _, ev := range record.Actions {
+		evs[ev.Action] = ev
+	}
+	if len(evs) != 4 {
+		t.Fatalf("expected an EV for each valid action, got %+v", record.Actions)
+	}
+	if evs["fold"].EV != 0 {
+		t.Errorf("expected folding to be the zero point, got %.2f", evs["fold"].EV)
+	}
+	if evs["call"].EV <= 0 {
+		t.Errorf("expected calling to beat folding, got %.2f", evs["call"].EV)
+	}
+	if evs["raise"].Amount < req.MinBet || evs["raise"].EV <= evs["call"].EV {
+		t.Errorf("expected raising to beat calling, got raise %+v vs call %+v", evs["raise"], evs["call"])
+	}
+	if evs["allin"].EV <= evs["raise"].EV {
+		t.Errorf("expected the all-in to be worth most with top set, got %+v", evs["allin"])
+	}
+}
@@ -0,0 +1,102 @@
+package complex
+
+import "github.com/lox/pokerforbots/v2/protocol"
+
+// Opponents are anonymous and reseated every hand, so fold tendencies are tracked
+// for the table as a whole. Heads-up that is the one opponent.
+const (
+	exploitMinSamples = 10   // Spots seen before a fold rate is trusted
+	overFoldTo3Bet    = 0.65 // Fold rate to 3-bets above which bluff 3-bets widen
+	overFoldToCBet    = 0.60 // Fold rate to c-bets above which the bot barrels more
+
+	bluff3BetFreq        = 0.25 // Bluff 3-bet frequency by default
+	exploitBluff3BetFreq = 0.60 // Bluff 3-bet frequency against over-folders
+	exploitBarrelFreq    = 0.75 // Barrel frequency against over-folders
+)
+
+// foldStat counts how often opponents folded when facing one of the bot's bets.
+type foldStat struct {
+	faced  int
+	folded int
+}
+
+// overFolds reports whether opponents fold more often than threshold, once there
+// are enough samples to trust the rate.
+func (s foldStat) overFolds(threshold float64) bool {
+	return s.faced >= exploitMinSamples && float64(s.folded)/float64(s.faced) > threshold
+}
+
+// opponentProfile tracks how the table responds to the bot's aggression.
+type opponentProfile struct {
+	foldTo3Bet foldStat
+	foldToCBet foldStat
+}
+
+// aggressionState follows the bot's bets through a hand so opponents' responses
+// can be attributed to the right spot.
+type aggressionState struct {
+	preflopRaises int       // Raises seen preflop, including the bot's
+	streetBets    int       // Bets and raises seen on the current street
+	aggressor     bool      // The bot made the last bet or raise on the previous street
+	lastRaiser    bool      // The bot made the last bet or raise on this street
+	pending       *foldStat // Spot opponents are currently responding to, nil if none
+}
+
+// trackAggression updates the profile from an action seen during the hand.
+func (b *complexBot) trackAggression(action protocol.PlayerAction) {
+	agg := &b.state.aggression
+	aggressive := action.Action == "raise" || action.Action == "allin"
+
+	if action.Seat == b.state.Seat {
+		if !aggressive {
+			return
+		}
+		switch {
+		case b.state.Street == "preflop" && agg.preflopRaises == 1:
+			agg.pending = &b.profile.foldTo3Bet
+		case b.state.Street != "preflop" && agg.streetBets == 0 && agg.aggressor:
+			agg.pending = &b.profile.foldToCBet
+		default:
+			agg.pending = nil
+		}
+		agg.lastRaiser = true
+	} else {
+		if agg.pending != nil {
+			switch action.Action {
+			case "fold":
+				agg.pending.faced++
+				agg.pending.folded++
+			case "call", "raise", "allin":
+				agg.pending.faced++
+			}
+		}
+		if aggressive {
+			agg.pending = nil
+			agg.lastRaiser = false
+		}
+	}
+
+	if aggressive {
+		agg.streetBets++
+		if b.state.Street == "preflop" {
+			agg.preflopRaises++
+		}
+	}
+}
+
+// nextStreetAggression carries the bot's initiative into a new street.
+func (b *complexBot) nextStreetAggression() {
+	agg := &b.state.aggression
+	agg.aggressor = agg.lastRaiser
+	agg.lastRaiser = false
+	agg.streetBets = 0
+	agg.pending = nil
+}
+
+// shouldBarrel reports whether to bet a hand the strategy would check, because
+// the bot has the initiative heads-up against opponents who over-fold to c-bets.
+func (b *complexBot) shouldBarrel() bool {
+	agg := b.state.aggression
+	return agg.aggressor && agg.streetBets == 0 && b.state.ActiveCount == 2 &&
+		b.profile.foldToCBet.overFolds(overFoldToCBet) && b.rng.Float64() < exploitBarrelFreq
+}
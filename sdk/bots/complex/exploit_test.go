@@ -0,0 +1,147 @@
+package complex
+
+import (
+	"testing"
+
+	"github.com/lox/pokerforbots/v2/internal/randutil"
+	"github.com/lox/pokerforbots/v2/poker"
+	"github.com/lox/pokerforbots/v2/protocol"
+	"github.com/rs/zerolog"
+)
+
+const (
+	heroSeat     = 0
+	villainSeat  = 1
+	exploitHands = 400
+)
+
+func newExploitBot(t *testing.T, holeCards ...string) *complexBot {
+	t.Helper()
+	hole, err := poker.ParseHand(holeCards...)
+	if err != nil {
+		t.Fatalf("parse hole cards: %v", err)
+	}
+	return &complexBot{
+		logger:   zerolog.Nop(),
+		rng:      randutil.New(2162),
+		bigBlind: 10,
+		strategy: defaultStrategy,
+		state: tableState{
+			Seat:        heroSeat,
+			Chips:       1000,
+			HoleCards:   hole,
+			Street:      "preflop",
+			ActiveCount: 2,
+		},
+	}
+}
+
+// newHand resets the per-hand tracking the way OnHandStart does.
+func (b *complexBot) newHand() {
+	b.state.Street = "preflop"
+	b.state.aggression = aggressionState{}
+}
+
+func TestFoldTo3BetWidensBluffs(t *testing.T) {
+	t.Parallel()
+
+	// 98s is a button 3-bet bluff: compare how often it 3-bets an open 3-handed
+	threeBets := func(bot *complexBot) int {
+		bot.state.ActiveCount = 3
+		req := protocol.ActionRequest{ToCall: 20, Pot: 35, MinBet: 60, MinRaise: 60, ValidActions: []string{"fold", "call", "raise", "allin"}}
+		count := 0
+		for range exploitHands {
+			if action, _ := bot.preflopDecision(req, PositionButton); action == "raise" {
+				count++
+			}
+		}
+		return count
+	}
+	baseline := threeBets(newExploitBot(t, "9s", "8s"))
+
+	// An opponent who folds every time the bot 3-bets
+	bot := newExploitBot(t, "9s", "8s")
+	for range exploitMinSamples {
+		bot.newHand()
+		for _, action := range []protocol.PlayerAction{
+			{Seat: villainSeat, Action: "raise"},
+			{Seat: heroSeat, Action: "raise"},
+			{Seat: villainSeat, Action: "fold"},
+		} {
+			bot.trackAggression(action)
+		}
+	}
+	if got := bot.profile.foldTo3Bet; got.faced != exploitMinSamples || got.folded != exploitMinSamples {
+		t.Fatalf("expected %d folds to 3-bets, got %+v", exploitMinSamples, got)
+	}
+	if bot.profile.foldToCBet.faced != 0 {
+		t.Fatalf("3-bet folds should not count as c-bet folds, got %+v", bot.profile.foldToCBet)
+	}
+
+	exploit := threeBets(bot)
+	if exploit <= baseline*3/2 {
+		t.Fatalf("expected far more 3-bets against an over-folder, got %d vs baseline %d", exploit, baseline)
+	}
+
+	// Defending hands outside the bluff range become bluffs too
+	bot.state.HoleCards, _ = poker.ParseHand("5d", "5c")
+	if threeBets(bot) == 0 {
+		t.Fatal("expected defending hands to 3-bet against an over-folder")
+	}
+}
+
+func TestFoldToCBetBarrelsMore(t *testing.T) {
+	t.Parallel()
+
+	board, err := poker.ParseHand("Kd", "7c", "2h")
+	if err != nil {
+		t.Fatalf("parse board: %v", err)
+	}
+	req := protocol.ActionRequest{ToCall: 0, Pot: 60, MinBet: 10, ValidActions: []string{"fold", "call", "raise", "allin"}}
+
+	// Plays a hand where the bot opens, is called and c-bets the flop
+	cbetHand := func(bot *complexBot, response string) {
+		bot.newHand()
+		bot.trackAggression(protocol.PlayerAction{Seat: heroSeat, Action: "raise"})
+		bot.trackAggression(protocol.PlayerAction{Seat: villainSeat, Action: "call"})
+		bot.state.Street = StreetFlop
+		bot.nextStreetAggression()
+		bot.trackAggression(protocol.PlayerAction{Seat: heroSeat, Action: "raise"})
+		bot.trackAggression(protocol.PlayerAction{Seat: villainSeat, Action: response})
+	}
+	// Bets with air on the flop after raising preflop
+	bets := func(bot *complexBot) int {
+		count := 0
+		for range exploitHands {
+			bot.newHand()
+			bot.trackAggression(protocol.PlayerAction{Seat: heroSeat, Action: "raise"})
+			bot.trackAggression(protocol.PlayerAction{Seat: villainSeat, Action: "call"})
+			bot.state.Street = StreetFlop
+			bot.state.Board = board
+			bot.nextStreetAggression()
+			if action, _ := bot.makeStrategicDecision(req, "Air", 0.2, PositionButton, 0); action == "raise" {
+				count++
+			}
+		}
+		return count
+	}
+
+	calling := newExploitBot(t, "4s", "3s")
+	for range exploitMinSamples {
+		cbetHand(calling, "call")
+	}
+	if got := calling.profile.foldToCBet; got.faced != exploitMinSamples || got.folded != 0 {
+		t.Fatalf("expected %d calls of c-bets, got %+v", exploitMinSamples, got)
+	}
+	if baseline := bets(calling); baseline != 0 {
+		t.Fatalf("expected air to check against opponents who call c-bets, bet %d times", baseline)
+	}
+
+	folding := newExploitBot(t, "4s", "3s")
+	for range exploitMinSamples {
+		cbetHand(folding, "fold")
+	}
+	if got := bets(folding); got < exploitHands/2 {
+		t.Fatalf("expected frequent barrels against an over-folder, bet %d of %d", got, exploitHands)
+	}
+}
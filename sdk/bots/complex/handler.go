@@ -1,6 +1,7 @@
 package complex
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"math"
@@ -18,6 +19,17 @@ import (
 	"github.com/rs/zerolog"
 )
 
+const equityCacheSize = 4096 // Distinct spots kept in the equity cache
+
+// opponentRangeShare is the share of starting hands, strongest first, each
+// opponent model assumes an opponent holds postflop. Multiway pots narrow it
+// further by analysis.MultiwayRange.
+var opponentRangeShare = map[string]float64{
+	config.OpponentModelRandom: 1.0,
+	config.OpponentModelLoose:  0.7,
+	config.OpponentModelTight:  0.35,
+}
+
 // tableState holds the latest state the bot knows about.
 type tableState struct {
 	HandID       string
@@ -26,10 +38,8 @@ type tableState struct {
 	Chips        int
 	Players      []protocol.Player
 	LastAction   protocol.PlayerAction
-	HoleCards    poker.Hand // Changed from []string
-	HoleCardsStr []string   // Keep for stats/logging
-	Board        poker.Hand // Changed from []string
-	BoardStr     []string   // Keep for stats/logging
+	HoleCards    poker.Hand
+	Board        poker.Hand
 	Street       string
 	Button       int
 	ActiveCount  int
@@ -42,6 +52,7 @@ type tableState struct {
 	// Opponent tracking
 	NumLimpers int  // Count of limpers before any raise
 	raiseSeen  bool // Whether a preflop raise has occurred this hand
+	aggression aggressionState
 
 	// Cached equity snapshot to avoid repeated calculations within a street
 	equity equitySnapshot
@@ -65,11 +76,34 @@ type complexBot struct {
 	handNum  int
 	bigBlind int // Track the big blind amount
 	strategy *StrategyConfig
+
+	// How opponents are assumed to play, from config.OpponentModel
+	opponentModel string
+
+	// How the table has responded to the bot's 3-bets and c-bets
+	profile opponentProfile
+
+	// Postflop equity results reused for the bot's lifetime
+	equityCache *analysis.EquityCache
+
+	// Monte Carlo iterations for multiway equity, which isn't cached
+	equityIterations int
+
+	// Scale on the strategy's mixed action frequencies, from config.StrategyMix
+	strategyMix float64
+
+	// Receives a DecisionRecord per decision when set with SetDecisionLog
+	decisionLog *json.Encoder
 }
 
 func newComplexBot(logger zerolog.Logger) *complexBot {
 	// Parse configuration from environment
 	cfg, err := config.FromEnv()
+	if err != nil && os.Getenv(config.EnvServer) != "" {
+		// Launched with a configuration that doesn't parse, so say why the
+		// settings it asked for are being ignored
+		logger.Warn().Err(err).Msg("Invalid bot configuration, using defaults")
+	}
 
 	// Read seed from environment, fallback to timestamp if not provided
 	seed := time.Now().UnixNano()
@@ -90,7 +124,23 @@ func newComplexBot(logger zerolog.Logger) *complexBot {
 		id = fmt.Sprintf("complex-improved-%04d", rng.IntN(10000))
 	}
 
-	logger.Debug().Int64("seed", seed).Str("bot_id", id).Msg("Bot initialized with seed")
+	// Equity iterations and opponent model are tunable through the environment
+	equityIterations := config.DefaultEquityIterations
+	opponentModel := config.OpponentModelRandom
+	strategyMix := config.DefaultStrategyMix
+	if err == nil {
+		equityIterations = cfg.EquityIterations
+		opponentModel = cfg.OpponentModel
+		strategyMix = cfg.StrategyMix
+	}
+
+	logger.Debug().
+		Int64("seed", seed).
+		Str("bot_id", id).
+		Int("equity_iterations", equityIterations).
+		Str("opponent_model", opponentModel).
+		Float64("strategy_mix", strategyMix).
+		Msg("Bot initialized with seed")
 
 	return &complexBot{
 		id:       id,
@@ -99,6 +149,11 @@ func newComplexBot(logger zerolog.Logger) *complexBot {
 		handNum:  0,
 		bigBlind: 10, // Default big blind
 		strategy: defaultStrategy,
+
+		opponentModel:    opponentModel,
+		equityCache:      analysis.NewEquityCache(equityCacheSize, equityIterations),
+		equityIterations: equityIterations,
+		strategyMix:      strategyMix,
 	}
 }
 
@@ -115,9 +170,8 @@ func (b *complexBot) OnHandStart(state *client.GameState, start protocol.HandSta
 	b.state.Chips = start.Players[start.YourSeat].Chips
 	b.state.StartingChips = start.Players[start.YourSeat].Chips
 
-	// Parse hole cards once and store both formats
-	b.state.HoleCardsStr = start.HoleCards
-	if holeHand, err := poker.ParseHand(start.HoleCards...); err == nil {
+	// Parse hole cards once; Cards() recovers the string form when needed
+	if holeHand, err := poker.HandFromStrings(start.HoleCards); err == nil {
 		b.state.HoleCards = holeHand
 	} else {
 		b.state.HoleCards = 0 // Empty hand on parse error
@@ -125,7 +179,6 @@ func (b *complexBot) OnHandStart(state *client.GameState, start protocol.HandSta
 	}
 
 	b.state.Board = 0 // Empty board at start
-	b.state.BoardStr = nil
 	b.state.Street = "preflop"
 	b.state.Button = start.Button
 	b.state.BetsThisHand = 0
@@ -142,10 +195,11 @@ func (b *complexBot) OnHandStart(state *client.GameState, start protocol.HandSta
 
 	b.state.NumLimpers = 0
 	b.state.raiseSeen = false
+	b.state.aggression = aggressionState{}
 	b.state.equity = equitySnapshot{}
 
 	b.logger.Debug().
-		Strs("holes", b.state.HoleCardsStr).
+		Strs("holes", start.HoleCards).
 		Int("position", b.getPosition()).
 		Int("active_players", b.state.ActiveCount).
 		Msg("hand start")
@@ -159,6 +213,7 @@ func (b *complexBot) OnActionRequest(state *client.GameState, req protocol.Actio
 	potOdds := b.calculatePotOdds(req)
 
 	action, amount := b.makeStrategicDecision(req, class, equity, position, potOdds)
+	b.recordDecision(req, equity, action, amount)
 
 	b.logger.Debug().
 		Float64("equity", equity).
@@ -197,6 +252,8 @@ func (b *complexBot) OnPlayerAction(state *client.GameState, action protocol.Pla
 		b.state.BetsThisHand++
 	}
 
+	b.trackAggression(action)
+
 	// Maintain simple preflop counters
 	if b.state.Street == "preflop" {
 		b.updatePreflopCounters(action)
@@ -207,10 +264,9 @@ func (b *complexBot) OnPlayerAction(state *client.GameState, action protocol.Pla
 
 func (b *complexBot) OnStreetChange(state *client.GameState, street protocol.StreetChange) error {
 	b.state.Street = street.Street
+	b.nextStreetAggression()
 
-	// Parse board once and store both formats
-	b.state.BoardStr = street.Board
-	if boardHand, err := poker.ParseHand(street.Board...); err == nil {
+	if boardHand, err := poker.HandFromStrings(street.Board); err == nil {
 		b.state.Board = boardHand
 	} else {
 		b.state.Board = 0 // Empty board on parse error
@@ -237,7 +293,7 @@ func (b *complexBot) OnHandResult(state *client.GameState, result protocol.HandR
 	b.logger.Debug().
 		Float64("net_bb", netBB).
 		Bool("won", won).
-		Bool("showdown", len(result.Showdown) > 0).
+		Str("end_reason", result.EndReason).
 		Msg("hand completed")
 
 	return nil
@@ -290,11 +346,8 @@ func (b *complexBot) preflopEquity() float64 {
 	if b.state.HoleCards.CountCards() != 2 {
 		return 0.5
 	}
-	if len(b.state.HoleCardsStr) != 2 {
-		return 0.5
-	}
-
-	category := analysis.GetHandCategory(b.state.HoleCardsStr[0], b.state.HoleCardsStr[1])
+	holes := b.state.HoleCards.Cards()
+	category := analysis.GetHandCategory(holes[0], holes[1])
 	if category == "" {
 		return 0.5
 	}
@@ -332,8 +385,20 @@ func (b *complexBot) classifyPostflopSDK() (string, float64) {
 	// Detect draws
 	drawInfo := classification.DetectDraws(holeCards, board)
 
-	// Calculate equity using Monte Carlo simulation (small sample for speed)
-	equityResult := analysis.CalculateEquity(holeCards, board, b.state.ActiveCount-1, 1000, b.rng)
+	// Calculate equity using Monte Carlo simulation against every opponent still in
+	// the hand, holding the range the opponent model assumes, tightened for
+	// multiway pots. Only any two cards heads-up is cached
+	opponents := max(1, b.state.ActiveCount-1)
+	share := analysis.MultiwayRange(opponents)
+	if modelShare, ok := opponentRangeShare[b.opponentModel]; ok {
+		share *= modelShare
+	}
+	var equityResult analysis.EquityResult
+	if share >= 1 {
+		equityResult = b.equityCache.Equity(holeCards, board, 1, b.rng)
+	} else {
+		equityResult = analysis.RangeEquity(holeCards, board, opponents, share, b.equityIterations, b.rng)
+	}
 	equity := equityResult.Equity()
 
 	// Enhanced classification based on draws and board texture
@@ -379,12 +444,6 @@ func (b *complexBot) classifyPostflopSDK() (string, float64) {
 		class = "TopPair"
 	}
 
-	// Adjust for multiway pots (more conservative reduction)
-	if b.state.ActiveCount > 2 {
-		// Only reduce by 2% per additional player instead of 3%
-		equity *= (1.0 - float64(b.state.ActiveCount-2)*0.02)
-	}
-
 	b.logger.Debug().
 		Str("board_texture", boardTexture.String()).
 		Int("draw_outs", drawInfo.Outs).
@@ -513,6 +572,22 @@ func (b *complexBot) makeStrategicDecision(req protocol.ActionRequest, handClass
 	// Look up action from postflop matrix
 	action, sizePct := b.strategy.PostflopDecision(handClass, canCheck, spr, multiway)
 
+	// Sometimes take a close-EV alternative so the line doesn't give the hand away
+	if mixed := b.strategy.MixedDecision(handClass, action, b.strategyMix, b.rng); mixed != action {
+		action = mixed
+		if action == "bet" || action == "raise" {
+			boardTexture := classification.AnalyzeBoardTexture(b.state.Board)
+			sizePct = b.strategy.BetSize(b.state.Street, boardTexture.String(), getHandStrengthCategory(equity))
+		}
+	}
+
+	// Keep betting with the initiative against opponents who give up too often
+	if canCheck && action != "bet" && b.shouldBarrel() {
+		boardTexture := classification.AnalyzeBoardTexture(b.state.Board)
+		sizePct = b.strategy.BetSize(b.state.Street, boardTexture.String(), HandStrengthDraw)
+		return b.raiseOrJam(req, b.betSize(req, sizePct))
+	}
+
 	// Handle the action from the table
 	switch action {
 	case "bet":
@@ -620,10 +695,10 @@ func (b *complexBot) shouldFold(req protocol.ActionRequest, equity float64) bool
 
 // Helper to check if our hand is in a range
 func (b *complexBot) handInRange(r *analysis.Range) bool {
-	if r == nil || len(b.state.HoleCardsStr) != 2 {
+	if r == nil || b.state.HoleCards.CountCards() != 2 {
 		return false
 	}
-	return r.Contains(b.state.HoleCardsStr[0], b.state.HoleCardsStr[1])
+	return r.ContainsHand(b.state.HoleCards)
 }
 
 // Preflop decision logic using table-driven ranges
@@ -640,7 +715,7 @@ func maxInt(a, b int) int {
 
 func (b *complexBot) preflopDecision(req protocol.ActionRequest, position int) (string, int) {
 	// Validate hole cards
-	if len(b.state.HoleCardsStr) != 2 {
+	if b.state.HoleCards.CountCards() != 2 {
 		// Protocol v2: check uses call with to_call=0
 		if hasAction(req.ValidActions, "call") && req.ToCall == 0 {
 			return "call", 0
@@ -713,8 +788,15 @@ func (b *complexBot) preflopDecision(req protocol.ActionRequest, position int) (
 			return b.raiseOrJam(req, min(amt, b.state.Chips))
 		}
 
-		// 3-bet bluff occasionally (25% frequency)
-		if hasAction(req.ValidActions, "raise") && b.handInRange(bluff3BetRange) && b.rng.Float64() < 0.25 {
+		// 3-bet bluff occasionally, widening to defending hands and bluffing more
+		// often against opponents who over-fold to 3-bets
+		bluffFreq := bluff3BetFreq
+		bluffing := b.handInRange(bluff3BetRange)
+		if b.profile.foldTo3Bet.overFolds(overFoldTo3Bet) {
+			bluffFreq = exploitBluff3BetFreq
+			bluffing = bluffing || b.handInRange(defendRange)
+		}
+		if hasAction(req.ValidActions, "raise") && bluffing && b.rng.Float64() < bluffFreq {
 			amt := threeBetIP
 			if !inPosition {
 				amt = threeBetOOP
@@ -794,5 +876,11 @@ func NewQuietHandler() *Handler {
 	return newComplexBot(logger)
 }
 
+// SetEquityCache replaces the bot's equity cache, e.g. to share a cache pre-warmed
+// with EquityCache.Warmup between bots running in the same process.
+func (b *complexBot) SetEquityCache(cache *analysis.EquityCache) {
+	b.equityCache = cache
+}
+
 // Check it implements the client.Handler interface
 var _ client.Handler = (*Handler)(nil)
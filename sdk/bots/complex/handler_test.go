@@ -0,0 +1,54 @@
+package complex
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/lox/pokerforbots/v2/poker"
+	"github.com/lox/pokerforbots/v2/sdk/config"
+	"github.com/rs/zerolog"
+)
+
+func TestOpponentModelFromEnvNarrowsRanges(t *testing.T) {
+	t.Setenv(config.EnvServer, "ws://localhost:8080/ws")
+	t.Setenv(config.EnvSeed, "2146")
+	t.Setenv(config.EnvEquityIterations, "3000")
+
+	// Top pair with a weak kicker is well ahead of any two cards but much less
+	// so against hands that are strong before the flop
+	hole, _ := poker.ParseHand("Kh", "7d")
+	board, _ := poker.ParseHand("Ks", "8c", "2d")
+
+	equities := make(map[string]float64)
+	for _, model := range []string{config.OpponentModelRandom, config.OpponentModelLoose, config.OpponentModelTight} {
+		t.Setenv(config.EnvOpponentModel, model)
+		bot := newComplexBot(zerolog.Nop())
+		if bot.opponentModel != model {
+			t.Fatalf("expected opponent model %q, got %q", model, bot.opponentModel)
+		}
+		bot.state.HoleCards = hole
+		bot.state.Board = board
+		bot.state.ActiveCount = 2
+		_, equities[model] = bot.classifyPostflopSDK()
+	}
+
+	random, loose, tight := equities[config.OpponentModelRandom], equities[config.OpponentModelLoose], equities[config.OpponentModelTight]
+	if !(random > loose && loose > tight) {
+		t.Errorf("expected equity to fall as the model tightens, got random %.3f, loose %.3f, tight %.3f", random, loose, tight)
+	}
+}
+
+func TestInvalidConfigIsLogged(t *testing.T) {
+	t.Setenv(config.EnvServer, "ws://localhost:8080/ws")
+	t.Setenv(config.EnvOpponentModel, "psychic")
+
+	var buf bytes.Buffer
+	bot := newComplexBot(zerolog.New(&buf))
+	if bot.opponentModel != config.OpponentModelRandom {
+		t.Errorf("expected the default opponent model, got %q", bot.opponentModel)
+	}
+	if !strings.Contains(buf.String(), "psychic") {
+		t.Errorf("expected a warning naming the invalid value, got %q", buf.String())
+	}
+}
@@ -1,6 +1,10 @@
 package complex
 
-import "github.com/lox/pokerforbots/v2/sdk/analysis"
+import (
+	rand "math/rand/v2"
+
+	"github.com/lox/pokerforbots/v2/sdk/analysis"
+)
 
 // FoldThreshold defines minimum equity needed to continue at different bet sizes.
 type FoldThreshold struct {
@@ -39,6 +43,15 @@ type PostflopAction struct {
 	SizePct   float64
 }
 
+// MixedAction swaps a postflop matrix action for a close-EV alternative at a
+// tuned frequency, so an opponent can't read the bot's hand class off its line.
+type MixedAction struct {
+	HandClass string
+	Action    string  // Action chosen by the postflop matrix
+	Alternate string  // Action taken instead
+	Freq      float64 // Fraction of spots that take the alternate at full mix
+}
+
 // Street constants for bet sizing.
 const (
 	StreetFlop  = "flop"
@@ -83,6 +96,7 @@ type StrategyConfig struct {
 	PostflopMatrix []PostflopAction
 	BetSizingTable []BetSizing
 	FlatTrapRange  *analysis.Range
+	MixedActions   []MixedAction
 }
 
 var defaultStrategy = buildDefaultStrategy()
@@ -147,6 +161,16 @@ func buildDefaultStrategy() *StrategyConfig {
 			{StreetRiver, BoardTextureAny, HandStrengthMedium, 0.50},
 			{StreetRiver, BoardTextureAny, HandStrengthDraw, 0.75},
 		},
+		MixedActions: []MixedAction{
+			{"TripsPlus", "bet", "check", 0.20},   // Slowplay to set up check-raises
+			{"TripsPlus", "call", "raise", 0.35},  // Raise multiway instead of flatting
+			{"TwoPair", "check", "bet", 0.30},     // Bet multiway instead of checking
+			{"TPTK", "check", "bet", 0.25},        // Thin value instead of pot control
+			{"ComboDraw", "check", "bet", 0.40},   // Semi-bluff
+			{"ComboDraw", "call", "raise", 0.30},  // Semi-bluff raise
+			{"StrongDraw", "check", "bet", 0.20},  // Semi-bluff
+			{"StrongDraw", "call", "raise", 0.10}, // Semi-bluff raise
+		},
 	}
 
 	addRange := func(pos int, action, spec string) {
@@ -243,3 +267,21 @@ func (s *StrategyConfig) BetSize(street, boardTexture, handStrength string) floa
 	}
 	return 0.50
 }
+
+// MixedDecision returns the action to take in place of the matrix action for
+// handClass. The alternate is drawn with probability Freq scaled by mix, where 0
+// always keeps the matrix action and 1 plays the tuned frequencies.
+func (s *StrategyConfig) MixedDecision(handClass, action string, mix float64, rng *rand.Rand) string {
+	if mix <= 0 {
+		return action
+	}
+	for _, mixed := range s.MixedActions {
+		if mixed.HandClass == handClass && mixed.Action == action {
+			if rng.Float64() < mixed.Freq*mix {
+				return mixed.Alternate
+			}
+			return action
+		}
+	}
+	return action
+}
@@ -1,6 +1,13 @@
 package complex
 
-import "testing"
+import (
+	"math"
+	"testing"
+
+	"github.com/lox/pokerforbots/v2/internal/randutil"
+	"github.com/lox/pokerforbots/v2/poker"
+	"github.com/lox/pokerforbots/v2/protocol"
+)
 
 func TestFoldThresholdValue(t *testing.T) {
 	if got := defaultStrategy.FoldThresholdValue(StreetFlop, 0.25); got != 0.15 {
@@ -68,3 +75,58 @@ func TestBetSizing(t *testing.T) {
 		t.Fatalf("expected river medium strength bet size 0.50, got %0.2f", size)
 	}
 }
+
+func TestMixedDecisionFrequencies(t *testing.T) {
+	t.Parallel()
+
+	const spots = 20000
+
+	board, err := poker.ParseHand("Kd", "9c", "4s")
+	if err != nil {
+		t.Fatalf("parse board: %v", err)
+	}
+
+	// Multiway two pair checks per the matrix, and bets 30% of the time at full mix
+	betFreq := func(mix float64) float64 {
+		bot := newExploitBot(t, "Kh", "9h")
+		bot.strategyMix = mix
+		bot.state.Street = StreetFlop
+		bot.state.Board = board
+		bot.state.ActiveCount = 3
+		req := protocol.ActionRequest{Pot: 100, MinBet: 10, ValidActions: []string{"call", "raise", "allin"}}
+
+		bets := 0
+		for range spots {
+			switch action, _ := bot.makeStrategicDecision(req, "TwoPair", 0.70, PositionMiddle, 0); action {
+			case "raise":
+				bets++
+			case "call":
+			default:
+				t.Fatalf("unexpected action %s", action)
+			}
+		}
+		return float64(bets) / spots
+	}
+
+	for _, tt := range []struct {
+		mix  float64
+		want float64
+	}{
+		{0, 0},
+		{0.5, 0.15},
+		{1, 0.30},
+	} {
+		if got := betFreq(tt.mix); math.Abs(got-tt.want) > 0.015 {
+			t.Errorf("mix %.1f: expected bet frequency %.2f, got %.3f", tt.mix, tt.want, got)
+		}
+	}
+}
+
+func TestMixedDecisionKeepsUnmixedActions(t *testing.T) {
+	rng := randutil.New(1)
+	for range 1000 {
+		if action := defaultStrategy.MixedDecision("Air", "check", 1, rng); action != "check" {
+			t.Fatalf("expected air to keep checking, got %s", action)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package styled
+
+import (
+	"cmp"
+	rand "math/rand/v2"
+	"slices"
+
+	"github.com/lox/pokerforbots/v2/protocol"
+	"github.com/lox/pokerforbots/v2/sdk/analysis"
+	"github.com/lox/pokerforbots/v2/sdk/client"
+	"github.com/lox/pokerforbots/v2/sdk/config"
+)
+
+// handPercentile maps each starting hand category to the fraction of dealt hands
+// that are stronger, by heads-up equity.
+var handPercentile = buildHandPercentiles()
+
+func buildHandPercentiles() map[string]float64 {
+	categories := make([]string, 0, len(analysis.PreflopEquityData))
+	for category := range analysis.PreflopEquityData {
+		categories = append(categories, category)
+	}
+	slices.SortFunc(categories, func(a, b string) int {
+		return cmp.Or(
+			cmp.Compare(analysis.GetPreflopEquity(b, 1), analysis.GetPreflopEquity(a, 1)),
+			cmp.Compare(a, b),
+		)
+	})
+
+	percentiles := make(map[string]float64, len(categories))
+	stronger := 0
+	for _, category := range categories {
+		percentiles[category] = float64(stronger) / 1326
+		switch {
+		case len(category) == 2:
+			stronger += 6 // Pairs
+		case category[2] == 's':
+			stronger += 4
+		default:
+			stronger += 12
+		}
+	}
+	return percentiles
+}
+
+// Handler plays a simple style set by tightness and aggression factors: it plays
+// the strongest (1 - tightness) of starting hands and bets or raises with
+// probability aggression when it continues.
+type Handler struct {
+	tightness  float64
+	aggression float64
+	rng        *rand.Rand
+}
+
+// NewHandler creates a handler with the style factors from cfg.
+func NewHandler(cfg config.BotConfig, rng *rand.Rand) *Handler {
+	return &Handler{
+		tightness:  cfg.Tightness,
+		aggression: cfg.Aggression,
+		rng:        rng,
+	}
+}
+
+func (*Handler) OnHandStart(*client.GameState, protocol.HandStart) error         { return nil }
+func (*Handler) OnGameUpdate(*client.GameState, protocol.GameUpdate) error       { return nil }
+func (*Handler) OnPlayerAction(*client.GameState, protocol.PlayerAction) error   { return nil }
+func (*Handler) OnStreetChange(*client.GameState, protocol.StreetChange) error   { return nil }
+func (*Handler) OnHandResult(*client.GameState, protocol.HandResult) error       { return nil }
+func (*Handler) OnGameCompleted(*client.GameState, protocol.GameCompleted) error { return nil }
+
+func (h *Handler) OnActionRequest(state *client.GameState, req protocol.ActionRequest) (string, int, error) {
+	continuing := true
+	if state.Street == "preflop" || state.Street == "" {
+		continuing = h.plays(state.HoleCards)
+	} else if req.ToCall > 0 {
+		// Tighter players give up more often when bet into after the flop
+		continuing = h.rng.Float64() >= h.tightness/2
+	}
+
+	if !continuing {
+		// Protocol v2: "call" checks when there is nothing to call
+		if req.ToCall == 0 && slices.Contains(req.ValidActions, "call") {
+			return "call", 0, nil
+		}
+		return "fold", 0, nil
+	}
+	if slices.Contains(req.ValidActions, "raise") && h.rng.Float64() < h.aggression {
+		return "raise", req.MinBet, nil
+	}
+	if slices.Contains(req.ValidActions, "call") {
+		return "call", 0, nil
+	}
+	return "fold", 0, nil
+}
+
+// plays reports whether the hole cards are among the hands this style plays.
+func (h *Handler) plays(holeCards []string) bool {
+	if len(holeCards) != 2 {
+		return false
+	}
+	percentile, ok := handPercentile[analysis.GetHandCategory(holeCards[0], holeCards[1])]
+	return ok && percentile < 1-h.tightness
+}
+
+// Check it implements the client.Handler interface
+var _ client.Handler = (*Handler)(nil)
@@ -0,0 +1,113 @@
+package client
+
+import (
+	"github.com/lox/pokerforbots/v2/protocol"
+)
+
+// aggressionHistorySize bounds how many completed hands RecentAggression can look back over.
+const aggressionHistorySize = 500
+
+// AggressionSummary counts an opponent's voluntary actions over recent hands.
+type AggressionSummary struct {
+	Hands  int // Completed hands the opponent was dealt into
+	Raises int // Bets, raises and all-ins
+	Calls  int
+	Checks int
+	Folds  int // Including timeout folds
+}
+
+// Factor returns the aggression factor, bets and raises per call. An opponent
+// who never called returns their raise count.
+func (a AggressionSummary) Factor() float64 {
+	if a.Calls == 0 {
+		return float64(a.Raises)
+	}
+	return float64(a.Raises) / float64(a.Calls)
+}
+
+// Frequency returns the share of the opponent's voluntary actions that were bets
+// or raises, 0 if they haven't acted.
+func (a AggressionSummary) Frequency() float64 {
+	total := a.Raises + a.Calls + a.Checks + a.Folds
+	if total == 0 {
+		return 0
+	}
+	return float64(a.Raises) / float64(total)
+}
+
+func (a AggressionSummary) add(b AggressionSummary) AggressionSummary {
+	return AggressionSummary{
+		Hands:  a.Hands + b.Hands,
+		Raises: a.Raises + b.Raises,
+		Calls:  a.Calls + b.Calls,
+		Checks: a.Checks + b.Checks,
+		Folds:  a.Folds + b.Folds,
+	}
+}
+
+// RecentAggression summarizes each opponent's actions over the last nHands
+// completed hands (0 or fewer for every hand remembered), keyed by the player
+// name the server reports. Opponents are anonymous and named by seat, so on
+// tables that reseat players between hands a summary describes whoever sat in
+// that seat. The current hand is counted once its result arrives.
+func (s *GameState) RecentAggression(nHands int) map[string]AggressionSummary {
+	history := s.aggressionHistory
+	if nHands > 0 && nHands < len(history) {
+		history = history[len(history)-nHands:]
+	}
+
+	summaries := make(map[string]AggressionSummary)
+	for _, hand := range history {
+		for name, counts := range hand {
+			summaries[name] = summaries[name].add(counts)
+		}
+	}
+	return summaries
+}
+
+// startHandAggression begins counting the opponents dealt into a new hand.
+func (s *GameState) startHandAggression(start protocol.HandStart) {
+	s.handAggression = make(map[string]AggressionSummary, len(start.Players))
+	for _, p := range start.Players {
+		if p.Seat != start.YourSeat {
+			s.handAggression[p.Name] = AggressionSummary{Hands: 1}
+		}
+	}
+}
+
+// recordAggression counts an opponent's action in the current hand. Blind posts
+// are forced, so they aren't counted.
+func (s *GameState) recordAggression(action protocol.PlayerAction) {
+	if s.handAggression == nil || action.Seat == s.Seat {
+		return
+	}
+	counts, ok := s.handAggression[action.PlayerName]
+	if !ok {
+		return
+	}
+	switch action.Action {
+	case "bet", "raise", "allin":
+		counts.Raises++
+	case "call":
+		counts.Calls++
+	case "check":
+		counts.Checks++
+	case "fold", "timeout_fold":
+		counts.Folds++
+	default:
+		return
+	}
+	s.handAggression[action.PlayerName] = counts
+}
+
+// finishHandAggression moves the current hand's counts into the history.
+func (s *GameState) finishHandAggression() {
+	if s.handAggression == nil {
+		return
+	}
+	s.aggressionHistory = append(s.aggressionHistory, s.handAggression)
+	if len(s.aggressionHistory) > aggressionHistorySize {
+		s.aggressionHistory = s.aggressionHistory[len(s.aggressionHistory)-aggressionHistorySize:]
+	}
+	s.handAggression = nil
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lox/pokerforbots/v2/protocol"
+	"github.com/rs/zerolog"
+)
+
+// aggressionRecorder captures the aggression summaries seen after each hand.
+type aggressionRecorder struct {
+	stubHandler
+	all    []map[string]AggressionSummary
+	latest []map[string]AggressionSummary
+}
+
+func (r *aggressionRecorder) OnHandResult(state *GameState, _ protocol.HandResult) error {
+	r.all = append(r.all, state.RecentAggression(0))
+	r.latest = append(r.latest, state.RecentAggression(1))
+	return nil
+}
+
+func TestRecentAggressionUpdatesAsHandsComplete(t *testing.T) {
+	t.Parallel()
+
+	players := []protocol.Player{{Seat: 0, Name: "me"}, {Seat: 1, Name: "bot-2"}, {Seat: 2, Name: "bot-3"}}
+	start := func(id string) *protocol.HandStart {
+		return &protocol.HandStart{Type: protocol.TypeHandStart, HandID: id, YourSeat: 0, Players: players}
+	}
+	act := func(seat int, action string) *protocol.PlayerAction {
+		return &protocol.PlayerAction{Type: protocol.TypePlayerAction, Seat: seat, PlayerName: players[seat].Name, Action: action}
+	}
+	result := &protocol.HandResult{Type: protocol.TypeHandResult}
+
+	url := startStubServer(t,
+		start("hand-1"),
+		act(1, "post_small_blind"), act(2, "post_big_blind"),
+		act(0, "call"), act(1, "raise"), act(2, "call"), act(0, "fold"),
+		act(1, "raise"), act(2, "fold"),
+		result,
+		start("hand-2"),
+		act(1, "post_small_blind"), act(2, "post_big_blind"),
+		act(0, "raise"), act(1, "fold"), act(2, "allin"), act(0, "fold"),
+		result,
+		&protocol.GameCompleted{Type: protocol.TypeGameCompleted},
+	)
+
+	recorder := &aggressionRecorder{}
+	bot := New("me", recorder, zerolog.Nop())
+	if err := bot.Connect(url); err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	if _, err := bot.Run(context.Background()); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+
+	if len(recorder.all) != 2 {
+		t.Fatalf("expected summaries after 2 hands, got %d", len(recorder.all))
+	}
+
+	want := []map[string]AggressionSummary{
+		{
+			"bot-2": {Hands: 1, Raises: 2},
+			"bot-3": {Hands: 1, Calls: 1, Folds: 1},
+		},
+		{
+			"bot-2": {Hands: 2, Raises: 2, Folds: 1},
+			"bot-3": {Hands: 2, Raises: 1, Calls: 1, Folds: 1},
+		},
+	}
+	for hand, summaries := range recorder.all {
+		if len(summaries) != len(want[hand]) {
+			t.Errorf("hand %d: expected opponents %v, got %v", hand+1, want[hand], summaries)
+		}
+		for name, w := range want[hand] {
+			if got := summaries[name]; got != w {
+				t.Errorf("hand %d: %s summary = %+v, want %+v", hand+1, name, got, w)
+			}
+		}
+	}
+
+	if got, want := recorder.latest[1]["bot-2"], (AggressionSummary{Hands: 1, Folds: 1}); got != want {
+		t.Errorf("last hand bot-2 summary = %+v, want %+v", got, want)
+	}
+	if got := recorder.all[1]["bot-3"].Factor(); got != 1 {
+		t.Errorf("bot-3 aggression factor = %v, want 1", got)
+	}
+	if got := recorder.all[1]["bot-2"].Frequency(); got != 2.0/3 {
+		t.Errorf("bot-2 aggression frequency = %v, want 2/3", got)
+	}
+}
@@ -0,0 +1,48 @@
+package client
+
+import (
+	"context"
+	"time"
+)
+
+// Default reconnect delays shared by Session and Observer
+const (
+	defaultReconnectDelay    = 500 * time.Millisecond
+	defaultMaxReconnectDelay = 30 * time.Second
+)
+
+// reconnectBackoff is the delay between reconnect attempts. Each wait doubles
+// the next delay up to max; reset returns it to the initial delay once a
+// connection succeeds.
+type reconnectBackoff struct {
+	initial time.Duration
+	max     time.Duration
+	next    time.Duration
+}
+
+func newReconnectBackoff(delay, maxDelay time.Duration) reconnectBackoff {
+	return reconnectBackoff{initial: delay, max: max(delay, maxDelay), next: delay}
+}
+
+// delay returns how long the next wait will sleep
+func (b *reconnectBackoff) delay() time.Duration {
+	return b.next
+}
+
+// reset returns the delay to its initial value
+func (b *reconnectBackoff) reset() {
+	b.next = b.initial
+}
+
+// wait sleeps for the current delay and doubles it for the next wait,
+// returning false if ctx is done first.
+func (b *reconnectBackoff) wait(ctx context.Context) bool {
+	d := b.next
+	b.next = min(b.next*2, b.max)
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
@@ -0,0 +1,38 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestReconnectBackoffDoublesUpToMaxAndResets(t *testing.T) {
+	t.Parallel()
+
+	b := newReconnectBackoff(time.Millisecond, 3*time.Millisecond)
+	ctx := context.Background()
+	var delays []time.Duration
+	for range 4 {
+		delays = append(delays, b.delay())
+		if !b.wait(ctx) {
+			t.Fatal("wait returned false with a live context")
+		}
+	}
+	want := []time.Duration{time.Millisecond, 2 * time.Millisecond, 3 * time.Millisecond, 3 * time.Millisecond}
+	for i := range want {
+		if delays[i] != want[i] {
+			t.Fatalf("delays = %v, want %v", delays, want)
+		}
+	}
+
+	b.reset()
+	if b.delay() != time.Millisecond {
+		t.Errorf("delay after reset = %v, want 1ms", b.delay())
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if b.wait(cancelled) {
+		t.Error("wait returned true with a cancelled context")
+	}
+}
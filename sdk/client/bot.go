@@ -1,6 +1,7 @@
 package client
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
@@ -8,6 +9,9 @@ import (
 	"net/url"
 	"os"
 	"slices"
+	"strconv"
+	"sync"
+	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/lox/pokerforbots/v2/protocol"
@@ -52,6 +56,10 @@ type GameState struct {
 	Street        string
 	Button        int
 	ActiveCount   int
+
+	handAggression    map[string]AggressionSummary   // Opponents' actions in the current hand
+	aggressionHistory []map[string]AggressionSummary // Completed hands, oldest first
+	preflop           preflopSpot                    // Preflop betting in the current hand
 }
 
 // Bot provides a simple framework for poker bot implementations
@@ -61,16 +69,69 @@ type Bot struct {
 	logger  zerolog.Logger
 	handler Handler
 	state   *GameState
+
+	shutdownHooks []func(*GameState)
+	shutdownOnce  sync.Once
+	result        RunResult
+	seat          *int           // Seat to request on connect, nil to be seated randomly
+	timing        *decisionTimer // Decision latency tracking, nil when disabled
+}
+
+// RunResult summarizes a session, captured from the server's GameCompleted message.
+type RunResult struct {
+	Completed      bool   // Whether the server reported the game as completed
+	Reason         string // Completion reason, e.g. "hand_limit_reached"
+	HandsCompleted uint64 // Hands played in the game
+	HandsPlayed    int    // Hands this bot was dealt into
+	NetChips       int64  // This bot's net result
+	FinishPosition int    // 1-based rank by net chips, 0 if the bot wasn't listed
+
+	// Standings lists every player ordered by net chips, best first
+	Standings []protocol.GameCompletedPlayer
+}
+
+// Option configures a Bot
+type Option func(*Bot)
+
+// WithShutdownHook registers a hook that runs exactly once when the session ends,
+// either after OnGameCompleted or when Run returns for any other reason (context
+// cancellation, server shutdown or disconnect). Use it to flush stats that would
+// otherwise be lost when a session is interrupted.
+func WithShutdownHook(hook func(*GameState)) Option {
+	return func(b *Bot) {
+		b.shutdownHooks = append(b.shutdownHooks, hook)
+	}
+}
+
+// WithSeat asks the server to pin the bot to a seat for every hand, e.g. seat 0 to
+// always be on the button. Without it the POKERFORBOTS_SEAT environment variable
+// is used if set, as it is by the spawner.
+func WithSeat(seat int) Option {
+	return func(b *Bot) {
+		b.seat = &seat
+	}
+}
+
+// WithDecisionTiming times every OnActionRequest call and logs a warning for
+// decisions slower than slow (0 never logs). DecisionLatency reports the summary.
+func WithDecisionTiming(slow time.Duration) Option {
+	return func(b *Bot) {
+		b.timing = &decisionTimer{slow: slow}
+	}
 }
 
 // New creates a new bot with the given handler
-func New(id string, handler Handler, logger zerolog.Logger) *Bot {
-	return &Bot{
+func New(id string, handler Handler, logger zerolog.Logger, opts ...Option) *Bot {
+	b := &Bot{
 		id:      id,
 		logger:  logger.With().Str("bot_id", id).Logger(),
 		handler: handler,
 		state:   &GameState{},
 	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
 }
 
 // Connect establishes a websocket connection and sends the connect message
@@ -80,6 +141,17 @@ func (b *Bot) Connect(serverURL string) error {
 		return err
 	}
 
+	seat := b.seat
+	if seat == nil {
+		if value := os.Getenv("POKERFORBOTS_SEAT"); value != "" {
+			envSeat, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("invalid POKERFORBOTS_SEAT value: %w", err)
+			}
+			seat = &envSeat
+		}
+	}
+
 	conn, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
 	if err != nil {
 		return err
@@ -90,6 +162,7 @@ func (b *Bot) Connect(serverURL string) error {
 		Type:            protocol.TypeConnect,
 		Name:            b.id,
 		ProtocolVersion: "2", // Use protocol v2 (simplified 4-action system)
+		Seat:            seat,
 	}
 	// Allow environment override for game when launched by server
 	if game := os.Getenv("POKERFORBOTS_GAME"); game != "" {
@@ -106,12 +179,14 @@ func (b *Bot) Connect(serverURL string) error {
 	return conn.WriteMessage(websocket.BinaryMessage, payload)
 }
 
-// Run starts the bot's main loop with context support
-func (b *Bot) Run(ctx context.Context) error {
+// Run starts the bot's main loop with context support. The returned result is
+// populated from the GameCompleted message if one was received before exiting.
+func (b *Bot) Run(ctx context.Context) (*RunResult, error) {
 	if b.conn == nil {
-		return errors.New("not connected")
+		return nil, errors.New("not connected")
 	}
 	defer b.conn.Close()
+	defer b.runShutdownHooks()
 
 	// Start a goroutine to close connection on context cancellation
 	go func() {
@@ -125,12 +200,12 @@ func (b *Bot) Run(ctx context.Context) error {
 		if err != nil {
 			// Check if context was cancelled
 			if ctx.Err() != nil {
-				return ctx.Err()
+				return &b.result, ctx.Err()
 			}
 			if websocket.IsCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
-				return nil
+				return &b.result, nil
 			}
-			return err
+			return &b.result, err
 		}
 		if msgType != websocket.BinaryMessage {
 			continue
@@ -138,7 +213,7 @@ func (b *Bot) Run(ctx context.Context) error {
 
 		if err := b.handle(data); err != nil {
 			if errors.Is(err, io.EOF) {
-				return nil
+				return &b.result, nil
 			}
 			b.logger.Error().Err(err).Msg("handler error")
 		}
@@ -150,6 +225,15 @@ func (b *Bot) ID() string {
 	return b.id
 }
 
+// DecisionLatency summarizes how long the handler has taken to decide. It is
+// empty unless the bot was created WithDecisionTiming.
+func (b *Bot) DecisionLatency() DecisionLatency {
+	if b.timing == nil {
+		return DecisionLatency{}
+	}
+	return b.timing.summary()
+}
+
 // State returns the current game state
 func (b *Bot) State() *GameState {
 	return b.state
@@ -175,6 +259,9 @@ func (b *Bot) handle(data []byte) error {
 	if b.tryHandResult(data) {
 		return nil
 	}
+	if b.tryServerShutdown(data) {
+		return io.EOF
+	}
 	return b.tryGameCompleted(data)
 }
 
@@ -194,6 +281,8 @@ func (b *Bot) tryHandStart(data []byte) bool {
 	b.state.Street = "preflop"
 	b.state.Button = start.Button
 	b.updateActiveCount()
+	b.state.startHandAggression(start)
+	b.state.preflop = preflopSpot{}
 
 	if err := b.handler.OnHandStart(b.state, start); err != nil {
 		b.logger.Error().Err(err).Msg("OnHandStart error")
@@ -227,6 +316,8 @@ func (b *Bot) tryPlayerAction(data []byte) bool {
 	}
 
 	b.state.LastAction = action
+	b.state.recordAggression(action)
+	b.state.recordPreflop(action)
 
 	if err := b.handler.OnPlayerAction(b.state, action); err != nil {
 		b.logger.Error().Err(err).Msg("OnPlayerAction error")
@@ -282,6 +373,7 @@ func (b *Bot) tryHandResult(data []byte) bool {
 	if payout > 0 {
 		b.state.Chips += payout
 	}
+	b.state.finishHandAggression()
 
 	if err := b.handler.OnHandResult(b.state, result); err != nil {
 		b.logger.Error().Err(err).Msg("OnHandResult error")
@@ -295,7 +387,18 @@ func (b *Bot) tryActionRequest(data []byte) bool {
 		return false
 	}
 
+	start := time.Now()
 	action, amount, err := b.handler.OnActionRequest(b.state, req)
+	if b.timing != nil {
+		if took := time.Since(start); b.timing.record(took) {
+			b.logger.Warn().
+				Dur("took", took).
+				Dur("threshold", b.timing.slow).
+				Str("hand_id", req.HandID).
+				Str("street", b.state.Street).
+				Msg("slow decision")
+		}
+	}
 	if err != nil {
 		b.logger.Error().Err(err).Msg("OnActionRequest error")
 		action, amount = "fold", 0 // Fallback to fold
@@ -325,7 +428,52 @@ func (b *Bot) tryGameCompleted(data []byte) error {
 		return nil
 	}
 
-	return b.handler.OnGameCompleted(b.state, completed)
+	b.recordResult(completed)
+	err := b.handler.OnGameCompleted(b.state, completed)
+	b.runShutdownHooks()
+	return err
+}
+
+// recordResult captures the final standings for Run to return.
+func (b *Bot) recordResult(completed protocol.GameCompleted) {
+	standings := slices.Clone(completed.Players)
+	slices.SortStableFunc(standings, func(x, y protocol.GameCompletedPlayer) int {
+		return cmp.Compare(y.NetChips, x.NetChips)
+	})
+
+	b.result = RunResult{
+		Completed:      true,
+		Reason:         completed.Reason,
+		HandsCompleted: completed.HandsCompleted,
+		Standings:      standings,
+	}
+	for i, player := range standings {
+		if player.DisplayName == b.id {
+			b.result.HandsPlayed = player.Hands
+			b.result.NetChips = player.NetChips
+			b.result.FinishPosition = i + 1
+			break
+		}
+	}
+}
+
+// runShutdownHooks runs the registered shutdown hooks, at most once per bot.
+func (b *Bot) runShutdownHooks() {
+	b.shutdownOnce.Do(func() {
+		for _, hook := range b.shutdownHooks {
+			hook(b.state)
+		}
+	})
+}
+
+func (b *Bot) tryServerShutdown(data []byte) bool {
+	var shutdown protocol.ServerShutdown
+	if err := protocol.Unmarshal(data, &shutdown); err != nil || shutdown.Type != protocol.TypeServerShutdown {
+		return false
+	}
+
+	b.logger.Info().Str("reason", shutdown.Reason).Msg("server shutting down")
+	return true
 }
 
 func (b *Bot) updateActiveCount() {
@@ -0,0 +1,212 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/lox/pokerforbots/v2/protocol"
+	"github.com/rs/zerolog"
+)
+
+// stubHandler folds every request and exits after the game completes.
+type stubHandler struct{}
+
+func (stubHandler) OnHandStart(*GameState, protocol.HandStart) error { return nil }
+func (stubHandler) OnActionRequest(*GameState, protocol.ActionRequest) (string, int, error) {
+	return "fold", 0, nil
+}
+func (stubHandler) OnGameUpdate(*GameState, protocol.GameUpdate) error       { return nil }
+func (stubHandler) OnPlayerAction(*GameState, protocol.PlayerAction) error   { return nil }
+func (stubHandler) OnStreetChange(*GameState, protocol.StreetChange) error   { return nil }
+func (stubHandler) OnHandResult(*GameState, protocol.HandResult) error       { return nil }
+func (stubHandler) OnGameCompleted(*GameState, protocol.GameCompleted) error { return io.EOF }
+
+// startStubServer accepts one websocket connection, reads the connect message and
+// then sends the given messages, keeping the connection open until the test ends.
+func startStubServer(t *testing.T, messages ...any) string {
+	t.Helper()
+
+	done := make(chan struct{})
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		if _, _, err := conn.ReadMessage(); err != nil {
+			return
+		}
+		for _, msg := range messages {
+			payload, err := protocol.Marshal(msg)
+			if err != nil {
+				t.Errorf("marshal: %v", err)
+				return
+			}
+			if err := conn.WriteMessage(websocket.BinaryMessage, payload); err != nil {
+				return
+			}
+		}
+		<-done
+	}))
+	t.Cleanup(func() {
+		close(done)
+		server.Close()
+	})
+	return "ws" + strings.TrimPrefix(server.URL, "http")
+}
+
+func TestShutdownHookRunsOnGameCompleted(t *testing.T) {
+	t.Parallel()
+
+	url := startStubServer(t, &protocol.GameCompleted{Type: protocol.TypeGameCompleted, HandsCompleted: 10})
+
+	calls := 0
+	bot := New("hook-bot", stubHandler{}, zerolog.Nop(), WithShutdownHook(func(state *GameState) {
+		if state == nil {
+			t.Error("expected game state in shutdown hook")
+		}
+		calls++
+	}))
+	if err := bot.Connect(url); err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := bot.Run(ctx); err != nil {
+		t.Fatalf("expected clean exit after game completed, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected shutdown hook to run once, ran %d times", calls)
+	}
+}
+
+func TestShutdownHookRunsOnContextCancel(t *testing.T) {
+	t.Parallel()
+
+	url := startStubServer(t)
+
+	calls := 0
+	bot := New("hook-bot", stubHandler{}, zerolog.Nop(), WithShutdownHook(func(*GameState) {
+		calls++
+	}))
+	if err := bot.Connect(url); err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	if _, err := bot.Run(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context cancellation, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected shutdown hook to run once, ran %d times", calls)
+	}
+}
+
+func TestRunReturnsSummary(t *testing.T) {
+	t.Parallel()
+
+	url := startStubServer(t, &protocol.GameCompleted{
+		Type:           protocol.TypeGameCompleted,
+		HandsCompleted: 100,
+		Reason:         "hand_limit_reached",
+		Players: []protocol.GameCompletedPlayer{
+			{BotID: "a1", DisplayName: "rival", Hands: 100, NetChips: -250},
+			{BotID: "b2", DisplayName: "summary-bot", Hands: 98, NetChips: 400},
+			{BotID: "c3", DisplayName: "other", Hands: 100, NetChips: -150},
+		},
+	})
+
+	bot := New("summary-bot", stubHandler{}, zerolog.Nop())
+	if err := bot.Connect(url); err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	result, err := bot.Run(ctx)
+	if err != nil {
+		t.Fatalf("run: %v", err)
+	}
+
+	if !result.Completed || result.Reason != "hand_limit_reached" || result.HandsCompleted != 100 {
+		t.Errorf("unexpected completion details: %+v", result)
+	}
+	if result.HandsPlayed != 98 || result.NetChips != 400 || result.FinishPosition != 1 {
+		t.Errorf("expected 98 hands, +400 and first place, got %d hands, %+d and position %d",
+			result.HandsPlayed, result.NetChips, result.FinishPosition)
+	}
+	var order []string
+	for _, player := range result.Standings {
+		order = append(order, player.DisplayName)
+	}
+	if got := strings.Join(order, ","); got != "summary-bot,other,rival" {
+		t.Errorf("expected standings ordered by net chips, got %s", got)
+	}
+}
+
+// slowHandler takes delay to decide on every request.
+type slowHandler struct {
+	stubHandler
+	delay time.Duration
+}
+
+func (h slowHandler) OnActionRequest(*GameState, protocol.ActionRequest) (string, int, error) {
+	time.Sleep(h.delay)
+	return "fold", 0, nil
+}
+
+func TestDecisionTimingReportsSlowDecisions(t *testing.T) {
+	t.Parallel()
+
+	const requests = 3
+	messages := make([]any, 0, requests+1)
+	for range requests {
+		messages = append(messages, &protocol.ActionRequest{
+			Type:         protocol.TypeActionRequest,
+			HandID:       "slow-hand",
+			ValidActions: []string{"fold", "call"},
+		})
+	}
+	messages = append(messages, &protocol.GameCompleted{Type: protocol.TypeGameCompleted})
+	url := startStubServer(t, messages...)
+
+	var logs strings.Builder
+	logger := zerolog.New(&logs)
+	const delay = 20 * time.Millisecond
+	bot := New("slow-bot", slowHandler{delay: delay}, logger, WithDecisionTiming(delay/2))
+	if err := bot.Connect(url); err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := bot.Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+
+	latency := bot.DecisionLatency()
+	if latency.Count != requests || latency.Slow != requests {
+		t.Fatalf("expected %d slow decisions, got %+v", requests, latency)
+	}
+	if latency.Mean < delay || latency.P95 < delay || latency.Max < delay {
+		t.Errorf("expected every latency to be at least %v, got %+v", delay, latency)
+	}
+	if got := strings.Count(logs.String(), "slow decision"); got != requests {
+		t.Errorf("expected %d slow decision warnings, got %d:\n%s", requests, got, logs.String())
+	}
+
+	if untimed := New("untimed", stubHandler{}, zerolog.Nop()).DecisionLatency(); untimed.Count != 0 {
+		t.Errorf("expected no latency without timing, got %+v", untimed)
+	}
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// decisionSampleSize bounds how many recent decisions are kept for percentiles.
+const decisionSampleSize = 1000
+
+// DecisionLatency summarizes how long the handler took in OnActionRequest.
+type DecisionLatency struct {
+	Count int           // Decisions timed
+	Slow  int           // Decisions slower than the slow threshold
+	Mean  time.Duration // Mean over all decisions
+	P95   time.Duration // 95th percentile of the most recent decisions
+	Max   time.Duration // Slowest decision
+}
+
+// decisionTimer accumulates decision latencies. Decisions are recorded from the
+// bot's read loop but the summary can be read from any goroutine.
+type decisionTimer struct {
+	mu      sync.Mutex
+	slow    time.Duration // Threshold for logging a slow decision, 0 to never log
+	latency DecisionLatency
+	total   time.Duration
+	samples []time.Duration // Ring buffer of recent decisions
+	next    int
+}
+
+// record adds a decision and reports whether it was slow.
+func (t *decisionTimer) record(d time.Duration) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.latency.Count++
+	t.total += d
+	t.latency.Max = max(t.latency.Max, d)
+	if len(t.samples) < decisionSampleSize {
+		t.samples = append(t.samples, d)
+	} else {
+		t.samples[t.next] = d
+		t.next = (t.next + 1) % decisionSampleSize
+	}
+
+	slow := t.slow > 0 && d > t.slow
+	if slow {
+		t.latency.Slow++
+	}
+	return slow
+}
+
+// summary returns the latency summary so far.
+func (t *decisionTimer) summary() DecisionLatency {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	summary := t.latency
+	if summary.Count == 0 {
+		return summary
+	}
+	summary.Mean = t.total / time.Duration(summary.Count)
+	sorted := slices.Clone(t.samples)
+	slices.Sort(sorted)
+	summary.P95 = sorted[(len(sorted)*95-1)/100]
+	return summary
+}
@@ -0,0 +1,203 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/lox/pokerforbots/v2/protocol"
+	"github.com/rs/zerolog"
+)
+
+// ObserverHandler receives the public events of a game. Observers are never
+// seated, so there is no action method and hole cards only appear at showdown.
+// Return io.EOF from any method to stop the observer.
+type ObserverHandler interface {
+	OnHandStart(start protocol.HandStart) error
+	OnPlayerAction(action protocol.PlayerAction) error
+	OnStreetChange(street protocol.StreetChange) error
+	OnHandResult(result protocol.HandResult) error
+	OnGameCompleted(completed protocol.GameCompleted) error
+}
+
+// Observer is a spectator client that stays connected to a game, reconnecting
+// with backoff whenever the connection drops. Useful for dashboards and monitors.
+type Observer struct {
+	serverURL string
+	handler   ObserverHandler
+	name      string
+	game      string
+	authToken string
+	logger    zerolog.Logger
+	backoff   reconnectBackoff
+}
+
+// ObserverOption configures an Observer
+type ObserverOption func(*Observer)
+
+// WithObserverName sets the name the observer connects with
+func WithObserverName(name string) ObserverOption {
+	return func(o *Observer) {
+		o.name = name
+	}
+}
+
+// WithObserverGame selects the game to watch (the server's default game otherwise)
+func WithObserverGame(game string) ObserverOption {
+	return func(o *Observer) {
+		o.game = game
+	}
+}
+
+// WithObserverAuthToken sets the token sent to servers that require authentication
+func WithObserverAuthToken(token string) ObserverOption {
+	return func(o *Observer) {
+		o.authToken = token
+	}
+}
+
+// WithObserverLogger sets the logger used for connection events
+func WithObserverLogger(logger zerolog.Logger) ObserverOption {
+	return func(o *Observer) {
+		o.logger = logger
+	}
+}
+
+// WithReconnectDelay sets the initial delay before reconnecting, which doubles
+// after each failed attempt up to maxDelay
+func WithReconnectDelay(delay, maxDelay time.Duration) ObserverOption {
+	return func(o *Observer) {
+		o.backoff = newReconnectBackoff(delay, maxDelay)
+	}
+}
+
+// NewObserver creates a spectator client for the server at serverURL
+func NewObserver(serverURL string, handler ObserverHandler, opts ...ObserverOption) *Observer {
+	o := &Observer{
+		serverURL: serverURL,
+		handler:   handler,
+		name:      "observer",
+		logger:    zerolog.Nop(),
+		backoff:   newReconnectBackoff(defaultReconnectDelay, defaultMaxReconnectDelay),
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// Run watches the game until ctx is cancelled or the handler returns io.EOF,
+// reconnecting after dropped connections and server restarts.
+func (o *Observer) Run(ctx context.Context) error {
+	for {
+		connected, err := o.watch(ctx)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if connected {
+			o.backoff.reset()
+		}
+
+		o.logger.Warn().Err(err).Dur("retry_in", o.backoff.delay()).Msg("observer disconnected, reconnecting")
+		if !o.backoff.wait(ctx) {
+			return ctx.Err()
+		}
+	}
+}
+
+// watch runs a single connection, reporting whether it was established and the
+// error that ended it. io.EOF means the handler asked to stop.
+func (o *Observer) watch(ctx context.Context) (bool, error) {
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, o.serverURL, nil)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+
+	payload, err := protocol.Marshal(&protocol.Connect{
+		Type:      protocol.TypeConnect,
+		Name:      o.name,
+		Game:      o.game,
+		Role:      protocol.RoleObserver,
+		AuthToken: o.authToken,
+	})
+	if err != nil {
+		return false, err
+	}
+	if err := conn.WriteMessage(websocket.BinaryMessage, payload); err != nil {
+		return false, err
+	}
+	o.logger.Info().Str("server", o.serverURL).Str("game", o.game).Msg("observer connected")
+
+	// Close the connection to unblock reads when the context is cancelled
+	stop := context.AfterFunc(ctx, func() {
+		conn.Close()
+	})
+	defer stop()
+
+	for {
+		msgType, data, err := conn.ReadMessage()
+		if err != nil {
+			return true, err
+		}
+		if msgType != websocket.BinaryMessage {
+			continue
+		}
+		if err := o.dispatch(data); err != nil {
+			if errors.Is(err, io.EOF) {
+				return true, err
+			}
+			o.logger.Error().Err(err).Msg("observer handler error")
+		}
+	}
+}
+
+// dispatch decodes a public event and passes it to the handler.
+func (o *Observer) dispatch(data []byte) error {
+	// Every message has a type field; decoding skips the fields it doesn't know
+	var envelope protocol.ServerShutdown
+	if err := protocol.Unmarshal(data, &envelope); err != nil {
+		return nil
+	}
+
+	switch envelope.Type {
+	case protocol.TypeHandStart:
+		var start protocol.HandStart
+		if err := protocol.Unmarshal(data, &start); err != nil {
+			return err
+		}
+		return o.handler.OnHandStart(start)
+	case protocol.TypePlayerAction:
+		var action protocol.PlayerAction
+		if err := protocol.Unmarshal(data, &action); err != nil {
+			return err
+		}
+		return o.handler.OnPlayerAction(action)
+	case protocol.TypeStreetChange:
+		var street protocol.StreetChange
+		if err := protocol.Unmarshal(data, &street); err != nil {
+			return err
+		}
+		return o.handler.OnStreetChange(street)
+	case protocol.TypeHandResult:
+		var result protocol.HandResult
+		if err := protocol.Unmarshal(data, &result); err != nil {
+			return err
+		}
+		return o.handler.OnHandResult(result)
+	case protocol.TypeGameCompleted:
+		var completed protocol.GameCompleted
+		if err := protocol.Unmarshal(data, &completed); err != nil {
+			return err
+		}
+		return o.handler.OnGameCompleted(completed)
+	case protocol.TypeServerShutdown:
+		o.logger.Info().Str("reason", envelope.Reason).Msg("server shutting down")
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/lox/pokerforbots/v2/protocol"
+)
+
+// recordingObserver collects hand IDs and stops after the first hand result.
+type recordingObserver struct {
+	mu      sync.Mutex
+	started []string
+	results []string
+}
+
+func (r *recordingObserver) OnHandStart(start protocol.HandStart) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.started = append(r.started, start.HandID)
+	return nil
+}
+
+func (r *recordingObserver) OnPlayerAction(protocol.PlayerAction) error { return nil }
+func (r *recordingObserver) OnStreetChange(protocol.StreetChange) error { return nil }
+
+func (r *recordingObserver) OnHandResult(result protocol.HandResult) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.results = append(r.results, result.HandID)
+	return io.EOF
+}
+
+func (r *recordingObserver) OnGameCompleted(protocol.GameCompleted) error { return nil }
+
+func TestObserverReconnectsAfterDrop(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu       sync.Mutex
+		connects []protocol.Connect
+	)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		var connect protocol.Connect
+		if err := protocol.Unmarshal(data, &connect); err != nil {
+			t.Errorf("unmarshal connect: %v", err)
+			return
+		}
+		mu.Lock()
+		connects = append(connects, connect)
+		attempt := len(connects)
+		mu.Unlock()
+
+		// The first connection delivers a hand start and then drops without a
+		// close frame; the second delivers the result.
+		var msg any = &protocol.HandStart{Type: protocol.TypeHandStart, HandID: "hand-1", YourSeat: -1}
+		if attempt > 1 {
+			msg = &protocol.HandResult{Type: protocol.TypeHandResult, HandID: "hand-1"}
+		}
+		payload, err := protocol.Marshal(msg)
+		if err != nil {
+			t.Errorf("marshal: %v", err)
+			return
+		}
+		if err := conn.WriteMessage(websocket.BinaryMessage, payload); err != nil {
+			return
+		}
+		if attempt > 1 {
+			// Wait for the observer to hang up
+			_, _, _ = conn.ReadMessage()
+		}
+	}))
+	defer server.Close()
+
+	handler := &recordingObserver{}
+	observer := NewObserver("ws"+strings.TrimPrefix(server.URL, "http"), handler,
+		WithObserverName("monitor"),
+		WithObserverGame("default"),
+		WithObserverAuthToken("secret"),
+		WithReconnectDelay(10*time.Millisecond, 50*time.Millisecond))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := observer.Run(ctx); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+	if len(handler.started) != 1 || handler.started[0] != "hand-1" {
+		t.Errorf("hand starts = %v, want [hand-1]", handler.started)
+	}
+	if len(handler.results) != 1 || handler.results[0] != "hand-1" {
+		t.Errorf("hand results = %v, want [hand-1]", handler.results)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(connects) != 2 {
+		t.Fatalf("connects = %d, want 2", len(connects))
+	}
+	for i, connect := range connects {
+		if connect.Role != protocol.RoleObserver || connect.Name != "monitor" || connect.Game != "default" || connect.AuthToken != "secret" {
+			t.Errorf("connect %d = %+v, want observer role for monitor on default with its token", i, connect)
+		}
+	}
+}
+
+func TestObserverStopsOnContextCancel(t *testing.T) {
+	t.Parallel()
+
+	// Nothing is listening, so the observer keeps retrying until cancelled
+	observer := NewObserver("ws://127.0.0.1:1/ws", &recordingObserver{},
+		WithReconnectDelay(10*time.Millisecond, 20*time.Millisecond))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err := observer.Run(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("Run = %v, want context.DeadlineExceeded", err)
+	}
+}
@@ -0,0 +1,58 @@
+package client
+
+import "github.com/lox/pokerforbots/v2/protocol"
+
+// preflopSpot follows the preflop betting ahead of the bot's first decision.
+type preflopSpot struct {
+	highBet int  // Largest bet so far, starting with the big blind
+	raises  int  // Voluntary raises, including all-ins that raised
+	callers int  // Players who called the latest raise
+	acted   bool // Whether the bot has acted voluntarily this hand
+}
+
+// IsColdCallSpot reports whether the bot has yet to act preflop and faces a
+// single raise that nobody has called, so calling would be a cold call.
+func (s *GameState) IsColdCallSpot() bool {
+	return s.Street == "preflop" && !s.preflop.acted && s.preflop.raises == 1 && s.preflop.callers == 0
+}
+
+// IsSqueezeSpot reports whether the bot has yet to act preflop and faces a single
+// raise and one or more callers, where a re-raise squeezes the callers.
+func (s *GameState) IsSqueezeSpot() bool {
+	return s.Street == "preflop" && !s.preflop.acted && s.preflop.raises == 1 && s.preflop.callers > 0
+}
+
+// recordPreflop updates the preflop spot from an action seen before the flop.
+func (s *GameState) recordPreflop(action protocol.PlayerAction) {
+	if s.Street != "preflop" {
+		return
+	}
+	spot := &s.preflop
+
+	switch action.Action {
+	case "post_small_blind", "post_big_blind":
+		spot.highBet = max(spot.highBet, action.PlayerBet)
+		return
+	case "bet", "raise":
+		spot.raises++
+		spot.callers = 0
+	case "allin":
+		if action.PlayerBet > spot.highBet {
+			spot.raises++
+			spot.callers = 0
+		} else if spot.raises > 0 {
+			spot.callers++
+		}
+	case "call":
+		if spot.raises > 0 {
+			spot.callers++
+		}
+	case "check", "fold", "timeout_fold":
+	default:
+		return
+	}
+	spot.highBet = max(spot.highBet, action.PlayerBet)
+	if action.Seat == s.Seat {
+		spot.acted = true
+	}
+}
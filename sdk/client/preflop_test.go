@@ -0,0 +1,91 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/lox/pokerforbots/v2/protocol"
+)
+
+func TestPreflopSpots(t *testing.T) {
+	t.Parallel()
+
+	// The bot sits in seat 0 on the button with the blinds in seats 1 and 2
+	act := func(seat int, action string, playerBet int) protocol.PlayerAction {
+		return protocol.PlayerAction{Seat: seat, Action: action, PlayerBet: playerBet}
+	}
+	blinds := []protocol.PlayerAction{act(1, "post_small_blind", 5), act(2, "post_big_blind", 10)}
+
+	tests := []struct {
+		name        string
+		actions     []protocol.PlayerAction
+		wantCold    bool
+		wantSqueeze bool
+	}{
+		{
+			name:    "unopened pot",
+			actions: []protocol.PlayerAction{act(3, "fold", 0)},
+		},
+		{
+			name:    "limpers are not a raise",
+			actions: []protocol.PlayerAction{act(3, "call", 10), act(4, "call", 10)},
+		},
+		{
+			name:     "single raise is a cold call",
+			actions:  []protocol.PlayerAction{act(3, "raise", 30), act(4, "fold", 0)},
+			wantCold: true,
+		},
+		{
+			name:        "raise and caller is a squeeze",
+			actions:     []protocol.PlayerAction{act(3, "raise", 30), act(4, "call", 30), act(5, "fold", 0)},
+			wantSqueeze: true,
+		},
+		{
+			name:        "short all-in call counts as a caller",
+			actions:     []protocol.PlayerAction{act(3, "raise", 30), act(4, "allin", 25)},
+			wantSqueeze: true,
+		},
+		{
+			name:    "all-in over a raise is a three-bet",
+			actions: []protocol.PlayerAction{act(3, "raise", 30), act(4, "allin", 200)},
+		},
+		{
+			name:    "re-raised pot is neither",
+			actions: []protocol.PlayerAction{act(3, "raise", 30), act(4, "call", 30), act(5, "raise", 100)},
+		},
+		{
+			name: "bot already acted",
+			actions: []protocol.PlayerAction{
+				act(3, "call", 10), act(4, "call", 10), act(5, "call", 10), act(0, "call", 10),
+				act(1, "call", 10), act(2, "raise", 40), act(3, "call", 40),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			state := &GameState{Seat: 0, Street: "preflop"}
+			for _, action := range append(append([]protocol.PlayerAction(nil), blinds...), tt.actions...) {
+				state.recordPreflop(action)
+			}
+			if got := state.IsColdCallSpot(); got != tt.wantCold {
+				t.Errorf("IsColdCallSpot() = %v, want %v", got, tt.wantCold)
+			}
+			if got := state.IsSqueezeSpot(); got != tt.wantSqueeze {
+				t.Errorf("IsSqueezeSpot() = %v, want %v", got, tt.wantSqueeze)
+			}
+		})
+	}
+
+	t.Run("only preflop", func(t *testing.T) {
+		t.Parallel()
+		state := &GameState{Seat: 0, Street: "preflop"}
+		for _, action := range append(blinds, act(3, "raise", 30)) {
+			state.recordPreflop(action)
+		}
+		state.Street = "flop"
+		if state.IsColdCallSpot() {
+			t.Error("expected no cold call spot after the flop")
+		}
+	})
+}
@@ -0,0 +1,197 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/lox/pokerforbots/v2/protocol"
+	"github.com/rs/zerolog"
+)
+
+// Session keeps a bot playing for a long deployment. It connects, plays each
+// game to completion, joins the next one and reconnects with backoff whenever
+// the connection drops, aggregating results across games. A fresh Bot is
+// created for every connection, so options such as shutdown hooks run once per
+// game. Return io.EOF from the handler's OnGameCompleted to end the session.
+type Session struct {
+	serverURL string
+	id        string
+	handler   Handler
+	logger    zerolog.Logger
+	botOpts   []Option
+	maxGames  int
+	backoff   reconnectBackoff
+
+	mu     sync.Mutex
+	stats  SessionStats
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// SessionStats aggregates a session's results across games
+type SessionStats struct {
+	Games       int       // Games played to completion
+	Wins        int       // Games finished first by net chips
+	Connections int       // Successful connections, including reconnects
+	HandsPlayed int       // Hands this bot was dealt into across all games
+	NetChips    int64     // Net result across all games
+	Last        RunResult // Most recently completed game, zero before the first
+}
+
+// SessionOption configures a Session
+type SessionOption func(*Session)
+
+// WithBotOptions applies opts to the Bot created for each connection
+func WithBotOptions(opts ...Option) SessionOption {
+	return func(s *Session) {
+		s.botOpts = append(s.botOpts, opts...)
+	}
+}
+
+// WithSessionGames ends the session after n completed games (0, the default, plays until stopped)
+func WithSessionGames(n int) SessionOption {
+	return func(s *Session) {
+		s.maxGames = n
+	}
+}
+
+// WithSessionReconnectDelay sets the delay before reconnecting, which doubles
+// after each failed attempt up to maxDelay. The session also waits delay before
+// joining the next game once one completes.
+func WithSessionReconnectDelay(delay, maxDelay time.Duration) SessionOption {
+	return func(s *Session) {
+		s.backoff = newReconnectBackoff(delay, maxDelay)
+	}
+}
+
+// NewSession creates a session that plays as id on the server at serverURL
+func NewSession(serverURL, id string, handler Handler, logger zerolog.Logger, opts ...SessionOption) *Session {
+	s := &Session{
+		serverURL: serverURL,
+		id:        id,
+		handler:   handler,
+		logger:    logger.With().Str("bot_id", id).Logger(),
+		backoff:   newReconnectBackoff(defaultReconnectDelay, defaultMaxReconnectDelay),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Start runs the session in the background until Stop is called, ctx is
+// cancelled, the game limit is reached or the handler ends it.
+func (s *Session) Start(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.done != nil {
+		return errors.New("session already started")
+	}
+
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.done = make(chan struct{})
+	go s.run(ctx)
+	return nil
+}
+
+// Stop ends the session, closing any open connection, and waits for it to finish.
+func (s *Session) Stop() {
+	s.mu.Lock()
+	cancel, done := s.cancel, s.done
+	s.mu.Unlock()
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+}
+
+// Done returns a channel that is closed when the session ends. It is nil
+// before Start.
+func (s *Session) Done() <-chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.done
+}
+
+// Stats returns the results aggregated so far
+func (s *Session) Stats() SessionStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stats
+}
+
+func (s *Session) run(ctx context.Context) {
+	defer close(s.done)
+
+	for {
+		handler := &sessionHandler{Handler: s.handler, logger: s.logger}
+		bot := New(s.id, handler, s.logger, s.botOpts...)
+		if err := bot.Connect(s.serverURL); err != nil {
+			s.logger.Warn().Err(err).Dur("retry_in", s.backoff.delay()).Msg("session connect failed, retrying")
+			if !s.backoff.wait(ctx) {
+				return
+			}
+			continue
+		}
+		s.backoff.reset()
+
+		s.mu.Lock()
+		s.stats.Connections++
+		s.mu.Unlock()
+
+		result, err := bot.Run(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if result != nil && result.Completed {
+			games := s.recordGame(*result)
+			if handler.stop || (s.maxGames > 0 && games >= s.maxGames) {
+				return
+			}
+			s.logger.Info().Int("games", games).Str("reason", result.Reason).Msg("game completed, joining the next game")
+		} else {
+			s.logger.Warn().Err(err).Dur("retry_in", s.backoff.delay()).Msg("session disconnected, reconnecting")
+		}
+
+		if !s.backoff.wait(ctx) {
+			return
+		}
+	}
+}
+
+// recordGame adds a completed game to the stats and returns the games played.
+func (s *Session) recordGame(result RunResult) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stats.Games++
+	if result.FinishPosition == 1 {
+		s.stats.Wins++
+	}
+	s.stats.HandsPlayed += result.HandsPlayed
+	s.stats.NetChips += result.NetChips
+	s.stats.Last = result
+	return s.stats.Games
+}
+
+// sessionHandler ends each connection once its game completes so the session
+// can join the next game, noting whether the wrapped handler asked to stop.
+type sessionHandler struct {
+	Handler
+	logger zerolog.Logger
+	stop   bool
+}
+
+func (h *sessionHandler) OnGameCompleted(state *GameState, completed protocol.GameCompleted) error {
+	err := h.Handler.OnGameCompleted(state, completed)
+	switch {
+	case errors.Is(err, io.EOF):
+		h.stop = true
+	case err != nil:
+		h.logger.Error().Err(err).Msg("handler error")
+	}
+	return io.EOF
+}
@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/lox/pokerforbots/v2/protocol"
+	"github.com/rs/zerolog"
+)
+
+// continuingHandler folds every request and keeps playing after each game.
+type continuingHandler struct{ stubHandler }
+
+func (continuingHandler) OnGameCompleted(*GameState, protocol.GameCompleted) error { return nil }
+
+func TestSessionAccumulatesAcrossGamesAndReconnects(t *testing.T) {
+	t.Parallel()
+
+	completed := func(net int64, hands int) *protocol.GameCompleted {
+		return &protocol.GameCompleted{
+			Type:   protocol.TypeGameCompleted,
+			Reason: "hand_limit_reached",
+			Players: []protocol.GameCompletedPlayer{
+				{DisplayName: "session-bot", NetChips: net, Hands: hands},
+				{DisplayName: "rival", NetChips: -net, Hands: hands},
+			},
+		}
+	}
+
+	var (
+		mu       sync.Mutex
+		attempts int
+	)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		if _, _, err := conn.ReadMessage(); err != nil {
+			return
+		}
+		mu.Lock()
+		attempts++
+		attempt := attempts
+		mu.Unlock()
+
+		// The first game completes, the next connection drops mid-game and the
+		// game after the reconnect completes too
+		var msg *protocol.GameCompleted
+		switch attempt {
+		case 1:
+			msg = completed(100, 10)
+		case 2:
+			return
+		case 3:
+			msg = completed(-30, 5)
+		}
+		if msg != nil {
+			payload, err := protocol.Marshal(msg)
+			if err != nil {
+				t.Errorf("marshal: %v", err)
+				return
+			}
+			if err := conn.WriteMessage(websocket.BinaryMessage, payload); err != nil {
+				return
+			}
+		}
+		// Wait for the session to hang up
+		_, _, _ = conn.ReadMessage()
+	}))
+	defer server.Close()
+
+	session := NewSession("ws"+strings.TrimPrefix(server.URL, "http"), "session-bot", continuingHandler{}, zerolog.Nop(),
+		WithSessionReconnectDelay(10*time.Millisecond, 50*time.Millisecond))
+	if err := session.Start(context.Background()); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	if err := session.Start(context.Background()); err == nil {
+		t.Error("expected second Start to fail")
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for session.Stats().Connections < 4 {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for the session to rejoin, stats %+v", session.Stats())
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	session.Stop()
+
+	select {
+	case <-session.Done():
+	default:
+		t.Fatal("expected session to be done after Stop")
+	}
+
+	stats := session.Stats()
+	if stats.Games != 2 || stats.Wins != 1 {
+		t.Errorf("games = %d, wins = %d, want 2 games and 1 win", stats.Games, stats.Wins)
+	}
+	if stats.HandsPlayed != 15 || stats.NetChips != 70 {
+		t.Errorf("hands = %d, net = %d, want 15 hands and +70", stats.HandsPlayed, stats.NetChips)
+	}
+	if stats.Last.NetChips != -30 || stats.Last.FinishPosition != 2 {
+		t.Errorf("last result = %+v, want -30 finishing second", stats.Last)
+	}
+}
+
+func TestSessionEndsAfterGameLimit(t *testing.T) {
+	t.Parallel()
+
+	url := startStubServer(t, &protocol.GameCompleted{
+		Type:    protocol.TypeGameCompleted,
+		Players: []protocol.GameCompletedPlayer{{DisplayName: "session-bot", NetChips: 5, Hands: 1}},
+	})
+	session := NewSession(url, "session-bot", continuingHandler{}, zerolog.Nop(), WithSessionGames(1))
+	if err := session.Start(context.Background()); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	select {
+	case <-session.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the session to end")
+	}
+	if stats := session.Stats(); stats.Games != 1 || stats.NetChips != 5 {
+		t.Errorf("stats = %+v, want one game at +5", stats)
+	}
+}
@@ -0,0 +1,33 @@
+package client
+
+import (
+	"math"
+	"slices"
+
+	"github.com/lox/pokerforbots/v2/protocol"
+)
+
+// BetFraction converts a pot-fraction bet into a legal protocol v2 action for req.
+// The bet is sized after calling, so 1.0 is a pot-sized bet or raise, and is
+// clamped to at least req.MinBet. Sizes that reach the bot's stack become an
+// all-in. If raising isn't allowed the bot calls (or checks), folding only when
+// calling isn't an option either.
+func (s *GameState) BetFraction(req protocol.ActionRequest, fraction float64) (string, int) {
+	currentBet := req.MinBet - req.MinRaise
+	ourBet := max(currentBet-req.ToCall, 0)
+	maxTotal := ourBet + s.Chips
+
+	target := currentBet + int(math.Round(fraction*float64(req.Pot+req.ToCall)))
+	target = max(target, req.MinBet)
+
+	if target >= maxTotal && slices.Contains(req.ValidActions, "allin") {
+		return "allin", 0
+	}
+	if target < maxTotal && slices.Contains(req.ValidActions, "raise") {
+		return "raise", target
+	}
+	if slices.Contains(req.ValidActions, "call") {
+		return "call", 0
+	}
+	return "fold", 0
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/lox/pokerforbots/v2/protocol"
+)
+
+func TestBetFraction(t *testing.T) {
+	t.Parallel()
+
+	open := []string{"fold", "call", "raise", "allin"}
+	tests := []struct {
+		name       string
+		chips      int
+		req        protocol.ActionRequest
+		fraction   float64
+		wantAction string
+		wantAmount int
+	}{
+		{
+			name:       "three quarter pot bet",
+			chips:      1000,
+			req:        protocol.ActionRequest{Pot: 100, MinBet: 10, MinRaise: 10, ValidActions: open},
+			fraction:   0.75,
+			wantAction: "raise",
+			wantAmount: 75,
+		},
+		{
+			// Facing 50 into 150: call 50 then raise a pot of 200 to 250 total
+			name:       "pot raise sizes after calling",
+			chips:      1000,
+			req:        protocol.ActionRequest{Pot: 150, ToCall: 50, MinBet: 100, MinRaise: 50, ValidActions: open},
+			fraction:   1,
+			wantAction: "raise",
+			wantAmount: 250,
+		},
+		{
+			name:       "small fraction clamps to min bet",
+			chips:      1000,
+			req:        protocol.ActionRequest{Pot: 100, MinBet: 10, MinRaise: 10, ValidActions: open},
+			fraction:   0.01,
+			wantAction: "raise",
+			wantAmount: 10,
+		},
+		{
+			name:       "bet reaching the stack goes all in",
+			chips:      60,
+			req:        protocol.ActionRequest{Pot: 100, MinBet: 10, MinRaise: 10, ValidActions: open},
+			fraction:   0.75,
+			wantAction: "allin",
+		},
+		{
+			// Already 20 in with 80 behind, so raising to 100 is the whole stack
+			name:       "stack includes chips already bet",
+			chips:      80,
+			req:        protocol.ActionRequest{Pot: 70, ToCall: 20, MinBet: 60, MinRaise: 20, ValidActions: open},
+			fraction:   1,
+			wantAction: "allin",
+		},
+		{
+			name:       "calls when raising is not allowed",
+			chips:      1000,
+			req:        protocol.ActionRequest{Pot: 100, ToCall: 50, MinBet: 100, MinRaise: 50, ValidActions: []string{"fold", "call"}},
+			fraction:   1,
+			wantAction: "call",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &GameState{Chips: tt.chips}
+			action, amount := state.BetFraction(tt.req, tt.fraction)
+			if action != tt.wantAction || amount != tt.wantAmount {
+				t.Errorf("BetFraction(%v) = (%s, %d), want (%s, %d)", tt.fraction, action, amount, tt.wantAction, tt.wantAmount)
+			}
+		})
+	}
+}
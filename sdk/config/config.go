@@ -21,6 +21,48 @@ const (
 
 	// EnvGame specifies the target game ID (defaults to "default")
 	EnvGame = "POKERFORBOTS_GAME"
+
+	// EnvSeat pins the bot to a seat (0 is the button); unset to be seated randomly
+	EnvSeat = "POKERFORBOTS_SEAT"
+
+	// EnvEquityIterations sets the Monte Carlo iterations per equity estimate
+	EnvEquityIterations = "POKERFORBOTS_EQUITY_ITERATIONS"
+
+	// EnvOpponentModel selects how bots assume opponents play (see OpponentModel constants)
+	EnvOpponentModel = "POKERFORBOTS_OPPONENT_MODEL"
+
+	// EnvTightness sets the fraction of starting hands a styled bot folds (0-1)
+	EnvTightness = "POKERFORBOTS_TIGHTNESS"
+
+	// EnvAggression sets how often a styled bot bets or raises instead of calling (0-1)
+	EnvAggression = "POKERFORBOTS_AGGRESSION"
+
+	// EnvStrategyMix scales how often the complex bot mixes in alternative close-EV actions (0-1)
+	EnvStrategyMix = "POKERFORBOTS_STRATEGY_MIX"
+)
+
+// DefaultEquityIterations is used when EnvEquityIterations is not set
+const DefaultEquityIterations = 1000
+
+// Default style factors, a tight-aggressive player
+const (
+	DefaultTightness  = 0.8
+	DefaultAggression = 0.7
+)
+
+// DefaultStrategyMix plays the complex bot's mixed actions at their tuned frequencies
+const DefaultStrategyMix = 1.0
+
+// Opponent models understood by the built-in bots
+const (
+	// OpponentModelRandom assumes opponents can hold any two cards (the default)
+	OpponentModelRandom = "random"
+
+	// OpponentModelTight assumes opponents hold the strongest 35% of starting hands
+	OpponentModelTight = "tight"
+
+	// OpponentModelLoose assumes opponents hold the strongest 70% of starting hands
+	OpponentModelLoose = "loose"
 )
 
 // BotConfig holds configuration parsed from environment variables
@@ -36,13 +78,38 @@ type BotConfig struct {
 
 	// GameID is the target game to join (defaults to "default")
 	GameID string
+
+	// EquityIterations is the Monte Carlo iterations per equity estimate
+	// (defaults to DefaultEquityIterations)
+	EquityIterations int
+
+	// OpponentModel is how opponents are assumed to play (defaults to OpponentModelRandom)
+	OpponentModel string
+
+	// Tightness is the fraction of starting hands folded preflop, from 0 (plays
+	// everything) to 1 (plays nothing). Defaults to DefaultTightness
+	Tightness float64
+
+	// Aggression is the probability of betting or raising rather than calling
+	// when continuing, from 0 to 1. Defaults to DefaultAggression
+	Aggression float64
+
+	// StrategyMix scales the frequencies at which the complex bot swaps a table
+	// action for a close-EV alternative, from 0 (always the table action) to 1
+	// (the tuned frequencies). Defaults to DefaultStrategyMix
+	StrategyMix float64
 }
 
 // FromEnv parses configuration from environment variables.
 // Returns an error if required variables are missing or invalid.
 func FromEnv() (*BotConfig, error) {
 	cfg := &BotConfig{
-		GameID: "default", // Default game if not specified
+		GameID:           "default", // Default game if not specified
+		EquityIterations: DefaultEquityIterations,
+		OpponentModel:    OpponentModelRandom,
+		Tightness:        DefaultTightness,
+		Aggression:       DefaultAggression,
+		StrategyMix:      DefaultStrategyMix,
 	}
 
 	// Parse server URL (required)
@@ -68,6 +135,52 @@ func FromEnv() (*BotConfig, error) {
 		cfg.GameID = gameID
 	}
 
+	// Parse equity iterations (optional)
+	if itersStr := os.Getenv(EnvEquityIterations); itersStr != "" {
+		iters, err := strconv.Atoi(itersStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value: %w", EnvEquityIterations, err)
+		}
+		if iters <= 0 {
+			return nil, fmt.Errorf("invalid %s value: must be positive, got %d", EnvEquityIterations, iters)
+		}
+		cfg.EquityIterations = iters
+	}
+
+	// Parse opponent model (optional)
+	if model := os.Getenv(EnvOpponentModel); model != "" {
+		switch model {
+		case OpponentModelRandom, OpponentModelTight, OpponentModelLoose:
+			cfg.OpponentModel = model
+		default:
+			return nil, fmt.Errorf("invalid %s value %q: expected %s, %s or %s",
+				EnvOpponentModel, model, OpponentModelRandom, OpponentModelTight, OpponentModelLoose)
+		}
+	}
+
+	// Parse style factors and strategy mix (optional)
+	for _, factor := range []struct {
+		env   string
+		value *float64
+	}{
+		{EnvTightness, &cfg.Tightness},
+		{EnvAggression, &cfg.Aggression},
+		{EnvStrategyMix, &cfg.StrategyMix},
+	} {
+		str := os.Getenv(factor.env)
+		if str == "" {
+			continue
+		}
+		v, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value: %w", factor.env, err)
+		}
+		if v < 0 || v > 1 {
+			return nil, fmt.Errorf("invalid %s value: must be between 0 and 1, got %v", factor.env, v)
+		}
+		*factor.value = v
+	}
+
 	return cfg, nil
 }
 
@@ -19,12 +19,23 @@ func TestFromEnv(t *testing.T) {
 				EnvSeed:   "12345",
 				EnvBotID:  "bot-1",
 				EnvGame:   "tournament",
+
+				EnvEquityIterations: "250",
+				EnvOpponentModel:    OpponentModelTight,
+				EnvTightness:        "0.9",
+				EnvAggression:       "0.25",
+				EnvStrategyMix:      "0.5",
 			},
 			want: &BotConfig{
-				ServerURL: "ws://localhost:8080/ws",
-				Seed:      12345,
-				BotID:     "bot-1",
-				GameID:    "tournament",
+				ServerURL:        "ws://localhost:8080/ws",
+				Seed:             12345,
+				BotID:            "bot-1",
+				GameID:           "tournament",
+				EquityIterations: 250,
+				OpponentModel:    OpponentModelTight,
+				Tightness:        0.9,
+				Aggression:       0.25,
+				StrategyMix:      0.5,
 			},
 		},
 		{
@@ -33,8 +44,13 @@ func TestFromEnv(t *testing.T) {
 				EnvServer: "ws://localhost:8080/ws",
 			},
 			want: &BotConfig{
-				ServerURL: "ws://localhost:8080/ws",
-				GameID:    "default",
+				ServerURL:        "ws://localhost:8080/ws",
+				GameID:           "default",
+				EquityIterations: DefaultEquityIterations,
+				OpponentModel:    OpponentModelRandom,
+				Tightness:        DefaultTightness,
+				Aggression:       DefaultAggression,
+				StrategyMix:      DefaultStrategyMix,
 			},
 		},
 		{
@@ -50,6 +66,30 @@ func TestFromEnv(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "invalid equity iterations",
+			env: map[string]string{
+				EnvServer:           "ws://localhost:8080/ws",
+				EnvEquityIterations: "0",
+			},
+			wantErr: true,
+		},
+		{
+			name: "aggression out of range",
+			env: map[string]string{
+				EnvServer:     "ws://localhost:8080/ws",
+				EnvAggression: "1.5",
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown opponent model",
+			env: map[string]string{
+				EnvServer:        "ws://localhost:8080/ws",
+				EnvOpponentModel: "psychic",
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -84,6 +124,18 @@ func TestFromEnv(t *testing.T) {
 			if got.GameID != tt.want.GameID {
 				t.Errorf("GameID = %v, want %v", got.GameID, tt.want.GameID)
 			}
+			if got.EquityIterations != tt.want.EquityIterations {
+				t.Errorf("EquityIterations = %v, want %v", got.EquityIterations, tt.want.EquityIterations)
+			}
+			if got.OpponentModel != tt.want.OpponentModel {
+				t.Errorf("OpponentModel = %v, want %v", got.OpponentModel, tt.want.OpponentModel)
+			}
+			if got.Tightness != tt.want.Tightness || got.Aggression != tt.want.Aggression {
+				t.Errorf("Tightness, Aggression = %v, %v, want %v, %v", got.Tightness, got.Aggression, tt.want.Tightness, tt.want.Aggression)
+			}
+			if got.StrategyMix != tt.want.StrategyMix {
+				t.Errorf("StrategyMix = %v, want %v", got.StrategyMix, tt.want.StrategyMix)
+			}
 		})
 	}
 }
@@ -0,0 +1,141 @@
+package spawner
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Session describes a spawn run well enough to replay it with the same deals and
+// bot seeds.
+type Session struct {
+	Seed    int64    // Server and bot seed
+	Hands   int      // Hand limit, 0 for unlimited
+	Spec    string   // Built-in bot spec, e.g. "calling-station:2,random:1"
+	BotCmds []string // Custom bot commands
+	Count   int      // Copies of each custom bot command, 0 means 1
+}
+
+// ReproduceCommand returns the canonical `pokerforbots spawn` command that replays
+// the session, for printing when a run completes. ParseReproduceCommand reads it back.
+func (s Session) ReproduceCommand() string {
+	args := []string{"pokerforbots", "spawn", "--seed", strconv.FormatInt(s.Seed, 10)}
+	if s.Hands > 0 {
+		args = append(args, "--hand-limit", strconv.Itoa(s.Hands))
+	}
+	if s.Spec != "" {
+		args = append(args, "--spec", s.Spec)
+	}
+	for _, cmd := range s.BotCmds {
+		args = append(args, "--bot-cmd", cmd)
+	}
+	if s.Count > 1 {
+		args = append(args, "--count", strconv.Itoa(s.Count))
+	}
+
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = shellQuote(arg)
+	}
+	return strings.Join(quoted, " ")
+}
+
+// ParseReproduceCommand parses a command produced by Session.ReproduceCommand.
+func ParseReproduceCommand(command string) (Session, error) {
+	args, err := shellSplit(command)
+	if err != nil {
+		return Session{}, err
+	}
+	if len(args) < 2 || args[0] != "pokerforbots" || args[1] != "spawn" {
+		return Session{}, errors.New("not a pokerforbots spawn command")
+	}
+
+	var s Session
+	for i := 2; i < len(args); i += 2 {
+		if i+1 >= len(args) {
+			return Session{}, fmt.Errorf("missing value for %s", args[i])
+		}
+		flag, value := args[i], args[i+1]
+		switch flag {
+		case "--seed":
+			s.Seed, err = strconv.ParseInt(value, 10, 64)
+		case "--hand-limit":
+			s.Hands, err = strconv.Atoi(value)
+		case "--spec":
+			s.Spec = value
+		case "--bot-cmd":
+			s.BotCmds = append(s.BotCmds, value)
+		case "--count":
+			s.Count, err = strconv.Atoi(value)
+		default:
+			return Session{}, fmt.Errorf("unknown flag %s", flag)
+		}
+		if err != nil {
+			return Session{}, fmt.Errorf("invalid %s value %q: %w", flag, value, err)
+		}
+	}
+	return s, nil
+}
+
+// shellQuote single-quotes arg if a POSIX shell would otherwise split or expand it.
+func shellQuote(arg string) string {
+	if arg != "" && strings.Trim(arg, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789_-+=./:,@") == "" {
+		return arg
+	}
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
+
+// shellSplit splits a command into words the way a POSIX shell does for single
+// quotes, double quotes and backslash escapes. Expansions are not supported.
+func shellSplit(command string) ([]string, error) {
+	var (
+		words   []string
+		word    strings.Builder
+		inWord  bool
+		quote   rune
+		escaped bool
+	)
+	for _, r := range command {
+		switch {
+		case escaped:
+			word.WriteRune(r)
+			escaped = false
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case quote == '"':
+			switch r {
+			case '"':
+				quote = 0
+			case '\\':
+				escaped = true
+			default:
+				word.WriteRune(r)
+			}
+		case r == '\\':
+			escaped, inWord = true, true
+		case r == '\'' || r == '"':
+			quote, inWord = r, true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 || escaped {
+		return nil, errors.New("unterminated quote or escape")
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
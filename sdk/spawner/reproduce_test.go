@@ -0,0 +1,63 @@
+package spawner
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReproduceCommandRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		session Session
+		want    string
+	}{
+		{
+			name:    "built-in bots",
+			session: Session{Seed: 42, Hands: 1000, Spec: "calling-station:2,random:1"},
+			want:    "pokerforbots spawn --seed 42 --hand-limit 1000 --spec calling-station:2,random:1",
+		},
+		{
+			name:    "custom bots with quoting",
+			session: Session{Seed: -7, Spec: "complex:3", BotCmds: []string{"go run ./my-bot", "./bot --name 'it''s me'"}, Count: 2},
+			want:    `pokerforbots spawn --seed -7 --spec complex:3 --bot-cmd 'go run ./my-bot' --bot-cmd './bot --name '\''it'\'''\''s me'\''' --count 2`,
+		},
+		{
+			name:    "seed only",
+			session: Session{Seed: 0},
+			want:    "pokerforbots spawn --seed 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			command := tt.session.ReproduceCommand()
+			if command != tt.want {
+				t.Errorf("ReproduceCommand() =\n  %s\nwant\n  %s", command, tt.want)
+			}
+			got, err := ParseReproduceCommand(command)
+			if err != nil {
+				t.Fatalf("ParseReproduceCommand: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.session) {
+				t.Errorf("round trip = %+v, want %+v", got, tt.session)
+			}
+		})
+	}
+}
+
+func TestParseReproduceCommandErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, command := range []string{
+		"pokerforbots server --seed 1",
+		"pokerforbots spawn --seed",
+		"pokerforbots spawn --seed abc",
+		"pokerforbots spawn --bogus 1",
+		"pokerforbots spawn --bot-cmd 'unterminated",
+	} {
+		if _, err := ParseReproduceCommand(command); err == nil {
+			t.Errorf("expected an error parsing %q", command)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"maps"
 	"net/http"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -36,6 +37,7 @@ type BotSpec struct {
 	GameID    string            // Target game (default: "default")
 	Env       map[string]string // Additional environment variables
 	QuietLogs bool              // Suppress process output logs
+	Seat      *int              // Pin the bot to this seat (0 is the button); requires Count of 1
 }
 
 // GameStats represents game statistics from the server.
@@ -48,6 +50,7 @@ type GameStats struct {
 	TimeoutMs        int                           `json:"timeout_ms"`
 	MinPlayers       int                           `json:"min_players"`
 	MaxPlayers       int                           `json:"max_players"`
+	OpenSeats        int                           `json:"open_seats"`
 	InfiniteBankroll bool                          `json:"infinite_bankroll"`
 	HandsCompleted   uint64                        `json:"hands_completed"`
 	HandLimit        uint64                        `json:"hand_limit"`
@@ -89,6 +92,9 @@ func (s *BotSpawner) Spawn(specs ...BotSpec) error {
 		if spec.Count <= 0 {
 			spec.Count = 1
 		}
+		if spec.Seat != nil && spec.Count > 1 {
+			return fmt.Errorf("cannot pin %d bots to seat %d", spec.Count, *spec.Seat)
+		}
 		totalBots += spec.Count
 	}
 
@@ -208,6 +214,9 @@ func (s *BotSpawner) buildEnvWithID(spec BotSpec, botID int) map[string]string {
 	env[config.EnvServer] = s.serverURL
 	env[config.EnvGame] = spec.GameID
 	env[config.EnvBotID] = fmt.Sprintf("bot-%d", botID)
+	if spec.Seat != nil {
+		env[config.EnvSeat] = strconv.Itoa(*spec.Seat)
+	}
 
 	// Add seed derivation for deterministic testing
 	if s.seed != 0 {
@@ -252,6 +261,81 @@ func (s *BotSpawner) SpawnBot(spec BotSpec) (*Process, error) {
 	return proc, nil
 }
 
+// AddSeat opens a seat on the spec's game and spawns a bot to fill it, growing
+// the number of bots dealt into each hand. The spec's Count must be 1.
+func (s *BotSpawner) AddSeat(spec BotSpec) (*Process, error) {
+	if spec.GameID == "" {
+		spec.GameID = "default"
+	}
+	seats, err := OpenSeat(s.serverURL, spec.GameID)
+	if err != nil {
+		return nil, err
+	}
+	proc, err := s.SpawnBot(spec)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info().Str("bot_id", proc.ID).Int("open_seats", seats).Msg("Seat added")
+	return proc, nil
+}
+
+// RemoveSeat closes a seat on the given game and stops the bot process with the
+// given ID, shrinking the number of bots dealt into each hand. A bot stopped
+// mid-hand is folded by the server.
+func (s *BotSpawner) RemoveSeat(gameID, id string) error {
+	proc, ok := s.GetProcess(id)
+	if !ok {
+		return fmt.Errorf("unknown process %s", id)
+	}
+
+	seats, err := CloseSeat(s.serverURL, gameID)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	delete(s.processes, id)
+	s.mu.Unlock()
+	if err := proc.Stop(); err != nil && !strings.Contains(err.Error(), "process already finished") {
+		return fmt.Errorf("failed to stop process %s: %w", id, err)
+	}
+	s.logger.Info().Str("bot_id", id).Int("open_seats", seats).Msg("Seat removed")
+	return nil
+}
+
+// Restart replaces an exited process with a fresh one running the same command,
+// arguments and environment, so a restarted bot reconnects with the same bot ID.
+func (s *BotSpawner) Restart(id string) (*Process, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	previous, ok := s.processes[id]
+	if !ok {
+		return nil, fmt.Errorf("unknown process %s", id)
+	}
+	if previous.IsAlive() {
+		return nil, fmt.Errorf("process %s is still running", id)
+	}
+	if s.ctx.Err() != nil {
+		return nil, fmt.Errorf("spawner is stopped")
+	}
+
+	proc := NewProcess(s.ctx, previous.Command, previous.Args, previous.Env, s.logger)
+	proc.ID = id
+	proc.logger = previous.logger
+	if err := proc.Start(); err != nil {
+		return nil, fmt.Errorf("failed to restart process %s: %w", id, err)
+	}
+	s.processes[id] = proc
+
+	s.logger.Info().
+		Str("process_id", id).
+		Str("command", proc.Command).
+		Msg("Process restarted")
+
+	return proc, nil
+}
+
 // GetProcess retrieves a process by its bot ID.
 func (s *BotSpawner) GetProcess(botID string) (*Process, bool) {
 	s.mu.RLock()
@@ -273,12 +357,7 @@ func (s *BotSpawner) GetAllProcesses() []*Process {
 
 // CollectStats fetches game statistics from the server.
 func CollectStats(serverURL string, gameID string) (*GameStats, error) {
-	// Convert WebSocket URL to HTTP
-	httpURL := strings.Replace(serverURL, "ws://", "http://", 1)
-	httpURL = strings.Replace(httpURL, "wss://", "https://", 1)
-	httpURL = strings.TrimSuffix(httpURL, "/ws")
-
-	statsURL := fmt.Sprintf("%s/admin/games/%s/stats", httpURL, gameID)
+	statsURL := fmt.Sprintf("%s/admin/games/%s/stats", adminURL(serverURL), gameID)
 
 	resp, err := http.Get(statsURL)
 	if err != nil {
@@ -302,3 +381,48 @@ func CollectStats(serverURL string, gameID string) (*GameStats, error) {
 
 	return &stats, nil
 }
+
+// OpenSeat asks the server to deal one more seat into each hand of a game and
+// returns the number of open seats.
+func OpenSeat(serverURL string, gameID string) (int, error) {
+	return changeSeats(serverURL, gameID, "open-seat")
+}
+
+// CloseSeat asks the server to deal one seat fewer into each hand of a game,
+// starting after the hands in progress, and returns the number of open seats.
+func CloseSeat(serverURL string, gameID string) (int, error) {
+	return changeSeats(serverURL, gameID, "close-seat")
+}
+
+func changeSeats(serverURL, gameID, endpoint string) (int, error) {
+	seatsURL := fmt.Sprintf("%s/admin/games/%s/%s", adminURL(serverURL), gameID, endpoint)
+
+	resp, err := http.Post(seatsURL, "application/json", nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to %s: %w", endpoint, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read %s response: %w", endpoint, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("%s request failed with status %d: %s", endpoint, resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+
+	var seats struct {
+		OpenSeats int `json:"open_seats"`
+	}
+	if err := json.Unmarshal(data, &seats); err != nil {
+		return 0, fmt.Errorf("failed to parse %s response: %w", endpoint, err)
+	}
+	return seats.OpenSeats, nil
+}
+
+// adminURL converts a WebSocket server URL into the base URL of its HTTP API.
+func adminURL(serverURL string) string {
+	httpURL := strings.Replace(serverURL, "ws://", "http://", 1)
+	httpURL = strings.Replace(httpURL, "wss://", "https://", 1)
+	return strings.TrimSuffix(httpURL, "/ws")
+}
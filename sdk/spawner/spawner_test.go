@@ -2,6 +2,7 @@ package spawner
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -198,6 +199,40 @@ func TestSpawnBot(t *testing.T) {
 	spawner.StopAll()
 }
 
+func TestSpawnerRestart(t *testing.T) {
+	logger := zerolog.New(zerolog.NewTestWriter(t))
+	spawner := New("ws://localhost:8080/ws", logger)
+	defer spawner.StopAll()
+
+	// Crash straight away, like a bot with a startup bug
+	proc, err := spawner.SpawnBot(BotSpec{Command: "sh", Args: []string{"-c", "exit 3"}, Count: 1})
+	if err != nil {
+		t.Fatalf("Failed to spawn bot: %v", err)
+	}
+	if err := proc.Wait(); err == nil {
+		t.Fatal("Expected the bot to exit with an error")
+	}
+
+	restarted, err := spawner.Restart(proc.ID)
+	if err != nil {
+		t.Fatalf("Failed to restart bot: %v", err)
+	}
+	if restarted == proc || restarted.ID != proc.ID {
+		t.Errorf("Expected a new process with ID %s, got %s", proc.ID, restarted.ID)
+	}
+	if got := restarted.Env[config.EnvBotID]; got != proc.Env[config.EnvBotID] {
+		t.Errorf("Expected restarted bot to keep bot ID %s, got %s", proc.Env[config.EnvBotID], got)
+	}
+	if current, _ := spawner.GetProcess(proc.ID); current != restarted {
+		t.Error("Expected the restarted process to replace the crashed one")
+	}
+	restarted.Wait()
+
+	if _, err := spawner.Restart("missing"); err == nil {
+		t.Error("Expected an error restarting an unknown process")
+	}
+}
+
 func TestCollectStats(t *testing.T) {
 	// Create a test server
 	mux := http.NewServeMux()
@@ -230,3 +265,53 @@ func TestCollectStats(t *testing.T) {
 		t.Errorf("Expected 42 hands completed, got %d", stats.HandsCompleted)
 	}
 }
+
+func TestOpenAndCloseSeat(t *testing.T) {
+	seats := 2
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /admin/games/test-game/open-seat", func(w http.ResponseWriter, r *http.Request) {
+		seats++
+		fmt.Fprintf(w, `{"open_seats":%d}`, seats)
+	})
+	mux.HandleFunc("POST /admin/games/test-game/close-seat", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte("cannot close seats below the minimum of 2 players"))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	wsURL := strings.Replace(server.URL, "http://", "ws://", 1) + "/ws"
+
+	got, err := OpenSeat(wsURL, "test-game")
+	if err != nil {
+		t.Fatalf("Failed to open seat: %v", err)
+	}
+	if got != 3 {
+		t.Errorf("Expected 3 open seats, got %d", got)
+	}
+
+	if _, err := CloseSeat(wsURL, "test-game"); err == nil || !strings.Contains(err.Error(), "minimum") {
+		t.Errorf("Expected the server's conflict error, got %v", err)
+	}
+}
+
+func TestSpawnerSeatPinning(t *testing.T) {
+	logger := zerolog.New(zerolog.NewTestWriter(t))
+	spawner := New("ws://localhost:8080/ws", logger)
+
+	button := 0
+	env := spawner.buildEnvWithID(BotSpec{Command: "echo", Seat: &button}, 1)
+	if got := env[config.EnvSeat]; got != "0" {
+		t.Errorf("expected %s=0, got %q", config.EnvSeat, got)
+	}
+	if _, ok := spawner.buildEnvWithID(BotSpec{Command: "echo"}, 2)[config.EnvSeat]; ok {
+		t.Errorf("expected no %s for unpinned bots", config.EnvSeat)
+	}
+
+	if err := spawner.Spawn(BotSpec{Command: "echo", Count: 2, Seat: &button}); err == nil {
+		t.Fatal("expected an error pinning several bots to one seat")
+	}
+	if spawner.ActiveCount() != 0 {
+		t.Fatalf("expected nothing spawned, got %d", spawner.ActiveCount())
+	}
+}